@@ -0,0 +1,40 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/apiresp"
+)
+
+type ConfigApi struct {
+	imAdminUserID func() []string
+}
+
+func NewConfigApi(imAdminUserID func() []string) ConfigApi {
+	return ConfigApi{imAdminUserID: imAdminUserID}
+}
+
+// GetConfigVersion returns the version of the reloadable config files the api runs with,
+// the other services applying the same files have the same version.
+func (c *ConfigApi) GetConfigVersion(ctx *gin.Context) {
+	if err := authverify.CheckAdmin(ctx, c.imAdminUserID()); err != nil {
+		apiresp.GinError(ctx, err)
+		return
+	}
+	apiresp.GinSuccess(ctx, config.ActiveVersion())
+}
@@ -21,6 +21,14 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/apistruct"
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/legalhold"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgedit"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgreaction"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgreceipt"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgretention"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgschedule"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgsearch"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgthread"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msg"
@@ -40,11 +48,11 @@ type MessageApi struct {
 	*rpcclient.Message
 	validate      *validator.Validate
 	userRpcClient *rpcclient.UserRpcClient
-	imAdminUserID []string
+	imAdminUserID func() []string
 }
 
 func NewMessageApi(msgRpcClient *rpcclient.Message, userRpcClient *rpcclient.User,
-	imAdminUserID []string) MessageApi {
+	imAdminUserID func() []string) MessageApi {
 	return MessageApi{Message: msgRpcClient, validate: validator.New(),
 		userRpcClient: rpcclient.NewUserRpcClientByUser(userRpcClient), imAdminUserID: imAdminUserID}
 }
@@ -118,6 +126,102 @@ func (m *MessageApi) RevokeMsg(c *gin.Context) {
 	a2r.Call(msg.MsgClient.RevokeMsg, m.Client, c)
 }
 
+func (m *MessageApi) EditMsg(c *gin.Context) {
+	a2r.Call(msgedit.MsgEditClient.EditMsg, m.EditClient, c)
+}
+
+func (m *MessageApi) GetMsgEditHistory(c *gin.Context) {
+	a2r.Call(msgedit.MsgEditClient.GetMsgEditHistory, m.EditClient, c)
+}
+
+func (m *MessageApi) AddMsgReaction(c *gin.Context) {
+	a2r.Call(msgreaction.MsgReactionClient.AddMsgReaction, m.ReactionClient, c)
+}
+
+func (m *MessageApi) RemoveMsgReaction(c *gin.Context) {
+	a2r.Call(msgreaction.MsgReactionClient.RemoveMsgReaction, m.ReactionClient, c)
+}
+
+func (m *MessageApi) GetMsgReactionUsers(c *gin.Context) {
+	a2r.Call(msgreaction.MsgReactionClient.GetMsgReactionUsers, m.ReactionClient, c)
+}
+
+func (m *MessageApi) PullMsgReactions(c *gin.Context) {
+	a2r.Call(msgreaction.MsgReactionClient.PullMsgReactions, m.ReactionClient, c)
+}
+
+func (m *MessageApi) CreateThread(c *gin.Context) {
+	a2r.Call(msgthread.MsgThreadClient.CreateThread, m.ThreadClient, c)
+}
+
+func (m *MessageApi) GetThreadsByRootSeqs(c *gin.Context) {
+	a2r.Call(msgthread.MsgThreadClient.GetThreadsByRootSeqs, m.ThreadClient, c)
+}
+
+func (m *MessageApi) GetUserThreads(c *gin.Context) {
+	a2r.Call(msgthread.MsgThreadClient.GetUserThreads, m.ThreadClient, c)
+}
+
+func (m *MessageApi) PullThreadMsgs(c *gin.Context) {
+	a2r.Call(msgthread.MsgThreadClient.PullThreadMsgs, m.ThreadClient, c)
+}
+
+func (m *MessageApi) MarkThreadAsRead(c *gin.Context) {
+	a2r.Call(msgthread.MsgThreadClient.MarkThreadAsRead, m.ThreadClient, c)
+}
+
+func (m *MessageApi) ScheduleMsg(c *gin.Context) {
+	a2r.Call(msgschedule.MsgScheduleClient.ScheduleMsg, m.ScheduleClient, c)
+}
+
+func (m *MessageApi) GetScheduledMsgs(c *gin.Context) {
+	a2r.Call(msgschedule.MsgScheduleClient.GetScheduledMsgs, m.ScheduleClient, c)
+}
+
+func (m *MessageApi) CancelScheduledMsg(c *gin.Context) {
+	a2r.Call(msgschedule.MsgScheduleClient.CancelScheduledMsg, m.ScheduleClient, c)
+}
+
+func (m *MessageApi) RescheduleMsg(c *gin.Context) {
+	a2r.Call(msgschedule.MsgScheduleClient.RescheduleMsg, m.ScheduleClient, c)
+}
+
+func (m *MessageApi) GetGroupMsgReadMembers(c *gin.Context) {
+	a2r.Call(msgreceipt.MsgReceiptClient.GetGroupMsgReadMembers, m.ReceiptClient, c)
+}
+
+func (m *MessageApi) SetRetentionPolicy(c *gin.Context) {
+	a2r.Call(msgretention.MsgRetentionClient.SetRetentionPolicy, m.RetentionClient, c)
+}
+
+func (m *MessageApi) DeleteRetentionPolicy(c *gin.Context) {
+	a2r.Call(msgretention.MsgRetentionClient.DeleteRetentionPolicy, m.RetentionClient, c)
+}
+
+func (m *MessageApi) GetRetentionPolicies(c *gin.Context) {
+	a2r.Call(msgretention.MsgRetentionClient.GetRetentionPolicies, m.RetentionClient, c)
+}
+
+func (m *MessageApi) CreateLegalHold(c *gin.Context) {
+	a2r.Call(legalhold.LegalHoldServiceClient.CreateLegalHold, m.LegalHoldClient, c)
+}
+
+func (m *MessageApi) UpdateLegalHold(c *gin.Context) {
+	a2r.Call(legalhold.LegalHoldServiceClient.UpdateLegalHold, m.LegalHoldClient, c)
+}
+
+func (m *MessageApi) ReleaseLegalHold(c *gin.Context) {
+	a2r.Call(legalhold.LegalHoldServiceClient.ReleaseLegalHold, m.LegalHoldClient, c)
+}
+
+func (m *MessageApi) GetLegalHolds(c *gin.Context) {
+	a2r.Call(legalhold.LegalHoldServiceClient.GetLegalHolds, m.LegalHoldClient, c)
+}
+
+func (m *MessageApi) GetLegalHoldAudits(c *gin.Context) {
+	a2r.Call(legalhold.LegalHoldServiceClient.GetLegalHoldAudits, m.LegalHoldClient, c)
+}
+
 func (m *MessageApi) MarkMsgsAsRead(c *gin.Context) {
 	a2r.Call(msg.MsgClient.MarkMsgsAsRead, m.Client, c)
 }
@@ -204,7 +308,7 @@ func (m *MessageApi) SendMessage(c *gin.Context) {
 	}
 
 	// Check if the user has the app manager role.
-	if !authverify.IsAppManagerUid(c, m.imAdminUserID) {
+	if !authverify.IsAppManagerUid(c, m.imAdminUserID()) {
 		// Respond with a permission error if the user is not an app manager.
 		apiresp.GinError(c, errs.ErrNoPermission.WrapMsg("only app manager can send message"))
 		return
@@ -259,7 +363,7 @@ func (m *MessageApi) SendBusinessNotification(c *gin.Context) {
 		return
 	}
 
-	if !authverify.IsAppManagerUid(c, m.imAdminUserID) {
+	if !authverify.IsAppManagerUid(c, m.imAdminUserID()) {
 		apiresp.GinError(c, errs.ErrNoPermission.WrapMsg("only app manager can send message"))
 		return
 	}
@@ -302,7 +406,7 @@ func (m *MessageApi) BatchSendMsg(c *gin.Context) {
 		apiresp.GinError(c, errs.ErrArgs.WithDetail(err.Error()).Wrap())
 		return
 	}
-	if err := authverify.CheckAdmin(c, m.imAdminUserID); err != nil {
+	if err := authverify.CheckAdmin(c, m.imAdminUserID()); err != nil {
 		apiresp.GinError(c, errs.ErrNoPermission.WrapMsg("only app manager can send message"))
 		return
 	}
@@ -366,7 +470,7 @@ func (m *MessageApi) GetActiveGroup(c *gin.Context) {
 }
 
 func (m *MessageApi) SearchMsg(c *gin.Context) {
-	a2r.Call(msg.MsgClient.SearchMessage, m.Client, c)
+	a2r.Call(msgsearch.MsgSearchClient.SearchMsg, m.SearchClient, c)
 }
 
 func (m *MessageApi) GetServerTime(c *gin.Context) {
@@ -0,0 +1,40 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/pushretry"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	"github.com/openimsdk/tools/a2r"
+)
+
+type PushApi rpcclient.Push
+
+func NewPushApi(client rpcclient.Push) PushApi {
+	return PushApi(client)
+}
+
+func (o *PushApi) GetDeadLetters(c *gin.Context) {
+	a2r.Call(pushretry.PushRetryClient.GetDeadLetters, o.RetryClient, c)
+}
+
+func (o *PushApi) ReplayDeadLetters(c *gin.Context) {
+	a2r.Call(pushretry.PushRetryClient.ReplayDeadLetters, o.RetryClient, c)
+}
+
+func (o *PushApi) DeleteDeadLetters(c *gin.Context) {
+	a2r.Call(pushretry.PushRetryClient.DeleteDeadLetters, o.RetryClient, c)
+}
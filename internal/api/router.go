@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/apiresp"
@@ -18,26 +19,29 @@ import (
 )
 
 func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.Engine {
-	disCov.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()),
+	disCov.AddOption(mw.GrpcClient(), tracing.GrpcDialOption(), grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
 	gin.SetMode(gin.ReleaseMode)
 	r := gin.New()
+	// the rpc calls made with the gin.Context carry the span of the request context
+	r.ContextWithFallback = true
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		_ = v.RegisterValidation("required_if", RequiredIf)
 	}
-	r.Use(gin.Recovery(), mw.CorsHandler(), mw.GinParseOperationID())
+	r.Use(gin.Recovery(), mw.CorsHandler(), mw.GinParseOperationID(), tracing.GinMiddleware())
 	// init rpc client here
 	userRpc := rpcclient.NewUser(disCov, config.Share.RpcRegisterName.User, config.Share.RpcRegisterName.MessageGateway,
-		config.Share.IMAdminUserID)
+		config.Share.AdminUserIDs)
 	groupRpc := rpcclient.NewGroup(disCov, config.Share.RpcRegisterName.Group)
 	friendRpc := rpcclient.NewFriend(disCov, config.Share.RpcRegisterName.Friend)
 	messageRpc := rpcclient.NewMessage(disCov, config.Share.RpcRegisterName.Msg)
 	conversationRpc := rpcclient.NewConversation(disCov, config.Share.RpcRegisterName.Conversation)
 	authRpc := rpcclient.NewAuth(disCov, config.Share.RpcRegisterName.Auth)
 	thirdRpc := rpcclient.NewThird(disCov, config.Share.RpcRegisterName.Third, config.RpcConfig.Prometheus.GrafanaURL)
+	pushRpc := rpcclient.NewPush(disCov, config.Share.RpcRegisterName.Push)
 
 	u := NewUserApi(*userRpc)
-	m := NewMessageApi(messageRpc, userRpc, config.Share.IMAdminUserID)
+	m := NewMessageApi(messageRpc, userRpc, config.Share.AdminUserIDs)
 	ParseToken := GinParseToken(authRpc)
 	userRouterGroup := r.Group("/user")
 	{
@@ -45,6 +49,8 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		userRouterGroup.POST("/update_user_info", ParseToken, u.UpdateUserInfo)
 		userRouterGroup.POST("/update_user_info_ex", ParseToken, u.UpdateUserInfoEx)
 		userRouterGroup.POST("/set_global_msg_recv_opt", ParseToken, u.SetGlobalRecvMessageOpt)
+		userRouterGroup.POST("/set_do_not_disturb", ParseToken, u.SetDoNotDisturb)
+		userRouterGroup.POST("/get_do_not_disturb", ParseToken, u.GetDoNotDisturb)
 		userRouterGroup.POST("/get_users_info", ParseToken, u.GetUsersPublicInfo)
 		userRouterGroup.POST("/get_all_users_uid", ParseToken, u.GetAllUsersID)
 		userRouterGroup.POST("/account_check", ParseToken, u.AccountCheck)
@@ -137,6 +143,11 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		logs.POST("/delete", t.DeleteLogs)
 		logs.POST("/search", t.SearchLogs)
 
+		export := thirdGroup.Group("/export")
+		export.POST("/create", t.CreateExportJob)
+		export.POST("/get", t.GetExportJob)
+		export.POST("/get_jobs", t.GetExportJobs)
+
 		objectGroup := r.Group("/object", ParseToken)
 
 		objectGroup.POST("/part_limit", t.PartLimit)
@@ -149,6 +160,14 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		objectGroup.POST("/complete_form_data", t.CompleteFormData)
 		objectGroup.GET("/*name", t.ObjectRedirect)
 	}
+	// Offline push retry
+	pushGroup := r.Group("/push", ParseToken)
+	{
+		p := NewPushApi(*pushRpc)
+		pushGroup.POST("/get_dead_letters", p.GetDeadLetters)
+		pushGroup.POST("/replay_dead_letters", p.ReplayDeadLetters)
+		pushGroup.POST("/delete_dead_letters", p.DeleteDeadLetters)
+	}
 	// Message
 	msgGroup := r.Group("/msg", ParseToken)
 	{
@@ -158,6 +177,25 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		msgGroup.POST("/send_business_notification", m.SendBusinessNotification)
 		msgGroup.POST("/pull_msg_by_seq", m.PullMsgBySeqs)
 		msgGroup.POST("/revoke_msg", m.RevokeMsg)
+		msgGroup.POST("/edit_msg", m.EditMsg)
+		msgGroup.POST("/get_msg_edit_history", m.GetMsgEditHistory)
+		msgGroup.POST("/add_msg_reaction", m.AddMsgReaction)
+		msgGroup.POST("/remove_msg_reaction", m.RemoveMsgReaction)
+		msgGroup.POST("/get_msg_reaction_users", m.GetMsgReactionUsers)
+		msgGroup.POST("/pull_msg_reactions", m.PullMsgReactions)
+		msgGroup.POST("/create_thread", m.CreateThread)
+		msgGroup.POST("/get_threads_by_root_seqs", m.GetThreadsByRootSeqs)
+		msgGroup.POST("/get_user_threads", m.GetUserThreads)
+		msgGroup.POST("/pull_thread_msgs", m.PullThreadMsgs)
+		msgGroup.POST("/mark_thread_as_read", m.MarkThreadAsRead)
+		msgGroup.POST("/schedule_msg", m.ScheduleMsg)
+		msgGroup.POST("/get_scheduled_msgs", m.GetScheduledMsgs)
+		msgGroup.POST("/cancel_scheduled_msg", m.CancelScheduledMsg)
+		msgGroup.POST("/reschedule_msg", m.RescheduleMsg)
+		msgGroup.POST("/get_group_msg_read_members", m.GetGroupMsgReadMembers)
+		msgGroup.POST("/set_retention_policy", m.SetRetentionPolicy)
+		msgGroup.POST("/delete_retention_policy", m.DeleteRetentionPolicy)
+		msgGroup.POST("/get_retention_policies", m.GetRetentionPolicies)
 		msgGroup.POST("/mark_msgs_as_read", m.MarkMsgsAsRead)
 		msgGroup.POST("/mark_conversation_as_read", m.MarkConversationAsRead)
 		msgGroup.POST("/get_conversations_has_read_and_max_seq", m.GetConversationsHasReadAndMaxSeq)
@@ -185,6 +223,21 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		conversationGroup.POST("/get_conversation_offline_push_user_ids", c.GetConversationOfflinePushUserIDs)
 	}
 
+	legalHoldGroup := r.Group("/legal_hold", ParseToken)
+	{
+		legalHoldGroup.POST("/create", m.CreateLegalHold)
+		legalHoldGroup.POST("/update", m.UpdateLegalHold)
+		legalHoldGroup.POST("/release", m.ReleaseLegalHold)
+		legalHoldGroup.POST("/get", m.GetLegalHolds)
+		legalHoldGroup.POST("/get_audits", m.GetLegalHoldAudits)
+	}
+
+	configGroup := r.Group("/config", ParseToken)
+	{
+		cf := NewConfigApi(config.Share.AdminUserIDs)
+		configGroup.POST("/get_version", cf.GetConfigVersion)
+	}
+
 	statisticsGroup := r.Group("/statistics", ParseToken)
 	{
 		statisticsGroup.POST("/user/register", u.UserRegisterCount)
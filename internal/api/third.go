@@ -24,6 +24,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/exportjob"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/third"
 	"github.com/openimsdk/tools/a2r"
@@ -167,6 +168,20 @@ func (o *ThirdApi) SearchLogs(c *gin.Context) {
 	a2r.Call(third.ThirdClient.SearchLogs, o.Client, c)
 }
 
+// #################### export ####################
+
+func (o *ThirdApi) CreateExportJob(c *gin.Context) {
+	a2r.Call(exportjob.ExportJobServiceClient.CreateExportJob, o.ExportClient, c)
+}
+
+func (o *ThirdApi) GetExportJob(c *gin.Context) {
+	a2r.Call(exportjob.ExportJobServiceClient.GetExportJob, o.ExportClient, c)
+}
+
+func (o *ThirdApi) GetExportJobs(c *gin.Context) {
+	a2r.Call(exportjob.ExportJobServiceClient.GetExportJobs, o.ExportClient, c)
+}
+
 func (o *ThirdApi) GetPrometheus(c *gin.Context) {
 	c.Redirect(http.StatusFound, o.GrafanaUrl)
 }
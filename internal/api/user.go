@@ -16,6 +16,7 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/donotdisturb"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msggateway"
@@ -46,6 +47,14 @@ func (u *UserApi) SetGlobalRecvMessageOpt(c *gin.Context) {
 	a2r.Call(user.UserClient.SetGlobalRecvMessageOpt, u.Client, c)
 }
 
+func (u *UserApi) SetDoNotDisturb(c *gin.Context) {
+	a2r.Call(donotdisturb.DoNotDisturbServiceClient.SetDoNotDisturb, u.DoNotDisturbClient, c)
+}
+
+func (u *UserApi) GetDoNotDisturb(c *gin.Context) {
+	a2r.Call(donotdisturb.DoNotDisturbServiceClient.GetDoNotDisturb, u.DoNotDisturbClient, c)
+}
+
 func (u *UserApi) GetUsersPublicInfo(c *gin.Context) {
 	a2r.Call(user.UserClient.GetDesignateUsers, u.Client, c)
 }
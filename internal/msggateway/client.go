@@ -21,6 +21,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/sdkws"
@@ -29,6 +30,8 @@ import (
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/utils/stringutil"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -65,6 +68,7 @@ type Client struct {
 	conn           LongConn
 	PlatformID     int    `json:"platformID"`
 	IsCompress     bool   `json:"isCompress"`
+	Encoding       string `json:"encoding"`
 	UserID         string `json:"userID"`
 	IsBackground   bool   `json:"isBackground"`
 	ctx            *UserConnContext
@@ -72,6 +76,7 @@ type Client struct {
 	closed         atomic.Bool
 	closedErr      error
 	token          string
+	encoder        Encoder
 }
 
 // ResetClient updates the client's state with new connection and context information.
@@ -80,6 +85,8 @@ func (c *Client) ResetClient(ctx *UserConnContext, conn LongConn, longConnServer
 	c.conn = conn
 	c.PlatformID = stringutil.StringToInt(ctx.GetPlatformID())
 	c.IsCompress = ctx.GetCompression()
+	c.Encoding = ctx.GetEncoding()
+	c.encoder = encoders[c.Encoding]
 	c.IsBackground = ctx.GetBackground()
 	c.UserID = ctx.GetUserID()
 	c.ctx = ctx
@@ -137,8 +144,16 @@ func (c *Client) readMessage() {
 				return
 			}
 		case MessageText:
-			c.closedErr = ErrNotSupportMessageProtocol
-			return
+			// Browsers speaking JSON send text frames, every other encoding is binary.
+			if c.Encoding != JsonEncoding {
+				c.closedErr = ErrNotSupportMessageProtocol
+				return
+			}
+			_ = c.conn.SetReadDeadline(pongWait)
+			if parseDataErr := c.handleMessage(message); parseDataErr != nil {
+				c.closedErr = parseDataErr
+				return
+			}
 
 		case PingMessage:
 			err := c.writePongMsg()
@@ -165,7 +180,7 @@ func (c *Client) handleMessage(message []byte) error {
 	var binaryReq = getReq()
 	defer freeReq(binaryReq)
 
-	err := c.longConnServer.Decode(message, binaryReq)
+	err := c.encoder.Decode(message, binaryReq)
 	if err != nil {
 		return err
 	}
@@ -260,7 +275,12 @@ func (c *Client) replyMessage(ctx context.Context, binaryReq *Req, err error, re
 	return nil
 }
 
-func (c *Client) PushMessage(ctx context.Context, msgData *sdkws.MsgData) error {
+func (c *Client) PushMessage(ctx context.Context, msgData *sdkws.MsgData) (err error) {
+	ctx, span := tracing.Start(ctx, "msggateway push message", trace.WithAttributes(
+		attribute.String("userID", c.UserID), attribute.Int("platformID", c.PlatformID)))
+	defer func() {
+		tracing.End(span, err)
+	}()
 	var msg sdkws.PushMessages
 	conversationID := msgprocessor.GetConversationIDByMsg(msgData)
 	m := map[string]*sdkws.PullMsgs{conversationID: {Msgs: []*sdkws.MsgData{msgData}}}
@@ -291,12 +311,22 @@ func (c *Client) KickOnlineMessage() error {
 	return err
 }
 
+// ReconnectMessage tells the client this node is draining and it should reconnect to another one.
+func (c *Client) ReconnectMessage() error {
+	resp := Resp{
+		ReqIdentifier: WSReconnectMsg,
+	}
+	err := c.writeBinaryMsg(resp)
+	c.close()
+	return err
+}
+
 func (c *Client) writeBinaryMsg(resp Resp) error {
 	if c.closed.Load() {
 		return nil
 	}
 
-	encodedBuf, err := c.longConnServer.Encode(resp)
+	encodedBuf, err := c.encoder.Encode(resp)
 	if err != nil {
 		return err
 	}
@@ -316,6 +346,9 @@ func (c *Client) writeBinaryMsg(resp Resp) error {
 		}
 		return c.conn.WriteMessage(MessageBinary, resultBuf)
 	}
+	if c.Encoding == JsonEncoding {
+		return c.conn.WriteMessage(MessageText, encodedBuf)
+	}
 
 	return c.conn.WriteMessage(MessageBinary, encodedBuf)
 }
@@ -25,8 +25,14 @@ const (
 	OperationID             = "operationID"
 	Compression             = "compression"
 	GzipCompressionProtocol = "gzip"
+	Encoding                = "encoding"
+	GobEncoding             = "gob"
+	JsonEncoding            = "json"
+	ProtobufEncoding        = "protobuf"
 	BackgroundStatus        = "isBackground"
 	SendResponse            = "isMsgResp"
+	Transport               = "transport"
+	SSETransport            = "sse"
 )
 
 const (
@@ -43,6 +49,7 @@ const (
 	WSKickOnlineMsg       = 2002
 	WsLogoutMsg           = 2003
 	WsSetBackgroundStatus = 2004
+	WSReconnectMsg        = 2005
 	WSDataError           = 3001
 )
 
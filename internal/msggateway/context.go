@@ -173,6 +173,24 @@ func (c *UserConnContext) GetBackground() bool {
 	}
 	return b
 }
+
+// IsSSE reports whether the client uses the Server-Sent Events transport instead of WebSocket.
+func (c *UserConnContext) IsSSE() bool {
+	transport, _ := c.Query(Transport)
+	return transport == SSETransport
+}
+
+// GetEncoding returns the frame encoding asked by the client, gob if it did not ask for one.
+func (c *UserConnContext) GetEncoding() string {
+	if encoding, exists := c.Query(Encoding); exists && encoding != "" {
+		return encoding
+	}
+	if encoding, exists := c.GetHeader(Encoding); exists && encoding != "" {
+		return encoding
+	}
+	return GobEncoding
+}
+
 func (c *UserConnContext) ParseEssentialArgs() error {
 	_, exists := c.Query(Token)
 	if !exists {
@@ -191,5 +209,8 @@ func (c *UserConnContext) ParseEssentialArgs() error {
 		return servererrs.ErrConnArgsErr.WrapMsg("platformID is not int")
 
 	}
+	if _, ok := encoders[c.GetEncoding()]; !ok {
+		return servererrs.ErrConnArgsErr.WrapMsg("encoding is not supported", "encoding", c.GetEncoding())
+	}
 	return nil
 }
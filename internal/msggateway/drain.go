@@ -0,0 +1,137 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	pbgatewaydrain "github.com/openimsdk/open-im-server/v3/pkg/protocol/gatewaydrain"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/idutil"
+)
+
+const (
+	defaultDrainBatchSize = 500
+	defaultDrainInterval  = 200 * time.Millisecond
+	defaultDrainDeadline  = 60 * time.Second
+)
+
+func (d drainConfig) withDefaults() drainConfig {
+	if d.batchSize <= 0 {
+		d.batchSize = defaultDrainBatchSize
+	}
+	if d.interval <= 0 {
+		d.interval = defaultDrainInterval
+	}
+	if d.deadline <= 0 {
+		d.deadline = defaultDrainDeadline
+	}
+	return d
+}
+
+// Drain refuses new connections, removes the node from service discovery and asks connected clients to reconnect
+// to another node, batchSize clients per interval so they do not all land on the remaining nodes at once.
+// The returned channel is closed when no connection is left or the deadline passed; a deadline <= 0 uses the
+// configured one. Calling Drain again only returns the channel of the drain in progress.
+func (ws *WsServer) Drain(deadline time.Duration) <-chan struct{} {
+	ws.drainOnce.Do(func() {
+		if deadline <= 0 {
+			deadline = ws.drain.deadline
+		}
+		ws.draining.Store(true)
+		ctx := mcontext.SetOperationID(context.Background(), "drain_"+idutil.OperationIDGenerator())
+		go ws.runDrain(ctx, deadline)
+	})
+	return ws.drained
+}
+
+func (ws *WsServer) IsDraining() bool {
+	return ws.draining.Load()
+}
+
+func (ws *WsServer) GetOnlineUserConnNum() int64 {
+	return ws.onlineUserConnNum.Load()
+}
+
+func (ws *WsServer) runDrain(ctx context.Context, deadline time.Duration) {
+	defer close(ws.drained)
+	log.ZInfo(ctx, "gateway drain start", "online user conn Num", ws.onlineUserConnNum.Load(), "deadline", deadline)
+	if ws.disCov != nil {
+		if err := ws.disCov.UnRegister(); err != nil {
+			log.ZWarn(ctx, "drain unregister from discovery failed", err)
+		}
+	}
+	timer := time.NewTimer(deadline)
+	defer timer.Stop()
+	ticker := time.NewTicker(ws.drain.interval)
+	defer ticker.Stop()
+	for {
+		if ws.onlineUserConnNum.Load() <= 0 {
+			log.ZInfo(ctx, "gateway drained")
+			return
+		}
+		ws.reconnectBatch(ctx)
+		select {
+		case <-timer.C:
+			log.ZWarn(ctx, "gateway drain deadline exceeded", nil, "online user conn Num", ws.onlineUserConnNum.Load())
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// reconnectBatch sends the reconnect control frame to at most batchSize clients and closes them.
+func (ws *WsServer) reconnectBatch(ctx context.Context) {
+	var num int
+	ws.clients.Range(func(client *Client) bool {
+		if client.closed.Load() {
+			return true
+		}
+		if err := client.ReconnectMessage(); err != nil {
+			log.ZWarn(ctx, "ReconnectMessage", err, "userID", client.UserID, "platformID", client.PlatformID)
+		}
+		num++
+		return num < ws.drain.batchSize
+	})
+	log.ZDebug(ctx, "drain batch sent", "num", num, "online user conn Num", ws.onlineUserConnNum.Load())
+}
+
+// drain runs on SIGTERM before the rpc server stops, so clients still connected keep receiving pushes.
+// It unregisters the node from discovery, so reconnecting clients land on other nodes, and blocks until every
+// client was moved or the deadline passed. Run waits on the same drain before shutting down the ws server.
+func (s *Server) drain() {
+	<-s.LongConnServer.Drain(0)
+}
+
+func (s *Server) Drain(ctx context.Context, req *pbgatewaydrain.DrainReq) (*pbgatewaydrain.DrainResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	s.LongConnServer.Drain(time.Duration(req.Deadline) * time.Second)
+	return &pbgatewaydrain.DrainResp{OnlineUserConnNum: s.LongConnServer.GetOnlineUserConnNum()}, nil
+}
+
+func (s *Server) GetDrainStatus(ctx context.Context, req *pbgatewaydrain.GetDrainStatusReq) (*pbgatewaydrain.GetDrainStatusResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	return &pbgatewaydrain.GetDrainStatusResp{
+		Draining:          s.LongConnServer.IsDraining(),
+		OnlineUserConnNum: s.LongConnServer.GetOnlineUserConnNum(),
+	}, nil
+}
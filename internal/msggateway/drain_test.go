@@ -0,0 +1,91 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+type drainConn struct {
+	LongConn
+	frames *atomic.Int32
+}
+
+func (c drainConn) SetWriteDeadline(time.Duration) error { return nil }
+
+func (c drainConn) WriteMessage(int, []byte) error {
+	c.frames.Add(1)
+	return nil
+}
+
+func (c drainConn) Close() error { return nil }
+
+func TestDrain(t *testing.T) {
+	ws, err := NewWsServer(&Config{}, WithDrain(2, 10*time.Millisecond, time.Second))
+	assert.NoError(t, err)
+	var frames atomic.Int32
+	for i := 0; i < 5; i++ {
+		r := httptest.NewRequest("GET", fmt.Sprintf("/?sendID=u%d&platformID=%d", i, constant.AndroidPlatformID), nil)
+		r.RemoteAddr = fmt.Sprintf("127.0.0.1:%d", 1000+i)
+		client := new(Client)
+		client.ResetClient(newContext(httptest.NewRecorder(), r), drainConn{frames: &frames}, ws)
+		ws.clients.Set(client.UserID, client)
+		ws.onlineUserConnNum.Add(1)
+	}
+	// Stand-in for the register loop of Run, without the online status rpc.
+	go func() {
+		for client := range ws.unregisterChan {
+			ws.clients.delete(client.UserID, client.ctx.GetRemoteAddr())
+			ws.onlineUserConnNum.Add(-1)
+		}
+	}()
+
+	start := time.Now()
+	drained := ws.Drain(0)
+	assert.True(t, ws.IsDraining())
+	select {
+	case <-drained:
+	case <-time.After(3 * time.Second):
+		t.Fatal("drain did not finish")
+	}
+	assert.Equal(t, int32(5), frames.Load())
+	assert.Equal(t, int64(0), ws.GetOnlineUserConnNum())
+	// 5 clients in batches of 2 need two pauses between batches.
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	assert.Equal(t, drained, ws.Drain(0))
+}
+
+func TestRunWaitsForDrain(t *testing.T) {
+	ws, err := NewWsServer(&Config{}, WithDrain(2, 10*time.Millisecond, 200*time.Millisecond))
+	assert.NoError(t, err)
+	// A connection that never leaves keeps the drain running until its deadline.
+	ws.onlineUserConnNum.Add(1)
+	drained := ws.Drain(0)
+	done := make(chan error, 1)
+	done <- nil
+	assert.NoError(t, ws.Run(done))
+	select {
+	case <-drained:
+	default:
+		t.Fatal("run returned before the drain finished")
+	}
+}
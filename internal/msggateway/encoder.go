@@ -17,8 +17,11 @@ package msggateway
 import (
 	"bytes"
 	"encoding/gob"
+	"encoding/json"
 
+	pbgateway "github.com/openimsdk/open-im-server/v3/pkg/protocol/gateway"
 	"github.com/openimsdk/tools/errs"
+	"google.golang.org/protobuf/proto"
 )
 
 type Encoder interface {
@@ -26,6 +29,13 @@ type Encoder interface {
 	Decode(encodeData []byte, decodeData any) error
 }
 
+// encoders are the frame encodings a client can negotiate with the Encoding handshake argument.
+var encoders = map[string]Encoder{
+	GobEncoding:      NewGobEncoder(),
+	JsonEncoding:     NewJsonEncoder(),
+	ProtobufEncoding: NewProtobufEncoder(),
+}
+
 type GobEncoder struct{}
 
 func NewGobEncoder() *GobEncoder {
@@ -49,3 +59,98 @@ func (g *GobEncoder) Decode(encodeData []byte, decodeData any) error {
 	}
 	return nil
 }
+
+type JsonEncoder struct{}
+
+func NewJsonEncoder() *JsonEncoder {
+	return &JsonEncoder{}
+}
+
+func (j *JsonEncoder) Encode(data any) ([]byte, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "JsonEncoder.Encode failed", "action", "encode")
+	}
+	return b, nil
+}
+
+func (j *JsonEncoder) Decode(encodeData []byte, decodeData any) error {
+	if err := json.Unmarshal(encodeData, decodeData); err != nil {
+		return errs.WrapMsg(err, "JsonEncoder.Decode failed", "action", "decode")
+	}
+	return nil
+}
+
+// ProtobufEncoder frames Req and Resp as the messages of pkg/protocol/gateway.
+type ProtobufEncoder struct{}
+
+func NewProtobufEncoder() *ProtobufEncoder {
+	return &ProtobufEncoder{}
+}
+
+func (p *ProtobufEncoder) Encode(data any) ([]byte, error) {
+	var msg proto.Message
+	switch v := data.(type) {
+	case Resp:
+		msg = respToPb(&v)
+	case *Resp:
+		msg = respToPb(v)
+	case *Req:
+		msg = &pbgateway.Req{
+			ReqIdentifier: v.ReqIdentifier,
+			Token:         v.Token,
+			SendID:        v.SendID,
+			OperationID:   v.OperationID,
+			MsgIncr:       v.MsgIncr,
+			Data:          v.Data,
+		}
+	default:
+		return nil, errs.New("ProtobufEncoder.Encode unsupported type", "action", "encode").Wrap()
+	}
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "ProtobufEncoder.Encode failed", "action", "encode")
+	}
+	return b, nil
+}
+
+func (p *ProtobufEncoder) Decode(encodeData []byte, decodeData any) error {
+	switch v := decodeData.(type) {
+	case *Req:
+		var req pbgateway.Req
+		if err := proto.Unmarshal(encodeData, &req); err != nil {
+			return errs.WrapMsg(err, "ProtobufEncoder.Decode failed", "action", "decode")
+		}
+		v.ReqIdentifier = req.ReqIdentifier
+		v.Token = req.Token
+		v.SendID = req.SendID
+		v.OperationID = req.OperationID
+		v.MsgIncr = req.MsgIncr
+		v.Data = req.Data
+	case *Resp:
+		var resp pbgateway.Resp
+		if err := proto.Unmarshal(encodeData, &resp); err != nil {
+			return errs.WrapMsg(err, "ProtobufEncoder.Decode failed", "action", "decode")
+		}
+		v.ReqIdentifier = resp.ReqIdentifier
+		v.MsgIncr = resp.MsgIncr
+		v.OperationID = resp.OperationID
+		v.ErrCode = int(resp.ErrCode)
+		v.ErrMsg = resp.ErrMsg
+		v.Data = resp.Data
+	default:
+		return errs.New("ProtobufEncoder.Decode unsupported type", "action", "decode").Wrap()
+	}
+	return nil
+}
+
+func respToPb(resp *Resp) *pbgateway.Resp {
+	return &pbgateway.Resp{
+		ReqIdentifier: resp.ReqIdentifier,
+		MsgIncr:       resp.MsgIncr,
+		OperationID:   resp.OperationID,
+		ErrCode:       int32(resp.ErrCode),
+		ErrMsg:        resp.ErrMsg,
+		Data:          resp.Data,
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncoders(t *testing.T) {
+	req := Req{ReqIdentifier: WSSendMsg, SendID: "u1", OperationID: "op", MsgIncr: "1", Data: []byte{1, 2, 3}}
+	resp := Resp{ReqIdentifier: WSSendMsg, MsgIncr: "1", OperationID: "op", ErrCode: 1001, ErrMsg: "err", Data: []byte{4, 5}}
+	for name, encoder := range encoders {
+		data, err := encoder.Encode(&req)
+		assert.NoError(t, err, name)
+		var decodedReq Req
+		assert.NoError(t, encoder.Decode(data, &decodedReq), name)
+		assert.Equal(t, req, decodedReq, name)
+
+		data, err = encoder.Encode(resp)
+		assert.NoError(t, err, name)
+		var decodedResp Resp
+		assert.NoError(t, encoder.Decode(data, &decodedResp), name)
+		assert.Equal(t, resp, decodedResp, name)
+	}
+}
+
+func BenchmarkEncodeResp(b *testing.B) {
+	resp := Resp{ReqIdentifier: WSPushMsg, OperationID: "op", Data: mockPushMessage()}
+	for name, encoder := range encoders {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, err := encoder.Encode(resp)
+				assert.Equal(b, nil, err)
+			}
+		})
+	}
+}
@@ -52,13 +52,18 @@ func Start(ctx context.Context, index int, conf *Config) error {
 		WithMaxConnNum(int64(conf.MsgGateway.LongConnSvr.WebsocketMaxConnNum)),
 		WithHandshakeTimeout(time.Duration(conf.MsgGateway.LongConnSvr.WebsocketTimeout)*time.Second),
 		WithMessageMaxMsgLength(conf.MsgGateway.LongConnSvr.WebsocketMaxMsgLen),
+		WithCompression(conf.MsgGateway.LongConnSvr.Compression.Enable, conf.MsgGateway.LongConnSvr.Compression.Level,
+			conf.MsgGateway.LongConnSvr.Compression.Threshold),
+		WithDrain(conf.MsgGateway.LongConnSvr.Drain.BatchSize,
+			time.Duration(conf.MsgGateway.LongConnSvr.Drain.Interval)*time.Millisecond,
+			time.Duration(conf.MsgGateway.LongConnSvr.Drain.Deadline)*time.Second),
 	)
 	if err != nil {
 		return err
 	}
 
 	hubServer := NewServer(rpcPort, prometheusPort, longServer, conf)
-	netDone := make(chan error)
+	netDone := make(chan error, 1)
 	go func() {
 		err = hubServer.Start(ctx, index, conf)
 		netDone <- err
@@ -49,15 +49,24 @@ type LongConn interface {
 	// GenerateLongConn Check the connection of the current and when it was sent are the same
 	GenerateLongConn(w http.ResponseWriter, r *http.Request) error
 }
+
+// handshakeConn is a LongConn that can answer the handshake itself.
+type handshakeConn interface {
+	LongConn
+	RespondWithError(err error, w http.ResponseWriter, r *http.Request) error
+	RespondWithSuccess() error
+}
+
 type GWebSocket struct {
 	protocolType     int
 	conn             *websocket.Conn
 	handshakeTimeout time.Duration
 	writeBufferSize  int
+	compression      compressionConfig
 }
 
-func newGWebSocket(protocolType int, handshakeTimeout time.Duration, wbs int) *GWebSocket {
-	return &GWebSocket{protocolType: protocolType, handshakeTimeout: handshakeTimeout, writeBufferSize: wbs}
+func newGWebSocket(protocolType int, handshakeTimeout time.Duration, wbs int, compression compressionConfig) *GWebSocket {
+	return &GWebSocket{protocolType: protocolType, handshakeTimeout: handshakeTimeout, writeBufferSize: wbs, compression: compression}
 }
 
 func (d *GWebSocket) Close() error {
@@ -72,18 +81,38 @@ func (d *GWebSocket) GenerateLongConn(w http.ResponseWriter, r *http.Request) er
 	if d.writeBufferSize > 0 { // default is 4kb.
 		upgrader.WriteBufferSize = d.writeBufferSize
 	}
+	// Payloads of the gzip mode are already compressed, deflating them again only costs CPU.
+	if d.compression.enable && !isGzipCompression(r) {
+		// gorilla/websocket only negotiates server_no_context_takeover and client_no_context_takeover.
+		upgrader.EnableCompression = true
+	}
 
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		// The upgrader.Upgrade method usually returns enough error messages to diagnose problems that may occur during the upgrade
 		return errs.WrapMsg(err, "GenerateLongConn: WebSocket upgrade failed")
 	}
+	if upgrader.EnableCompression && d.compression.level != 0 {
+		if err := conn.SetCompressionLevel(d.compression.level); err != nil {
+			_ = conn.Close()
+			return errs.WrapMsg(err, "GenerateLongConn: invalid compression level", "level", d.compression.level)
+		}
+	}
 	d.conn = conn
 	return nil
 }
 
+// isGzipCompression reports whether the client asked for the SDK gzip mode, see UserConnContext.GetCompression.
+func isGzipCompression(r *http.Request) bool {
+	return r.URL.Query().Get(Compression) == GzipCompressionProtocol || r.Header.Get(Compression) == GzipCompressionProtocol
+}
+
 func (d *GWebSocket) WriteMessage(messageType int, message []byte) error {
 	// d.setSendConn(d.conn)
+	if d.compression.enable {
+		// Only takes effect when permessage-deflate was negotiated, small frames are cheaper uncompressed.
+		d.conn.EnableWriteCompression(len(message) >= d.compression.threshold)
+	}
 	return d.conn.WriteMessage(messageType, message)
 }
 
@@ -112,7 +141,9 @@ func (d *GWebSocket) SetWriteDeadline(timeout time.Duration) error {
 }
 
 func (d *GWebSocket) Dial(urlStr string, requestHeader http.Header) (*http.Response, error) {
-	conn, httpResp, err := websocket.DefaultDialer.Dial(urlStr, requestHeader)
+	dialer := *websocket.DefaultDialer
+	dialer.EnableCompression = d.compression.enable
+	conn, httpResp, err := dialer.Dial(urlStr, requestHeader)
 	if err != nil {
 		return httpResp, errs.WrapMsg(err, "GWebSocket.Dial failed", "url", urlStr)
 	}
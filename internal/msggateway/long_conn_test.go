@@ -0,0 +1,159 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingConn counts the bytes read from the wire by the client, frame headers included.
+type countingConn struct {
+	net.Conn
+	read *int64
+}
+
+func (c countingConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	atomic.AddInt64(c.read, int64(n))
+	return n, err
+}
+
+func mockPushMessage() []byte {
+	data, _ := json.Marshal(map[string]any{
+		"sendID":         "1695766238",
+		"recvID":         "2156402919",
+		"clientMsgID":    "5e1ab1d8b0b1b1a6e2c1a0a4b0a27e33",
+		"serverMsgID":    "9d3cb1d0a6c2c9e8e1f7a3d4c6b5a2f1",
+		"senderNickname": "openIM",
+		"sessionType":    1,
+		"contentType":    101,
+		"content":        `{"content":"` + strings.Repeat("hello, this is a message from openIM. ", 30) + `"}`,
+		"seq":            1024,
+		"sendTime":       time.Now().UnixMilli(),
+	})
+	return data
+}
+
+// benchmarkWire pushes b.N messages from a gateway connection to a client and reports the bytes on the wire.
+func benchmarkWire(b *testing.B, compression compressionConfig, gzip bool) {
+	payload := mockPushMessage()
+	compressor := NewGzipCompressor()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn := newGWebSocket(WebSocket, time.Second, 0, compression)
+		if err := conn.GenerateLongConn(w, r); err != nil {
+			return
+		}
+		defer conn.Close()
+		for i := 0; i < b.N; i++ {
+			data := payload
+			if gzip {
+				var err error
+				if data, err = compressor.CompressWithPool(payload); err != nil {
+					return
+				}
+			}
+			if err := conn.WriteMessage(MessageBinary, data); err != nil {
+				return
+			}
+		}
+	}))
+	defer srv.Close()
+
+	var read int64
+	dialer := websocket.Dialer{
+		EnableCompression: compression.enable,
+		NetDialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			conn, err := (&net.Dialer{}).DialContext(ctx, network, addr)
+			if err != nil {
+				return nil, err
+			}
+			return countingConn{Conn: conn, read: &read}, nil
+		},
+	}
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+	if gzip {
+		url += "?" + Compression + "=" + GzipCompressionProtocol
+	}
+	b.ResetTimer()
+	conn, _, err := dialer.Dial(url, nil)
+	assert.NoError(b, err)
+	defer conn.Close()
+	for i := 0; i < b.N; i++ {
+		_, data, err := conn.ReadMessage()
+		assert.NoError(b, err)
+		if gzip {
+			data, err = compressor.DecompressWithPool(data)
+			assert.NoError(b, err)
+		}
+		assert.Equal(b, len(payload), len(data))
+	}
+	b.StopTimer()
+	b.ReportMetric(float64(atomic.LoadInt64(&read))/float64(b.N), "wire-B/op")
+}
+
+func BenchmarkWireNoCompression(b *testing.B) {
+	benchmarkWire(b, compressionConfig{}, false)
+}
+
+func BenchmarkWireGzip(b *testing.B) {
+	benchmarkWire(b, compressionConfig{}, true)
+}
+
+func BenchmarkWirePermessageDeflate(b *testing.B) {
+	benchmarkWire(b, compressionConfig{enable: true, level: 1, threshold: 512}, false)
+}
+
+func BenchmarkWirePermessageDeflateBestCompression(b *testing.B) {
+	benchmarkWire(b, compressionConfig{enable: true, level: 9, threshold: 512}, false)
+}
+
+func TestPermessageDeflateNegotiation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn := newGWebSocket(WebSocket, time.Second, 0, compressionConfig{enable: true, level: 1, threshold: 512})
+		if err := conn.GenerateLongConn(w, r); err != nil {
+			return
+		}
+		defer conn.Close()
+		_ = conn.WriteMessage(MessageBinary, mockPushMessage())
+	}))
+	defer srv.Close()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	dialer := websocket.Dialer{EnableCompression: true}
+	conn, resp, err := dialer.Dial(url, nil)
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Header.Get("Sec-Websocket-Extensions"), "permessage-deflate")
+	_, data, err := conn.ReadMessage()
+	assert.NoError(t, err)
+	assert.Equal(t, mockPushMessage()[:20], data[:20])
+	_ = conn.Close()
+
+	// The gzip mode of old SDKs must not be deflated a second time.
+	conn, resp, err = dialer.Dial(url+"?"+Compression+"="+GzipCompressionProtocol, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Header.Get("Sec-Websocket-Extensions"))
+	_ = conn.Close()
+}
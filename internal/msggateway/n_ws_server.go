@@ -48,6 +48,9 @@ type LongConnServer interface {
 	KickUserConn(client *Client) error
 	UnRegister(c *Client)
 	SetKickHandlerInfo(i *kickHandler)
+	Drain(deadline time.Duration) <-chan struct{}
+	IsDraining() bool
+	GetOnlineUserConnNum() int64
 	Compressor
 	Encoder
 	MessageHandler
@@ -66,6 +69,12 @@ type WsServer struct {
 	onlineUserConnNum atomic.Int64
 	handshakeTimeout  time.Duration
 	writeBufferSize   int
+	compression       compressionConfig
+	drain             drainConfig
+	draining          atomic.Bool
+	drainOnce         sync.Once
+	drained           chan struct{}
+	sseConns          sync.Map
 	validate          *validator.Validate
 	userClient        *rpcclient.UserRpcClient
 	authClient        *rpcclient.Auth
@@ -84,7 +93,7 @@ type kickHandler struct {
 
 func (ws *WsServer) SetDiscoveryRegistry(disCov discovery.SvcDiscoveryRegistry, config *Config) {
 	ws.MessageHandler = NewGrpcHandler(ws.validate, disCov, &config.Share.RpcRegisterName)
-	u := rpcclient.NewUserRpcClient(disCov, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID)
+	u := rpcclient.NewUserRpcClient(disCov, config.Share.RpcRegisterName.User, config.Share.AdminUserIDs)
 	ws.authClient = rpcclient.NewAuth(disCov, config.Share.RpcRegisterName.Auth)
 	ws.userClient = &u
 	ws.disCov = disCov
@@ -97,9 +106,9 @@ func (ws *WsServer) SetUserOnlineStatus(ctx context.Context, client *Client, sta
 	}
 	switch status {
 	case constant.Online:
-		ws.webhookAfterUserOnline(ctx, &ws.msgGatewayConfig.WebhooksConfig.AfterUserOnline, client.UserID, client.PlatformID, client.IsBackground, client.ctx.GetConnID())
+		ws.webhookAfterUserOnline(ctx, &ws.msgGatewayConfig.WebhooksConfig.Current().AfterUserOnline, client.UserID, client.PlatformID, client.IsBackground, client.ctx.GetConnID())
 	case constant.Offline:
-		ws.webhookAfterUserOffline(ctx, &ws.msgGatewayConfig.WebhooksConfig.AfterUserOffline, client.UserID, client.PlatformID, client.ctx.GetConnID())
+		ws.webhookAfterUserOffline(ctx, &ws.msgGatewayConfig.WebhooksConfig.Current().AfterUserOffline, client.UserID, client.PlatformID, client.ctx.GetConnID())
 	}
 }
 
@@ -130,6 +139,9 @@ func NewWsServer(msgGatewayConfig *Config, opts ...Option) (*WsServer, error) {
 		port:             config.port,
 		wsMaxConnNum:     config.maxConnNum,
 		writeBufferSize:  config.writeBufferSize,
+		compression:      config.compression,
+		drain:            config.drain.withDefaults(),
+		drained:          make(chan struct{}),
 		handshakeTimeout: config.handshakeTimeout,
 		clientPool: sync.Pool{
 			New: func() any {
@@ -143,7 +155,7 @@ func NewWsServer(msgGatewayConfig *Config, opts ...Option) (*WsServer, error) {
 		clients:         newUserMap(),
 		Compressor:      NewGzipCompressor(),
 		Encoder:         NewGobEncoder(),
-		webhookClient:   webhook.NewWebhookClient(msgGatewayConfig.WebhooksConfig.URL),
+		webhookClient:   webhook.NewWebhookClient(&msgGatewayConfig.WebhooksConfig),
 	}, nil
 }
 
@@ -179,19 +191,31 @@ func (ws *WsServer) Run(done chan error) error {
 			netErr = errs.WrapMsg(err, "ws start err", server.Addr)
 		}
 	}()
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
-	defer cancel()
-	var err error
-	select {
-	case err = <-done:
-		sErr := server.Shutdown(ctx)
-		if sErr != nil {
-			return errs.WrapMsg(sErr, "shutdown err")
+	shutdown := func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			return errs.WrapMsg(err, "shutdown err")
 		}
 		close(shutdownDone)
+		return nil
+	}
+	select {
+	case err := <-done:
+		// a drain started by SIGTERM or the admin api must move every client before the process exits
+		if ws.IsDraining() {
+			<-ws.drained
+		}
+		if sErr := shutdown(); sErr != nil {
+			return sErr
+		}
 		if err != nil {
 			return err
 		}
+	case <-ws.drained:
+		if sErr := shutdown(); sErr != nil {
+			return sErr
+		}
 	case <-netDone:
 	}
 	return netErr
@@ -368,10 +392,29 @@ func (ws *WsServer) validateRespWithRequest(ctx *UserConnContext, resp *pbAuth.P
 	return nil
 }
 
+func (ws *WsServer) newLongConn(connContext *UserConnContext) handshakeConn {
+	if connContext.IsSSE() {
+		return newSSEConn(connContext.GetConnID(), connContext.GetToken(), &ws.sseConns)
+	}
+	return newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize, ws.compression)
+}
+
 func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 	// Create a new connection context
 	connContext := newContext(w, r)
 
+	// Upstream frames of an existing SSE connection
+	if connContext.IsSSE() && r.Method == http.MethodPost {
+		ws.sseUpstreamHandler(connContext)
+		return
+	}
+
+	// A draining node only keeps serving the connections it already has
+	if ws.draining.Load() {
+		httpError(connContext, servererrs.ErrConnDraining.WrapMsg("gateway is draining"))
+		return
+	}
+
 	// Check if the current number of online user connections exceeds the maximum limit
 	if ws.onlineUserConnNum.Load() >= ws.wsMaxConnNum {
 		// If it exceeds the maximum connection number, return an error via HTTP and stop processing
@@ -394,8 +437,8 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		// If there's an error parsing the Token, decide whether to send the error message via WebSocket based on the context flag
 		shouldSendError := connContext.ShouldSendResp()
 		if shouldSendError {
-			// Create a WebSocket connection object and attempt to send the error message via WebSocket
-			wsLongConn := newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize)
+			// Create a long connection object and attempt to send the error message through it
+			wsLongConn := ws.newLongConn(connContext)
 			if err := wsLongConn.RespondWithError(err, w, r); err == nil {
 				// If the error message is successfully sent via WebSocket, stop processing
 				return
@@ -414,8 +457,8 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Create a WebSocket long connection object
-	wsLongConn := newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize)
+	// Create a WebSocket or SSE long connection object
+	wsLongConn := ws.newLongConn(connContext)
 	if err := wsLongConn.GenerateLongConn(w, r); err != nil {
 		//If the creation of the long connection fails, the error is handled internally during the handshake process.
 		log.ZWarn(connContext, "long connection fails", err)
@@ -439,4 +482,9 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 	// Register the client with the server and start message processing
 	ws.registerChan <- client
 	go client.readMessage()
+
+	// The SSE stream is the response of this request, keep it open until the connection is closed.
+	if sseConn, ok := wsLongConn.(*SSEConn); ok {
+		<-sseConn.Done()
+	}
 }
@@ -29,6 +29,29 @@ type (
 		messageMaxMsgLength int
 		// Websocket write buffer, default: 4096, 4kb.
 		writeBufferSize int
+		// permessage-deflate negotiation
+		compression compressionConfig
+		// connection draining before the node stops
+		drain drainConfig
+	}
+
+	// compressionConfig controls RFC 7692 permessage-deflate, negotiated without context takeover.
+	compressionConfig struct {
+		enable bool
+		// flate level used for outgoing messages
+		level int
+		// messages shorter than threshold bytes are not compressed
+		threshold int
+	}
+
+	// drainConfig controls how connections are handed over to other nodes when the gateway drains.
+	drainConfig struct {
+		// clients asked to reconnect per batch
+		batchSize int
+		// pause between two batches
+		interval time.Duration
+		// the node stops waiting for connections to leave after deadline
+		deadline time.Duration
 	}
 )
 
@@ -61,3 +84,15 @@ func WithWriteBufferSize(size int) Option {
 		opt.writeBufferSize = size
 	}
 }
+
+func WithCompression(enable bool, level, threshold int) Option {
+	return func(opt *configs) {
+		opt.compression = compressionConfig{enable: enable, level: level, threshold: threshold}
+	}
+}
+
+func WithDrain(batchSize int, interval, deadline time.Duration) Option {
+	return func(opt *configs) {
+		opt.drain = drainConfig{batchSize: batchSize, interval: interval, deadline: deadline}
+	}
+}
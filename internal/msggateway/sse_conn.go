@@ -0,0 +1,319 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/errs"
+)
+
+const sseFrameBufferSize = 64
+
+// sseFrame is an upstream frame posted by the client.
+type sseFrame struct {
+	messageType int
+	data        []byte
+}
+
+// SSEConn is a LongConn for clients that cannot upgrade to WebSocket.
+// Downstream frames are written as Server-Sent Events on the GET request that opened the connection:
+// an "open" event carrying the connID first, then "message" events for text frames and "binary" events
+// with base64 data for binary frames. Upstream frames are POSTed to the same path with transport=sse,
+// the connID and the token of the stream; an empty body is a ping.
+// Upstream requests must reach the gateway instance holding the stream, e.g. with sticky sessions.
+type SSEConn struct {
+	connID      string
+	token       string
+	registry    *sync.Map
+	w           http.ResponseWriter
+	flusher     http.Flusher
+	writeLock   sync.Mutex
+	frames      chan sseFrame
+	done        chan struct{}
+	closeOnce   sync.Once
+	lock        sync.Mutex
+	readLimit   int64
+	deadline    time.Time
+	pingHandler PingPongHandler
+	isNil       bool
+}
+
+func newSSEConn(connID, token string, registry *sync.Map) *SSEConn {
+	return &SSEConn{
+		connID:   connID,
+		token:    token,
+		registry: registry,
+		frames:   make(chan sseFrame, sseFrameBufferSize),
+		done:     make(chan struct{}),
+	}
+}
+
+func (s *SSEConn) GenerateLongConn(w http.ResponseWriter, r *http.Request) error {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return errs.New("GenerateLongConn: response writer does not support flushing")
+	}
+	s.w = w
+	s.flusher = flusher
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	// Stops nginx from buffering the stream.
+	header.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	data, err := json.Marshal(map[string]string{ConnID: s.connID})
+	if err != nil {
+		return errs.WrapMsg(err, "json marshal failed")
+	}
+	if err := s.writeEvent("open", data); err != nil {
+		return err
+	}
+	s.registry.Store(s.connID, s)
+	go func() {
+		select {
+		case <-r.Context().Done():
+			_ = s.Close()
+		case <-s.done:
+		}
+	}()
+	return nil
+}
+
+func (s *SSEConn) writeEvent(event string, data []byte) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	select {
+	case <-s.done:
+		return ErrConnClosed
+	default:
+	}
+	var buf bytes.Buffer
+	buf.WriteString("event: ")
+	buf.WriteString(event)
+	buf.WriteByte('\n')
+	// A line break inside the data would end the field, every line gets its own data field.
+	for _, line := range bytes.Split(data, []byte{'\n'}) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('\n')
+	if _, err := s.w.Write(buf.Bytes()); err != nil {
+		return errs.WrapMsg(err, "SSEConn.WriteMessage failed")
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+func (s *SSEConn) WriteMessage(messageType int, message []byte) error {
+	switch messageType {
+	case MessageText:
+		return s.writeEvent("message", message)
+	case MessageBinary:
+		return s.writeEvent("binary", []byte(base64.StdEncoding.EncodeToString(message)))
+	case PongMessage, PingMessage:
+		return s.writeEvent("pong", nil)
+	case CloseMessage:
+		return s.writeEvent("close", message)
+	default:
+		return ErrNotSupportMessageProtocol
+	}
+}
+
+// ReadMessage returns the next frame posted by the client, answering pings like gorilla/websocket does.
+func (s *SSEConn) ReadMessage() (int, []byte, error) {
+	for {
+		s.lock.Lock()
+		deadline := s.deadline
+		pingHandler := s.pingHandler
+		s.lock.Unlock()
+		var (
+			timer   *time.Timer
+			timeout <-chan time.Time
+		)
+		if !deadline.IsZero() {
+			timer = time.NewTimer(time.Until(deadline))
+			timeout = timer.C
+		}
+		var frame sseFrame
+		select {
+		case frame = <-s.frames:
+		case <-s.done:
+			return 0, nil, ErrConnClosed
+		case <-timeout:
+			return 0, nil, errs.New("sse read timeout", "connID", s.connID)
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if frame.messageType == PingMessage {
+			if pingHandler != nil {
+				if err := pingHandler(""); err != nil {
+					return 0, nil, err
+				}
+			}
+			continue
+		}
+		return frame.messageType, frame.data, nil
+	}
+}
+
+// deliver hands an upstream frame to ReadMessage.
+func (s *SSEConn) deliver(r *http.Request, frame sseFrame) error {
+	select {
+	case s.frames <- frame:
+		return nil
+	case <-s.done:
+		return ErrConnClosed
+	case <-r.Context().Done():
+		return errs.Wrap(r.Context().Err())
+	}
+}
+
+func (s *SSEConn) Close() error {
+	s.closeOnce.Do(func() {
+		// Takes the write lock so no event is half written when the handler returns.
+		s.writeLock.Lock()
+		close(s.done)
+		s.writeLock.Unlock()
+		s.registry.CompareAndDelete(s.connID, s)
+	})
+	return nil
+}
+
+// Done is closed once the connection is closed, the handler serving the stream must not return before.
+func (s *SSEConn) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *SSEConn) SetReadDeadline(timeout time.Duration) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.deadline = time.Now().Add(timeout)
+	return nil
+}
+
+func (s *SSEConn) SetWriteDeadline(timeout time.Duration) error {
+	if timeout <= 0 {
+		return errs.New("timeout must be greater than 0")
+	}
+	err := http.NewResponseController(s.w).SetWriteDeadline(time.Now().Add(timeout))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return errs.WrapMsg(err, "SSEConn.SetWriteDeadline failed")
+	}
+	return nil
+}
+
+func (s *SSEConn) Dial(_ string, _ http.Header) (*http.Response, error) {
+	return nil, errs.New("SSEConn does not support dial")
+}
+
+func (s *SSEConn) IsNil() bool {
+	return s.isNil
+}
+
+func (s *SSEConn) SetConnNil() {
+	s.isNil = true
+}
+
+func (s *SSEConn) SetReadLimit(limit int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.readLimit = limit
+}
+
+func (s *SSEConn) SetPongHandler(_ PingPongHandler) {}
+
+func (s *SSEConn) SetPingHandler(handler PingPongHandler) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.pingHandler = handler
+}
+
+func (s *SSEConn) RespondWithError(err error, w http.ResponseWriter, r *http.Request) error {
+	if err := s.GenerateLongConn(w, r); err != nil {
+		return err
+	}
+	defer s.Close()
+	data, err := json.Marshal(apiresp.ParseError(err))
+	if err != nil {
+		return errs.WrapMsg(err, "json marshal failed")
+	}
+	return s.WriteMessage(MessageText, data)
+}
+
+func (s *SSEConn) RespondWithSuccess() error {
+	data, err := json.Marshal(apiresp.ParseError(nil))
+	if err != nil {
+		_ = s.Close()
+		return errs.WrapMsg(err, "json marshal failed")
+	}
+	if err := s.WriteMessage(MessageText, data); err != nil {
+		_ = s.Close()
+		return err
+	}
+	return nil
+}
+
+// sseUpstreamHandler delivers a frame POSTed by an SSE client to its connection.
+func (ws *WsServer) sseUpstreamHandler(connContext *UserConnContext) {
+	connID, _ := connContext.Query(ConnID)
+	value, ok := ws.sseConns.Load(connID)
+	if !ok {
+		httpError(connContext, servererrs.ErrConnArgsErr.WrapMsg("sse connection not found", "connID", connID))
+		return
+	}
+	conn := value.(*SSEConn)
+	if conn.token != connContext.GetToken() {
+		httpError(connContext, servererrs.ErrTokenInvalid.WrapMsg("token does not match the sse connection"))
+		return
+	}
+	conn.lock.Lock()
+	readLimit := conn.readLimit
+	conn.lock.Unlock()
+	r := connContext.Req
+	body := io.Reader(r.Body)
+	if readLimit > 0 {
+		body = http.MaxBytesReader(connContext.RespWriter, r.Body, readLimit)
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		httpError(connContext, servererrs.ErrConnArgsErr.WrapMsg("read sse frame failed: "+err.Error()))
+		return
+	}
+	frame := sseFrame{messageType: MessageText, data: data}
+	switch {
+	case len(data) == 0:
+		frame.messageType = PingMessage
+	case r.Header.Get("Content-Type") == "application/octet-stream":
+		frame.messageType = MessageBinary
+	}
+	if err := conn.deliver(r, frame); err != nil {
+		httpError(connContext, err)
+		return
+	}
+	apiresp.HttpSuccess(connContext.RespWriter, nil)
+}
@@ -0,0 +1,108 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"bufio"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// readEvent reads one Server-Sent Event and returns its name and data.
+func readEvent(t *testing.T, r *bufio.Reader) (string, string) {
+	var event, data string
+	for {
+		line, err := r.ReadString('\n')
+		assert.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			return event, data
+		case strings.HasPrefix(line, "event: "):
+			event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			data += strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func TestSSEConn(t *testing.T) {
+	ws := &WsServer{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := newContext(w, r)
+		if r.Method == http.MethodPost {
+			ws.sseUpstreamHandler(ctx)
+			return
+		}
+		conn := newSSEConn("conn1", ctx.GetToken(), &ws.sseConns)
+		if err := conn.GenerateLongConn(w, r); err != nil {
+			return
+		}
+		conn.SetPingHandler(func(string) error { return conn.WriteMessage(PongMessage, nil) })
+		_ = conn.SetReadDeadline(time.Second)
+		// Echo every upstream frame back downstream.
+		go func() {
+			for {
+				messageType, data, err := conn.ReadMessage()
+				if err != nil {
+					return
+				}
+				_ = conn.WriteMessage(messageType, data)
+			}
+		}()
+		<-conn.Done()
+	}))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "?transport=sse&token=t1")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	stream := bufio.NewReader(resp.Body)
+	event, data := readEvent(t, stream)
+	assert.Equal(t, "open", event)
+	assert.JSONEq(t, `{"connID":"conn1"}`, data)
+
+	post := func(token, contentType, body string) int {
+		resp, err := http.Post(srv.URL+"?transport=sse&connID=conn1&token="+token, contentType, strings.NewReader(body))
+		assert.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	post("t1", "application/json", `{"reqIdentifier":1001}`)
+	event, data = readEvent(t, stream)
+	assert.Equal(t, "message", event)
+	assert.Equal(t, `{"reqIdentifier":1001}`, data)
+
+	post("t1", "application/octet-stream", "\x01\x02")
+	event, data = readEvent(t, stream)
+	assert.Equal(t, "binary", event)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte{1, 2}), data)
+
+	post("t1", "text/plain", "")
+	event, _ = readEvent(t, stream)
+	assert.Equal(t, "pong", event)
+
+	// A request carrying another token must not inject frames.
+	post("t2", "application/json", `{"reqIdentifier":1003}`)
+	_, ok := ws.sseConns.Load("conn1")
+	assert.True(t, ok)
+}
@@ -130,6 +130,18 @@ func (u *UserMap) deleteClients(key string, clients []*Client) (isDeleteUser boo
 	return false
 }
 
+// Range calls f for every client until f returns false.
+func (u *UserMap) Range(f func(client *Client) bool) {
+	u.m.Range(func(_, value any) bool {
+		for _, client := range value.([]*Client) {
+			if !f(client) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
 func (u *UserMap) DeleteAll(key string) {
 	u.m.Delete(key)
 }
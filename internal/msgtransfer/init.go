@@ -17,8 +17,7 @@ package msgtransfer
 import (
 	"context"
 	"fmt"
-	"github.com/openimsdk/tools/db/mongoutil"
-	"github.com/openimsdk/tools/db/redisutil"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/dbconn"
 	"github.com/openimsdk/tools/utils/datautil"
 	"net/http"
 	"os"
@@ -31,6 +30,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/mgo"
 	kdisc "github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
@@ -63,16 +63,17 @@ type Config struct {
 	ZookeeperConfig config.ZooKeeper
 	Share           config.Share
 	WebhooksConfig  config.Webhooks
+	SearchConfig    config.Search
 }
 
 func Start(ctx context.Context, index int, config *Config) error {
 	log.CInfo(ctx, "MSG-TRANSFER server is initializing", "prometheusPorts",
 		config.MsgTransfer.Prometheus.Ports, "index", index)
-	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
+	mgocli, err := dbconn.NewMongoDB(ctx, config.MongodbConfig.Build())
 	if err != nil {
 		return err
 	}
-	rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
+	rdb, err := dbconn.NewRedisClient(ctx, config.RedisConfig.Build())
 	if err != nil {
 		return err
 	}
@@ -80,7 +81,7 @@ func Start(ctx context.Context, index int, config *Config) error {
 	if err != nil {
 		return err
 	}
-	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()),
+	client.AddOption(mw.GrpcClient(), tracing.GrpcDialOption(), grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
 	//todo MsgCacheTimeout
 	msgModel := cache.NewMsgCache(rdb, config.RedisConfig.EnablePipeline)
@@ -93,22 +94,43 @@ func Start(ctx context.Context, index int, config *Config) error {
 	if err != nil {
 		return err
 	}
+	threadModel, err := mgo.NewThreadMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	threadParticipantModel, err := mgo.NewThreadParticipantMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	threadDatabase := controller.NewThreadDatabase(threadModel, threadParticipantModel,
+		cache.NewThreadCacheRedis(rdb, threadModel, threadParticipantModel, cache.GetDefaultOpt()))
 	conversationRpcClient := rpcclient.NewConversationRpcClient(client, config.Share.RpcRegisterName.Conversation)
 	groupRpcClient := rpcclient.NewGroupRpcClient(client, config.Share.RpcRegisterName.Group)
-	msgTransfer, err := NewMsgTransfer(&config.KafkaConfig, msgDatabase, &conversationRpcClient, &groupRpcClient)
+	var (
+		msgRpcClient   *rpcclient.MessageRpcClient
+		searchBackfill *searchBackfill
+	)
+	if config.SearchConfig.Enable != "" {
+		client := rpcclient.NewMessageRpcClient(client, config.Share.RpcRegisterName.Msg)
+		msgRpcClient = &client
+		searchBackfill = newSearchBackfill(&config.SearchConfig, cache.NewSearchBackfillCache(rdb), msgDatabase, msgRpcClient)
+	}
+	msgTransfer, err := NewMsgTransfer(&config.KafkaConfig, msgDatabase, threadDatabase, &conversationRpcClient, &groupRpcClient,
+		msgRpcClient, searchBackfill)
 	if err != nil {
 		return err
 	}
 	return msgTransfer.Start(index, config)
 }
 
-func NewMsgTransfer(kafkaConf *config.Kafka, msgDatabase controller.CommonMsgDatabase,
-	conversationRpcClient *rpcclient.ConversationRpcClient, groupRpcClient *rpcclient.GroupRpcClient) (*MsgTransfer, error) {
-	historyCH, err := NewOnlineHistoryRedisConsumerHandler(kafkaConf, msgDatabase, conversationRpcClient, groupRpcClient)
+func NewMsgTransfer(kafkaConf *config.Kafka, msgDatabase controller.CommonMsgDatabase, threadDatabase controller.ThreadDatabase,
+	conversationRpcClient *rpcclient.ConversationRpcClient, groupRpcClient *rpcclient.GroupRpcClient,
+	msgRpcClient *rpcclient.MessageRpcClient, searchBackfill *searchBackfill) (*MsgTransfer, error) {
+	historyCH, err := NewOnlineHistoryRedisConsumerHandler(kafkaConf, msgDatabase, threadDatabase, conversationRpcClient, groupRpcClient)
 	if err != nil {
 		return nil, err
 	}
-	historyMongoCH, err := NewOnlineHistoryMongoConsumerHandler(kafkaConf, msgDatabase)
+	historyMongoCH, err := NewOnlineHistoryMongoConsumerHandler(kafkaConf, msgDatabase, msgRpcClient, searchBackfill)
 	if err != nil {
 		return nil, err
 	}
@@ -133,6 +155,9 @@ func (m *MsgTransfer) Start(index int, config *Config) error {
 
 	go m.historyCH.historyConsumerGroup.RegisterHandleAndConsumer(m.ctx, m.historyCH)
 	go m.historyMongoCH.historyConsumerGroup.RegisterHandleAndConsumer(m.ctx, m.historyMongoCH)
+	if m.historyMongoCH.searchBackfill != nil {
+		go m.historyMongoCH.searchBackfill.Run(m.ctx)
+	}
 
 	if config.MsgTransfer.Prometheus.Enable {
 		go func() {
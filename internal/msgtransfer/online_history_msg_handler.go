@@ -26,6 +26,7 @@ import (
 	"github.com/go-redis/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
@@ -36,6 +37,8 @@ import (
 	"github.com/openimsdk/tools/mq/kafka"
 	"github.com/openimsdk/tools/utils/idutil"
 	"github.com/openimsdk/tools/utils/stringutil"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -68,6 +71,7 @@ type ContextMsg struct {
 
 type OnlineHistoryRedisConsumerHandler struct {
 	historyConsumerGroup *kafka.MConsumerGroup
+	groupID              string
 	chArrays             [ChannelNum]chan Cmd2Value
 	msgDistributionCh    chan Cmd2Value
 
@@ -77,18 +81,21 @@ type OnlineHistoryRedisConsumerHandler struct {
 	// singleMsgFailedCountMutex  sync.Mutex
 
 	msgDatabase           controller.CommonMsgDatabase
+	threadDatabase        controller.ThreadDatabase
 	conversationRpcClient *rpcclient.ConversationRpcClient
 	groupRpcClient        *rpcclient.GroupRpcClient
 }
 
-func NewOnlineHistoryRedisConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase,
+func NewOnlineHistoryRedisConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase, threadDatabase controller.ThreadDatabase,
 	conversationRpcClient *rpcclient.ConversationRpcClient, groupRpcClient *rpcclient.GroupRpcClient) (*OnlineHistoryRedisConsumerHandler, error) {
 	historyConsumerGroup, err := kafka.NewMConsumerGroup(kafkaConf.Build(), kafkaConf.ToRedisGroupID, []string{kafkaConf.ToRedisTopic})
 	if err != nil {
 		return nil, err
 	}
 	var och OnlineHistoryRedisConsumerHandler
+	och.groupID = kafkaConf.ToRedisGroupID
 	och.msgDatabase = database
+	och.threadDatabase = threadDatabase
 	och.msgDistributionCh = make(chan Cmd2Value) // no buffer channel
 	go och.MessagesDistributionHandle()
 	for i := 0; i < ChannelNum; i++ {
@@ -107,7 +114,12 @@ func (och *OnlineHistoryRedisConsumerHandler) Run(channelID int) {
 		case SourceMessages:
 			msgChannelValue := cmd.Value.(MsgChannelValue)
 			ctxMsgList := msgChannelValue.ctxMsgList
-			ctx := msgChannelValue.ctx
+			msgCtxs := make([]context.Context, 0, len(ctxMsgList))
+			for _, ctxMsg := range ctxMsgList {
+				msgCtxs = append(msgCtxs, ctxMsg.ctx)
+			}
+			ctx, span := tracing.StartLinked(msgChannelValue.ctx, "msgtransfer handle messages", msgCtxs,
+				trace.WithAttributes(attribute.Int("messages", len(ctxMsgList))))
 			log.ZDebug(
 				ctx,
 				"msg arrived channel",
@@ -148,6 +160,7 @@ func (och *OnlineHistoryRedisConsumerHandler) Run(channelID int) {
 			if err := och.msgDatabase.MsgToModifyMQ(ctx, msgChannelValue.uniqueKey, conversationIDNotification, modifyMsgList); err != nil {
 				log.ZError(ctx, "msg to modify mq error", err, "uniqueKey", msgChannelValue.uniqueKey, "modifyMsgList", modifyMsgList)
 			}
+			span.End()
 		}
 	}
 }
@@ -271,6 +284,8 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(ctx context.Context, key
 							"conversationID", conversationID)
 					}
 				}
+			case msgprocessor.ThreadChatType:
+				// threads are listed by participation instead of conversations
 			case constant.SingleChatType, constant.NotificationChatType:
 				if err := och.conversationRpcClient.SingleChatFirstCreateConversation(ctx, storageList[0].RecvID,
 					storageList[0].SendID, conversationID, storageList[0].SessionType); err != nil {
@@ -283,6 +298,12 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(ctx context.Context, key
 			}
 		}
 
+		if storageList[0].SessionType == msgprocessor.ThreadChatType {
+			if err := och.threadDatabase.AddReplies(ctx, conversationID, storageList); err != nil {
+				log.ZWarn(ctx, "add thread replies error", err, "conversationID", conversationID)
+			}
+		}
+
 		log.ZDebug(ctx, "success incr to next topic")
 		err = och.msgDatabase.MsgToMongoMQ(ctx, key, conversationID, storageList, lastSeq)
 		if err != nil {
@@ -318,7 +339,10 @@ func (och *OnlineHistoryRedisConsumerHandler) MessagesDistributionHandle() {
 					}
 					log.ZInfo(ctx, "consumer.kafka.GetContextWithMQHeader", "len", len(consumerMessages[i].Headers),
 						"header", strings.Join(arr, ", "))
-					ctxMsg.ctx = kafka.GetContextWithMQHeader(consumerMessages[i].Headers)
+					msgCtx, span := tracing.StartConsume(consumerMessages[i], och.groupID)
+					// the messages are handled in batches, the span of the batch continues their traces
+					span.End()
+					ctxMsg.ctx = msgCtx
 					ctxMsg.message = msgFromMQ
 					log.ZDebug(
 						ctx,
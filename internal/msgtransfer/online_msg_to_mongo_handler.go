@@ -21,6 +21,8 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	pbmsg "github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mq/kafka"
@@ -29,10 +31,16 @@ import (
 
 type OnlineHistoryMongoConsumerHandler struct {
 	historyConsumerGroup *kafka.MConsumerGroup
+	groupID              string
 	msgDatabase          controller.CommonMsgDatabase
+	// msgRpcClient feeds the search index, nil when it is disabled
+	msgRpcClient *rpcclient.MessageRpcClient
+	// searchBackfill indexes again the messages msgRpcClient failed to index, nil when the index is disabled
+	searchBackfill *searchBackfill
 }
 
-func NewOnlineHistoryMongoConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase) (*OnlineHistoryMongoConsumerHandler, error) {
+func NewOnlineHistoryMongoConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase,
+	msgRpcClient *rpcclient.MessageRpcClient, searchBackfill *searchBackfill) (*OnlineHistoryMongoConsumerHandler, error) {
 	historyConsumerGroup, err := kafka.NewMConsumerGroup(kafkaConf.Build(), kafkaConf.ToMongoGroupID, []string{kafkaConf.ToMongoTopic})
 	if err != nil {
 		return nil, err
@@ -40,7 +48,10 @@ func NewOnlineHistoryMongoConsumerHandler(kafkaConf *config.Kafka, database cont
 
 	mc := &OnlineHistoryMongoConsumerHandler{
 		historyConsumerGroup: historyConsumerGroup,
+		groupID:              kafkaConf.ToMongoGroupID,
 		msgDatabase:          database,
+		msgRpcClient:         msgRpcClient,
+		searchBackfill:       searchBackfill,
 	}
 	return mc, nil
 }
@@ -72,6 +83,14 @@ func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(ctx context.Cont
 		prommetrics.MsgInsertMongoFailedCounter.Inc()
 	} else {
 		prommetrics.MsgInsertMongoSuccessCounter.Inc()
+		if mc.msgRpcClient != nil {
+			if err := mc.msgRpcClient.IndexMsgs(ctx, msgFromMQ.ConversationID, msgFromMQ.MsgData); err != nil {
+				log.ZWarn(ctx, "index msgs failed", err, "conversationID", msgFromMQ.ConversationID)
+				if err := mc.searchBackfill.Add(ctx, msgFromMQ.ConversationID, msgFromMQ.MsgData, err); err != nil {
+					log.ZError(ctx, "add search backfill task failed", err, "conversationID", msgFromMQ.ConversationID)
+				}
+			}
+		}
 	}
 	var seqs []int64
 	for _, msg := range msgFromMQ.MsgData {
@@ -102,12 +121,13 @@ func (mc *OnlineHistoryMongoConsumerHandler) ConsumeClaim(
 	log.ZDebug(context.Background(), "online new session msg come", "highWaterMarkOffset",
 		claim.HighWaterMarkOffset(), "topic", claim.Topic(), "partition", claim.Partition())
 	for msg := range claim.Messages() {
-		ctx := mc.historyConsumerGroup.GetContextFromMsg(msg)
+		ctx, span := tracing.StartConsume(msg, mc.groupID)
 		if len(msg.Value) != 0 {
 			mc.handleChatWs2Mongo(ctx, msg, string(msg.Key), sess)
 		} else {
 			log.ZError(ctx, "mongo msg get from kafka but is nil", nil, "conversationID", msg.Key)
 		}
+		span.End()
 		sess.MarkMessage(msg, "")
 	}
 	return nil
@@ -0,0 +1,186 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgtransfer
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+const (
+	defaultBackfillInterval   = 10
+	defaultBackfillBackoff    = 10
+	defaultBackfillMaxBackoff = 600
+	defaultBackfillBatchSize  = 100
+	backfillLease             = 5 * time.Minute
+)
+
+// msgIndexer is the search index rpc of openim-rpc-msg.
+type msgIndexer interface {
+	IndexMsgs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error
+}
+
+// backfillTask is a batch of messages whose indexing failed, stored as JSON in the backfill queue. Only the seqs
+// are kept, the messages are read back from MongoDB so edits, revokes and deletes made meanwhile are indexed.
+type backfillTask struct {
+	OperationID    string  `json:"operationID"`
+	ConversationID string  `json:"conversationID"`
+	Seqs           []int64 `json:"seqs"`
+	Attempts       int     `json:"attempts"`
+	LastError      string  `json:"lastError"`
+}
+
+// id is unique per conversation and first seq, so a batch queued twice is only indexed once.
+func (t *backfillTask) id() string {
+	return t.ConversationID + ":" + strconv.FormatInt(t.Seqs[0], 10)
+}
+
+// searchBackfill indexes again the messages the mongo consumer failed to index, until it succeeds.
+type searchBackfill struct {
+	cache       cache.SearchBackfillCache
+	msgDatabase controller.CommonMsgDatabase
+	indexer     msgIndexer
+	interval    time.Duration
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	batchSize   int
+}
+
+func newSearchBackfill(searchConf *config.Search, cache cache.SearchBackfillCache, msgDatabase controller.CommonMsgDatabase,
+	indexer msgIndexer) *searchBackfill {
+	conf := searchConf.Backfill
+	positive := func(v, def int) int {
+		if v <= 0 {
+			return def
+		}
+		return v
+	}
+	return &searchBackfill{
+		cache:       cache,
+		msgDatabase: msgDatabase,
+		indexer:     indexer,
+		interval:    time.Duration(positive(conf.Interval, defaultBackfillInterval)) * time.Second,
+		backoff:     time.Duration(positive(conf.Backoff, defaultBackfillBackoff)) * time.Second,
+		maxBackoff:  time.Duration(positive(conf.MaxBackoff, defaultBackfillMaxBackoff)) * time.Second,
+		batchSize:   positive(conf.BatchSize, defaultBackfillBatchSize),
+	}
+}
+
+// Add queues the messages of a failed indexing.
+func (b *searchBackfill) Add(ctx context.Context, conversationID string, msgs []*sdkws.MsgData, indexErr error) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	task := &backfillTask{
+		OperationID:    mcontext.GetOperationID(ctx),
+		ConversationID: conversationID,
+		Seqs:           make([]int64, 0, len(msgs)),
+		Attempts:       1,
+		LastError:      indexErr.Error(),
+	}
+	for _, msg := range msgs {
+		task.Seqs = append(task.Seqs, msg.Seq)
+	}
+	return b.reschedule(ctx, task)
+}
+
+// backoffDelay returns the delay before the next attempt of a task that has already been tried attempts times.
+func (b *searchBackfill) backoffDelay(attempts int) time.Duration {
+	delay := b.backoff
+	for i := 1; i < attempts && delay < b.maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, b.maxBackoff)
+}
+
+func (b *searchBackfill) reschedule(ctx context.Context, task *backfillTask) error {
+	data, err := json.Marshal(task)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return b.cache.AddBackfillTask(ctx, task.id(), string(data), time.Now().Add(b.backoffDelay(task.Attempts)))
+}
+
+// Run indexes due tasks until ctx is done.
+func (b *searchBackfill) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b.backfillDue(ctx)
+		}
+	}
+}
+
+func (b *searchBackfill) backfillDue(ctx context.Context) {
+	for {
+		now := time.Now()
+		tasks, err := b.cache.LeaseDueBackfillTasks(ctx, now, now.Add(backfillLease), b.batchSize)
+		if err != nil {
+			log.ZError(ctx, "lease search backfill tasks failed", err)
+			return
+		}
+		for id, data := range tasks {
+			var task backfillTask
+			if err := json.Unmarshal([]byte(data), &task); err != nil || len(task.Seqs) == 0 {
+				log.ZError(ctx, "unmarshal search backfill task failed", err, "task", data)
+				b.ack(ctx, id)
+				continue
+			}
+			b.backfill(mcontext.SetOperationID(ctx, task.OperationID), id, &task)
+		}
+		if len(tasks) < b.batchSize {
+			return
+		}
+	}
+}
+
+func (b *searchBackfill) ack(ctx context.Context, id string) {
+	if err := b.cache.AckBackfillTask(ctx, id); err != nil {
+		log.ZError(ctx, "ack search backfill task failed", err, "id", id)
+	}
+}
+
+// backfill indexes a leased task, it is acked once indexed and rescheduled otherwise.
+func (b *searchBackfill) backfill(ctx context.Context, id string, task *backfillTask) {
+	_, _, msgs, err := b.msgDatabase.GetMsgBySeqs(ctx, "", task.ConversationID, task.Seqs)
+	if err == nil && len(msgs) > 0 {
+		err = b.indexer.IndexMsgs(ctx, task.ConversationID, msgs)
+	}
+	if err == nil {
+		log.ZDebug(ctx, "search backfill success", "id", id, "attempts", task.Attempts+1)
+		b.ack(ctx, id)
+		return
+	}
+	log.ZWarn(ctx, "search backfill failed", err, "id", id, "attempts", task.Attempts+1)
+	task.Attempts++
+	task.LastError = err.Error()
+	if err := b.reschedule(ctx, task); err != nil {
+		log.ZError(ctx, "reschedule search backfill task failed", err, "id", id)
+	}
+}
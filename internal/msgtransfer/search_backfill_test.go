@@ -0,0 +1,127 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgtransfer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockSearchBackfillCache struct {
+	cache.SearchBackfillCache
+	tasks map[string]string
+	due   map[string]time.Time
+}
+
+func (m *mockSearchBackfillCache) AddBackfillTask(ctx context.Context, id string, task string, next time.Time) error {
+	m.tasks[id] = task
+	m.due[id] = next
+	return nil
+}
+
+func (m *mockSearchBackfillCache) LeaseDueBackfillTasks(ctx context.Context, now time.Time, leaseUntil time.Time, count int) (map[string]string, error) {
+	tasks := make(map[string]string)
+	for id, due := range m.due {
+		if len(tasks) < count && !due.After(now) {
+			tasks[id] = m.tasks[id]
+			m.due[id] = leaseUntil
+		}
+	}
+	return tasks, nil
+}
+
+func (m *mockSearchBackfillCache) AckBackfillTask(ctx context.Context, id string) error {
+	delete(m.tasks, id)
+	delete(m.due, id)
+	return nil
+}
+
+type mockBackfillMsgDatabase struct {
+	controller.CommonMsgDatabase
+	msgs map[int64]*sdkws.MsgData
+}
+
+func (m *mockBackfillMsgDatabase) GetMsgBySeqs(ctx context.Context, userID string, conversationID string, seqs []int64) (int64, int64, []*sdkws.MsgData, error) {
+	var msgs []*sdkws.MsgData
+	for _, seq := range seqs {
+		if msg, ok := m.msgs[seq]; ok {
+			msgs = append(msgs, msg)
+		}
+	}
+	return 0, 0, msgs, nil
+}
+
+type mockIndexer struct {
+	err     error
+	indexed []int64
+}
+
+func (m *mockIndexer) IndexMsgs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error {
+	if m.err != nil {
+		return m.err
+	}
+	for _, msg := range msgs {
+		m.indexed = append(m.indexed, msg.Seq)
+	}
+	return nil
+}
+
+func TestSearchBackfill(t *testing.T) {
+	ctx := context.Background()
+	backfillCache := &mockSearchBackfillCache{tasks: make(map[string]string), due: make(map[string]time.Time)}
+	indexer := &mockIndexer{err: errors.New("index unavailable")}
+	msgs := []*sdkws.MsgData{{Seq: 11, Content: []byte("a")}, {Seq: 12, Content: []byte("b")}}
+	b := &searchBackfill{
+		cache:       backfillCache,
+		msgDatabase: &mockBackfillMsgDatabase{msgs: map[int64]*sdkws.MsgData{11: msgs[0], 12: msgs[1]}},
+		indexer:     indexer,
+		backoff:     time.Millisecond,
+		maxBackoff:  4 * time.Millisecond,
+		batchSize:   10,
+	}
+
+	assert.NoError(t, b.Add(ctx, "sg_g1", msgs, indexer.err))
+	assert.Contains(t, backfillCache.tasks, "sg_g1:11")
+
+	// The index is still down, the task is rescheduled with one more attempt.
+	time.Sleep(2 * time.Millisecond)
+	b.backfillDue(ctx)
+	var task backfillTask
+	assert.NoError(t, json.Unmarshal([]byte(backfillCache.tasks["sg_g1:11"]), &task))
+	assert.Equal(t, 2, task.Attempts)
+	assert.Equal(t, []int64{11, 12}, task.Seqs)
+
+	// Once the index is back the messages are read from the database, indexed and the task acked.
+	indexer.err = nil
+	time.Sleep(5 * time.Millisecond)
+	b.backfillDue(ctx)
+	assert.Equal(t, []int64{11, 12}, indexer.indexed)
+	assert.Empty(t, backfillCache.tasks)
+}
+
+func TestSearchBackfillDelay(t *testing.T) {
+	b := &searchBackfill{backoff: 10 * time.Second, maxBackoff: 60 * time.Second}
+	assert.Equal(t, 10*time.Second, b.backoffDelay(1))
+	assert.Equal(t, 40*time.Second, b.backoffDelay(3))
+	assert.Equal(t, 60*time.Second, b.backoffDelay(10))
+}
@@ -0,0 +1,255 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/util/conversationutil"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/idutil"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	digestFlushInterval = time.Second
+	digestBatchSize     = 500
+
+	digestReadSeqConcurrency = 10
+)
+
+// pushAggregator coalesces the offline pushes of a group chat per member within a window.
+type pushAggregator struct {
+	cache   cache.PushDigestCache
+	enable  bool
+	window  time.Duration
+	windows map[string]time.Duration
+}
+
+func newPushAggregator(pushConf *config.Push, cache cache.PushDigestCache) *pushAggregator {
+	conf := pushConf.Aggregation
+	windows := make(map[string]time.Duration)
+	for _, conversation := range conf.Conversations {
+		for _, conversationID := range conversation.ConversationIDs {
+			windows[conversationID] = time.Duration(conversation.Window) * time.Second
+		}
+	}
+	return &pushAggregator{
+		cache:   cache,
+		enable:  conf.Enable,
+		window:  time.Duration(conf.Window) * time.Second,
+		windows: windows,
+	}
+}
+
+func (a *pushAggregator) conversationWindow(conversationID string) time.Duration {
+	if !a.enable {
+		return 0
+	}
+	if window, ok := a.windows[conversationID]; ok {
+		return window
+	}
+	return a.window
+}
+
+// digestMember identifies the window of one member in one group.
+type digestMember struct {
+	GroupID string `json:"g"`
+	UserID  string `json:"u"`
+}
+
+func (m digestMember) String() string {
+	data, _ := json.Marshal(m)
+	return string(data)
+}
+
+// Collapse returns the receivers that are pushed at once: those opening a new window and those @mentioned.
+// Everyone else is counted into the digest pushed when their window closes.
+func (a *pushAggregator) Collapse(ctx context.Context, msg *sdkws.MsgData, userIDs []string) []string {
+	if msg.SessionType != constant.ReadGroupChatType || msgprocessor.IsNotificationByMsg(msg) {
+		return userIDs
+	}
+	window := a.conversationWindow(msgprocessor.GetConversationIDByMsg(msg))
+	if window <= 0 {
+		return userIDs
+	}
+	atUsers := atUserIDs(msg)
+	pushUserIDs := make([]string, 0, len(userIDs))
+	members := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if datautil.Contain(userID, atUsers...) {
+			pushUserIDs = append(pushUserIDs, userID)
+			continue
+		}
+		members = append(members, digestMember{GroupID: msg.GroupID, UserID: userID}.String())
+	}
+	opened, err := a.cache.Collapse(ctx, members, time.Now().Add(window))
+	if err != nil {
+		log.ZWarn(ctx, "collapse offline push failed, push every receiver", err, "groupID", msg.GroupID)
+		return userIDs
+	}
+	for _, member := range opened {
+		var m digestMember
+		if err := json.Unmarshal([]byte(member), &m); err == nil {
+			pushUserIDs = append(pushUserIDs, m.UserID)
+		}
+	}
+	log.ZDebug(ctx, "offline push collapsed", "groupID", msg.GroupID, "push", len(pushUserIDs), "collapsed", len(userIDs)-len(pushUserIDs))
+	return pushUserIDs
+}
+
+// runPushDigest pushes a summary for every closed window that counted more than the message pushed when it opened.
+func (c *ConsumerHandler) runPushDigest(ctx context.Context) {
+	if !c.aggregator.enable {
+		return
+	}
+	ticker := time.NewTicker(digestFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.pushDueDigests(mcontext.SetOperationID(ctx, idutil.OperationIDGenerator()))
+		}
+	}
+}
+
+func (c *ConsumerHandler) pushDueDigests(ctx context.Context) {
+	for {
+		digests, err := c.aggregator.cache.PopDueDigests(ctx, time.Now(), digestBatchSize)
+		if err != nil {
+			log.ZError(ctx, "pop offline push digests failed", err)
+			return
+		}
+		groups := make(map[string]map[string]int64)
+		for member, count := range digests {
+			var m digestMember
+			if err := json.Unmarshal([]byte(member), &m); err != nil {
+				log.ZError(ctx, "unmarshal offline push digest member failed", err, "member", member)
+				continue
+			}
+			// The first message of the window has already been pushed on its own.
+			if count <= 1 {
+				continue
+			}
+			if groups[m.GroupID] == nil {
+				groups[m.GroupID] = make(map[string]int64)
+			}
+			groups[m.GroupID][m.UserID] = count - 1
+		}
+		for groupID, counts := range groups {
+			receivers, err := c.digestReceivers(ctx, groupID, counts)
+			if err != nil {
+				log.ZWarn(ctx, "check offline digest receivers failed", err, "groupID", groupID)
+				continue
+			}
+			for count, userIDs := range receivers {
+				if err := c.pushDigest(ctx, groupID, count, userIDs); err != nil {
+					log.ZWarn(ctx, "push offline digest failed", err, "groupID", groupID, "userIDs", userIDs)
+				}
+			}
+		}
+		if len(digests) < digestBatchSize {
+			return
+		}
+	}
+}
+
+// digestReceivers drops the members who came online or read the group since their window opened,
+// and groups the others by the number of messages still unread.
+func (c *ConsumerHandler) digestReceivers(ctx context.Context, groupID string, counts map[string]int64) (map[int64][]string, error) {
+	onlineUserIDs, err := c.userRpcClient.GetOnlineUserIDs(ctx, datautil.Keys(counts))
+	if err != nil {
+		return nil, err
+	}
+	for _, userID := range onlineUserIDs {
+		delete(counts, userID)
+	}
+	if len(counts) == 0 {
+		return nil, nil
+	}
+	conversationID := conversationutil.GenGroupConversationID(groupID)
+	maxSeq, err := c.msgRpcClient.GetConversationMaxSeq(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		mu          sync.Mutex
+		hasReadSeqs = make(map[string]int64, len(counts))
+	)
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(digestReadSeqConcurrency)
+	for userID := range counts {
+		userID := userID
+		g.Go(func() error {
+			seqs, err := c.msgRpcClient.GetHasReadSeqs(gctx, userID, []string{conversationID})
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			hasReadSeqs[userID] = seqs[conversationID]
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return digestCounts(counts, maxSeq, hasReadSeqs), nil
+}
+
+// digestCounts caps the count of every member to the messages they have not read yet and groups the members by it.
+func digestCounts(counts map[string]int64, maxSeq int64, hasReadSeqs map[string]int64) map[int64][]string {
+	res := make(map[int64][]string)
+	for userID, count := range counts {
+		count = min(count, maxSeq-hasReadSeqs[userID])
+		if count <= 0 {
+			continue
+		}
+		res[count] = append(res[count], userID)
+	}
+	return res
+}
+
+func (c *ConsumerHandler) pushDigest(ctx context.Context, groupID string, count int64, userIDs []string) error {
+	var groupName string
+	groupInfo, err := c.groupLocalCache.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		log.ZWarn(ctx, "get group info for offline digest failed", err, "groupID", groupID)
+	} else {
+		groupName = groupInfo.GroupName
+	}
+	conversationID := conversationutil.GenGroupConversationID(groupID)
+	opts := &options.Opts{
+		Signal:     &options.Signal{},
+		CollapseID: conversationID,
+		ThreadID:   conversationID,
+	}
+	content := fmt.Sprintf("%d new messages in %s", count, groupName)
+	return c.offlinePush(ctx, conversationID, nil, userIDs, groupName, content, opts)
+}
@@ -0,0 +1,68 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockPushDigestCache struct {
+	cache.PushDigestCache
+	counts map[string]int64
+}
+
+func (m *mockPushDigestCache) Collapse(ctx context.Context, members []string, windowEnd time.Time) ([]string, error) {
+	var opened []string
+	for _, member := range members {
+		m.counts[member]++
+		if m.counts[member] == 1 {
+			opened = append(opened, member)
+		}
+	}
+	return opened, nil
+}
+
+func TestCollapse(t *testing.T) {
+	digests := &mockPushDigestCache{counts: make(map[string]int64)}
+	a := &pushAggregator{cache: digests, enable: true, window: time.Minute}
+	ctx := context.Background()
+	msg := &sdkws.MsgData{SessionType: constant.ReadGroupChatType, GroupID: "g1", ContentType: constant.Text}
+
+	assert.ElementsMatch(t, []string{"u1", "u2"}, a.Collapse(ctx, msg, []string{"u1", "u2"}))
+	assert.Empty(t, a.Collapse(ctx, msg, []string{"u1", "u2"}))
+	// @mentioned members are pushed at once
+	msg.ContentType = constant.AtText
+	msg.Content = []byte(`{"atUserList":["u2"]}`)
+	assert.Equal(t, []string{"u2"}, a.Collapse(ctx, msg, []string{"u1", "u2"}))
+	assert.Equal(t, int64(3), digests.counts[digestMember{GroupID: "g1", UserID: "u1"}.String()])
+}
+
+func TestDigestCounts(t *testing.T) {
+	counts := map[string]int64{"u1": 5, "u2": 5, "u3": 5, "u4": 2}
+	hasReadSeqs := map[string]int64{
+		"u1": 100, // read everything since the window opened
+		"u2": 97,  // read some of the messages
+		"u3": 90,
+	}
+	res := digestCounts(counts, 100, hasReadSeqs)
+	assert.Equal(t, map[int64][]string{3: {"u2"}, 5: {"u3"}, 2: {"u4"}}, res)
+}
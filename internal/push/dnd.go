@@ -0,0 +1,119 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/donotdisturb"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/jsonutil"
+)
+
+const minutesPerDay = 24 * 60
+
+// locations caches the time zones by name, time.LoadLocation reads tzdata from disk on every call.
+var locations sync.Map
+
+// loadLocation returns the time zone of name, or UTC when name is unknown. Only the known zones are cached,
+// so the names users set cannot grow the cache past the tz database.
+func loadLocation(name string) *time.Location {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	locations.Store(name, loc)
+	return loc
+}
+
+// inDoNotDisturb reports whether now falls into one of the weekly windows, evaluated in the user's time zone.
+func inDoNotDisturb(dnd *donotdisturb.DoNotDisturb, now time.Time) bool {
+	if dnd == nil || !dnd.Enable || len(dnd.Windows) == 0 {
+		return false
+	}
+	now = now.In(loadLocation(dnd.TimeZone))
+	weekday := int32(now.Weekday())
+	minute := int32(now.Hour()*60 + now.Minute())
+	for _, window := range dnd.Windows {
+		if window.EndMinute > window.StartMinute {
+			if window.Weekday == weekday && minute >= window.StartMinute && minute < window.EndMinute {
+				return true
+			}
+			continue
+		}
+		// The window crosses midnight and ends on the next day.
+		if window.Weekday == weekday && minute >= window.StartMinute {
+			return true
+		}
+		if (window.Weekday+1)%7 == weekday && minute < window.EndMinute%minutesPerDay {
+			return true
+		}
+	}
+	return false
+}
+
+// atUserIDs returns the users mentioned by an AtText message, an @all mention is kept as constant.AtAllString.
+func atUserIDs(msg *sdkws.MsgData) []string {
+	if msg.ContentType != constant.AtText {
+		return nil
+	}
+	var elem struct {
+		AtUserList []string `json:"atUserList,omitempty"`
+	}
+	if err := jsonutil.JsonStringToStruct(string(msg.Content), &elem); err != nil {
+		return nil
+	}
+	return elem.AtUserList
+}
+
+// mentioned reports whether userID is mentioned by atUsers, @all mentions every receiver.
+func mentioned(userID string, atUsers []string) bool {
+	return datautil.Contain(userID, atUsers...) || datautil.Contain(constant.AtAllString, atUsers...)
+}
+
+// filterDoNotDisturb splits offline receivers into those pushed normally and those pushed without sound,
+// dropping users inside a suppressing do not disturb window. A schedule that cannot be loaded never blocks the push.
+func (c *ConsumerHandler) filterDoNotDisturb(ctx context.Context, conversationID string, atUsers []string,
+	userIDs []string) (normal []string, silent []string) {
+	now := time.Now()
+	for _, userID := range userIDs {
+		dnd, err := c.userLocalCache.GetUserDoNotDisturb(ctx, userID)
+		if err != nil {
+			log.ZWarn(ctx, "get user do not disturb failed", err, "userID", userID)
+			normal = append(normal, userID)
+			continue
+		}
+		if !inDoNotDisturb(dnd, now) ||
+			(dnd.AllowAtMention && mentioned(userID, atUsers)) ||
+			datautil.Contain(conversationID, dnd.ExceptConversationIDs...) {
+			normal = append(normal, userID)
+			continue
+		}
+		if dnd.Silent {
+			silent = append(silent, userID)
+			continue
+		}
+		log.ZDebug(ctx, "offline push suppressed by do not disturb", "userID", userID, "conversationID", conversationID)
+	}
+	return normal, silent
+}
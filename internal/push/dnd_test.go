@@ -0,0 +1,72 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/donotdisturb"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInDoNotDisturb(t *testing.T) {
+	dnd := &donotdisturb.DoNotDisturb{
+		Enable:   true,
+		TimeZone: "Asia/Shanghai",
+		Windows: []*donotdisturb.TimeWindow{
+			// Monday 23:00 ~ Tuesday 07:00
+			{Weekday: int32(time.Monday), StartMinute: 23 * 60, EndMinute: 7 * 60},
+			// Saturday 12:00 ~ 14:00
+			{Weekday: int32(time.Saturday), StartMinute: 12 * 60, EndMinute: 14 * 60},
+		},
+	}
+	loc, err := time.LoadLocation(dnd.TimeZone)
+	assert.NoError(t, err)
+	cases := []struct {
+		time time.Time
+		want bool
+	}{
+		{time.Date(2024, 4, 1, 23, 30, 0, 0, loc), true}, // Monday
+		{time.Date(2024, 4, 2, 3, 0, 0, 0, loc), true},   // Tuesday
+		{time.Date(2024, 4, 2, 7, 0, 0, 0, loc), false},  // Tuesday
+		{time.Date(2024, 4, 3, 3, 0, 0, 0, loc), false},  // Wednesday
+		{time.Date(2024, 4, 6, 13, 0, 0, 0, loc), true},  // Saturday
+		{time.Date(2024, 4, 6, 13, 0, 0, 0, time.UTC), false},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, inDoNotDisturb(dnd, c.time), c.time.String())
+	}
+	dnd.Enable = false
+	assert.False(t, inDoNotDisturb(dnd, cases[0].time))
+}
+
+func TestLoadLocation(t *testing.T) {
+	loc := loadLocation("Asia/Shanghai")
+	assert.Equal(t, "Asia/Shanghai", loc.String())
+	assert.Same(t, loc, loadLocation("Asia/Shanghai"))
+	assert.Equal(t, time.UTC, loadLocation("Mars/Olympus"))
+	_, ok := locations.Load("Mars/Olympus")
+	assert.False(t, ok)
+}
+
+func TestMentioned(t *testing.T) {
+	assert.True(t, mentioned("u1", []string{"u1", "u2"}))
+	assert.False(t, mentioned("u3", []string{"u1", "u2"}))
+	// @all mentions every receiver
+	assert.True(t, mentioned("u3", []string{constant.AtAllString}))
+	assert.False(t, mentioned("u3", nil))
+}
@@ -0,0 +1,42 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apns
+
+// Payload is the JSON body sent to APNs for a single device token.
+type Payload struct {
+	Aps         Aps    `json:"aps"`
+	Ex          string `json:"ex,omitempty"`
+	ClientMsgID string `json:"clientMsgID,omitempty"`
+	// CollapseID is sent as the apns-collapse-id header.
+	CollapseID string `json:"-"`
+}
+
+type Aps struct {
+	Alert          Alert  `json:"alert"`
+	Sound          string `json:"sound,omitempty"`
+	Badge          *int   `json:"badge,omitempty"`
+	MutableContent int    `json:"mutable-content,omitempty"`
+	ThreadID       string `json:"thread-id,omitempty"`
+}
+
+type Alert struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+}
+
+// Resp is the error body returned by APNs when the status code is not 200.
+type Resp struct {
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,303 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apns
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	ProductionHost  = "https://api.push.apple.com"
+	DevelopmentHost = "https://api.sandbox.push.apple.com"
+
+	// APNs rejects provider tokens older than one hour and throttles tokens refreshed more often than every 20 minutes.
+	tokenRefreshInterval = 50 * time.Minute
+	requestTimeout       = 10 * time.Second
+	concurrentLimit      = 16
+)
+
+// Terminal are the platforms of the device tokens APNs can push.
+var Terminal = []int{constant.IOSPlatformID, constant.IPadPlatformID}
+
+var (
+	ErrPushFailed   = errs.New("apns push failed")
+	errTokenInvalid = errs.New("apns device token invalid")
+)
+
+type APNs struct {
+	pushConf   *config.Push
+	cache      cache.ThirdCache
+	httpClient *http.Client
+	host       string
+	signingKey *ecdsa.PrivateKey
+	// platformIDs are the platforms of the tokens pushed, the others are pushed by other providers
+	platformIDs []int
+
+	lock          sync.Mutex
+	authToken     string
+	authTokenTime time.Time
+}
+
+// NewClient initializes a new APNs client using token-based authentication.
+// The .p8 signing key is read from pushConf.APNs.KeyFile, relative paths are resolved against the config directory.
+// Only the tokens registered from platformIDs are pushed, a subset of Terminal.
+func NewClient(pushConf *config.Push, cache cache.ThirdCache, platformIDs []int) (*APNs, error) {
+	keyFile := pushConf.APNs.KeyFile
+	if !filepath.IsAbs(keyFile) {
+		projectRoot, err := config.GetProjectRoot()
+		if err != nil {
+			return nil, err
+		}
+		keyFile = filepath.Join(projectRoot, "config", keyFile)
+	}
+	host := DevelopmentHost
+	if pushConf.IOSPush.Production {
+		host = ProductionHost
+	}
+	httpClient := &http.Client{
+		Transport: &http.Transport{ForceAttemptHTTP2: true},
+		Timeout:   requestTimeout,
+	}
+	return NewClientWithHost(pushConf, cache, platformIDs, keyFile, host, httpClient)
+}
+
+// NewClientWithHost is like NewClient but sends to the given host with the given HTTP/2 capable client.
+func NewClientWithHost(pushConf *config.Push, cache cache.ThirdCache, platformIDs []int, keyFile, host string, httpClient *http.Client) (*APNs, error) {
+	keyData, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "read apns key file failed", "keyFile", keyFile)
+	}
+	signingKey, err := jwt.ParseECPrivateKeyFromPEM(keyData)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "parse apns key file failed", "keyFile", keyFile)
+	}
+	return &APNs{
+		pushConf:    pushConf,
+		cache:       cache,
+		httpClient:  httpClient,
+		host:        host,
+		signingKey:  signingKey,
+		platformIDs: platformIDs,
+	}, nil
+}
+
+func (a *APNs) Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error {
+	sound := opts.IOSPushSound
+	if sound == "" {
+		sound = a.pushConf.IOSPush.PushSound
+	}
+	if opts.Silent {
+		sound = ""
+	}
+	var clientMsgID string
+	if opts.Signal != nil {
+		clientMsgID = opts.Signal.ClientMsgID
+	}
+	var (
+		success int64
+		fail    int64
+		// failedUserIDs are the users worth pushing again, guarded by lock
+		lock          sync.Mutex
+		failedUserIDs []string
+	)
+	addFailed := func(userID string) {
+		atomic.AddInt64(&fail, 1)
+		lock.Lock()
+		failedUserIDs = append(failedUserIDs, userID)
+		lock.Unlock()
+	}
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrentLimit)
+	for _, userID := range userIDs {
+		deviceTokens, err := a.deviceTokens(ctx, userID)
+		if err != nil {
+			log.ZWarn(ctx, "get apns device token failed", err, "userID", userID)
+			addFailed(userID)
+			continue
+		}
+		if len(deviceTokens) == 0 {
+			continue
+		}
+		badge, err := a.badge(ctx, userID, opts.IOSBadgeCount || a.pushConf.IOSPush.BadgeCount)
+		if err != nil {
+			log.ZWarn(ctx, "get apns badge failed", err, "userID", userID)
+			addFailed(userID)
+			continue
+		}
+		payload := Payload{
+			Aps: Aps{
+				Alert:          Alert{Title: title, Body: content},
+				Sound:          sound,
+				Badge:          badge,
+				MutableContent: 1,
+				ThreadID:       opts.ThreadID,
+			},
+			Ex:          opts.Ex,
+			ClientMsgID: clientMsgID,
+			CollapseID:  opts.CollapseID,
+		}
+		for platformID, deviceToken := range deviceTokens {
+			userID, platformID, deviceToken := userID, platformID, deviceToken
+			g.Go(func() error {
+				if err := a.send(gCtx, userID, platformID, deviceToken, &payload); err != nil {
+					log.ZWarn(ctx, "apns send failed", err, "userID", userID, "platformID", platformID)
+					if errors.Is(err, errTokenInvalid) {
+						// the token is dropped, pushing again has nothing to send to
+						atomic.AddInt64(&fail, 1)
+					} else {
+						addFailed(userID)
+					}
+					return nil
+				}
+				atomic.AddInt64(&success, 1)
+				return nil
+			})
+		}
+	}
+	_ = g.Wait()
+	// a user is pushed again once, however many of their devices failed
+	failedUserIDs = datautil.Distinct(failedUserIDs)
+	log.ZDebug(ctx, "apns push result", "success", success, "fail", fail)
+	if (fail > 0 && success == 0) || len(failedUserIDs) > 0 {
+		return &options.UsersError{UserIDs: failedUserIDs, Err: ErrPushFailed.WrapMsg("apns pushes failed", "success", success, "fail", fail)}
+	}
+	return nil
+}
+
+// deviceTokens returns the tokens of userID by platform, the platforms without a token are left out.
+func (a *APNs) deviceTokens(ctx context.Context, userID string) (map[int]string, error) {
+	deviceTokens := make(map[int]string)
+	for _, platformID := range a.platformIDs {
+		deviceToken, err := a.cache.GetFcmToken(ctx, userID, platformID)
+		if err != nil {
+			if errs.Unwrap(err) == redis.Nil {
+				continue
+			}
+			return nil, err
+		}
+		deviceTokens[platformID] = deviceToken
+	}
+	return deviceTokens, nil
+}
+
+func (a *APNs) badge(ctx context.Context, userID string, incr bool) (*int, error) {
+	if incr {
+		unreadCountSum, err := a.cache.IncrUserBadgeUnreadCountSum(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		return &unreadCountSum, nil
+	}
+	unreadCountSum, err := a.cache.GetUserBadgeUnreadCountSum(ctx, userID)
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		return nil, err
+	}
+	if unreadCountSum == 0 {
+		unreadCountSum = 1
+	}
+	return &unreadCountSum, nil
+}
+
+func (a *APNs) send(ctx context.Context, userID string, platformID int, deviceToken string, payload *Payload) error {
+	authToken, err := a.getAuthToken()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.host+"/3/device/"+deviceToken, bytes.NewReader(data))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req.Header.Set("authorization", "bearer "+authToken)
+	req.Header.Set("apns-topic", a.pushConf.APNs.BundleID)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("content-type", "application/json")
+	if payload.CollapseID != "" {
+		req.Header.Set("apns-collapse-id", payload.CollapseID)
+	}
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	var apnsResp Resp
+	_ = json.NewDecoder(resp.Body).Decode(&apnsResp)
+	switch {
+	case resp.StatusCode == http.StatusGone, apnsResp.Reason == "BadDeviceToken":
+		// The device token is no longer valid for this topic, drop it so it is not retried.
+		if err := a.cache.DelFcmToken(ctx, userID, platformID); err != nil {
+			log.ZWarn(ctx, "del apns device token failed", err, "userID", userID, "platformID", platformID)
+		}
+		return errTokenInvalid.WrapMsg(apnsResp.Reason, "status", resp.StatusCode, "userID", userID)
+	case resp.StatusCode == http.StatusForbidden && apnsResp.Reason == "ExpiredProviderToken":
+		a.resetAuthToken()
+	}
+	return ErrPushFailed.WrapMsg(apnsResp.Reason, "status", resp.StatusCode, "userID", userID)
+}
+
+// getAuthToken returns the cached provider token, signing a new one when it is older than tokenRefreshInterval.
+func (a *APNs) getAuthToken() (string, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.authToken != "" && time.Since(a.authTokenTime) < tokenRefreshInterval {
+		return a.authToken, nil
+	}
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+		Issuer:   a.pushConf.APNs.TeamID,
+		IssuedAt: jwt.NewNumericDate(now),
+	})
+	token.Header["kid"] = a.pushConf.APNs.KeyID
+	signed, err := token.SignedString(a.signingKey)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	a.authToken = signed
+	a.authTokenTime = now
+	return signed, nil
+}
+
+func (a *APNs) resetAuthToken() {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.authToken = ""
+}
@@ -0,0 +1,190 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apns
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockThirdCache struct {
+	cache.ThirdCache
+	mu      sync.Mutex
+	tokens  map[string]map[int]string
+	badges  map[string]int
+	deleted []string
+}
+
+func (m *mockThirdCache) GetFcmToken(_ context.Context, account string, platformID int) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	token, ok := m.tokens[account][platformID]
+	if !ok {
+		return "", errs.Wrap(redis.Nil)
+	}
+	return token, nil
+}
+
+func (m *mockThirdCache) DelFcmToken(_ context.Context, account string, platformID int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleted = append(m.deleted, account+"/"+constant.PlatformIDToName(platformID))
+	return nil
+}
+
+func (m *mockThirdCache) IncrUserBadgeUnreadCountSum(_ context.Context, userID string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.badges[userID]++
+	return m.badges[userID], nil
+}
+
+func (m *mockThirdCache) GetUserBadgeUnreadCountSum(_ context.Context, userID string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.badges[userID], nil
+}
+
+func writeP8(t *testing.T) (string, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "AuthKey_TEST.p8")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path, key
+}
+
+func TestAPNsPush(t *testing.T) {
+	keyFile, key := writeP8(t)
+
+	var (
+		mu       sync.Mutex
+		payloads = make(map[string]Payload)
+	)
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 {
+			w.WriteHeader(http.StatusHTTPVersionNotSupported)
+			return
+		}
+		auth := strings.TrimPrefix(r.Header.Get("authorization"), "bearer ")
+		token, err := jwt.Parse(auth, func(token *jwt.Token) (any, error) { return &key.PublicKey, nil })
+		if err != nil || !token.Valid || token.Header["kid"] != "KEYID" {
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(Resp{Reason: "InvalidProviderToken"})
+			return
+		}
+		if r.Header.Get("apns-topic") != "io.openim.app" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(Resp{Reason: "TopicDisallowed"})
+			return
+		}
+		deviceToken := strings.TrimPrefix(r.URL.Path, "/3/device/")
+		if deviceToken == "busy" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_ = json.NewEncoder(w).Encode(Resp{Reason: "ServiceUnavailable"})
+			return
+		}
+		if deviceToken == "stale" {
+			w.WriteHeader(http.StatusGone)
+			_ = json.NewEncoder(w).Encode(Resp{Reason: "Unregistered"})
+			return
+		}
+		var payload Payload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		payloads[deviceToken] = payload
+		mu.Unlock()
+	}))
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	defer srv.Close()
+
+	var pushConf config.Push
+	pushConf.APNs.KeyID = "KEYID"
+	pushConf.APNs.TeamID = "TEAMID"
+	pushConf.APNs.BundleID = "io.openim.app"
+	pushConf.IOSPush.PushSound = "default"
+	thirdCache := &mockThirdCache{
+		tokens: map[string]map[int]string{
+			"u1": {constant.IOSPlatformID: "token1", constant.IPadPlatformID: "pad1"},
+			"u2": {constant.IOSPlatformID: "token2", constant.IPadPlatformID: "stale"},
+			"u4": {constant.IOSPlatformID: "busy", constant.IPadPlatformID: "busy"},
+			// the Android token is pushed by another provider
+			"u5": {constant.AndroidPlatformID: "android5"},
+		},
+		badges: map[string]int{"u1": 4},
+	}
+	client, err := NewClientWithHost(&pushConf, thirdCache, Terminal, keyFile, srv.URL, srv.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := &options.Opts{Signal: &options.Signal{ClientMsgID: "msg1"}, IOSBadgeCount: true, Ex: "ex"}
+	err = client.Push(context.Background(), []string{"u1", "u2", "u3", "u5"}, "title", "content", opts)
+	assert.NoError(t, err)
+	assert.Len(t, payloads, 3)
+	assert.Equal(t, payloads["token1"], payloads["pad1"])
+	assert.Contains(t, payloads, "token2")
+
+	payload, ok := payloads["token1"]
+	assert.True(t, ok)
+	assert.Equal(t, "title", payload.Aps.Alert.Title)
+	assert.Equal(t, "content", payload.Aps.Alert.Body)
+	assert.Equal(t, "default", payload.Aps.Sound)
+	assert.Equal(t, 5, *payload.Aps.Badge)
+	assert.Equal(t, "msg1", payload.ClientMsgID)
+	assert.Equal(t, "ex", payload.Ex)
+	assert.Equal(t, []string{"u2/IPad"}, thirdCache.deleted)
+
+	// only the users failed for a reason other than their token are pushed again
+	err = client.Push(context.Background(), []string{"u1", "u2", "u4"}, "title", "content", opts)
+	assert.ErrorIs(t, err, ErrPushFailed)
+	assert.Equal(t, []string{"u4"}, options.FailedUserIDs(err, []string{"u1", "u2", "u4"}))
+
+	thirdCache.tokens["u2"] = map[int]string{constant.IPadPlatformID: "stale"}
+	err = client.Push(context.Background(), []string{"u2"}, "title", "content", opts)
+	assert.ErrorIs(t, err, ErrPushFailed)
+}
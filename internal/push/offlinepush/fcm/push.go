@@ -36,11 +36,14 @@ var Terminal = []int{constant.IOSPlatformID, constant.AndroidPlatformID, constan
 type Fcm struct {
 	fcmMsgCli *messaging.Client
 	cache     cache.ThirdCache
+	// platformIDs are the platforms of the tokens pushed, the others are pushed by other providers
+	platformIDs []int
 }
 
 // NewClient initializes a new FCM client using the Firebase Admin SDK.
 // It requires the FCM service account credentials file located within the project's configuration directory.
-func NewClient(pushConf *config.Push, cache cache.ThirdCache) (*Fcm, error) {
+// Only the tokens registered from platformIDs are pushed, a subset of Terminal.
+func NewClient(pushConf *config.Push, cache cache.ThirdCache, platformIDs []int) (*Fcm, error) {
 	projectRoot, err := config.GetProjectRoot()
 	if err != nil {
 		return nil, err
@@ -57,21 +60,27 @@ func NewClient(pushConf *config.Push, cache cache.ThirdCache) (*Fcm, error) {
 		return nil, errs.Wrap(err)
 	}
 
-	return &Fcm{fcmMsgCli: fcmMsgClient, cache: cache}, nil
+	return &Fcm{fcmMsgCli: fcmMsgClient, cache: cache, platformIDs: platformIDs}, nil
 }
 
 func (f *Fcm) Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error {
 	// accounts->registrationToken
 	allTokens := make(map[string][]string, 0)
+	iosUsers := make(map[string]bool)
 	for _, account := range userIDs {
 		var personTokens []string
-		for _, v := range Terminal {
+		for _, v := range f.platformIDs {
 			Token, err := f.cache.GetFcmToken(ctx, account, v)
 			if err == nil {
 				personTokens = append(personTokens, Token)
+				if v == constant.IOSPlatformID {
+					iosUsers[account] = true
+				}
 			}
 		}
-		allTokens[account] = personTokens
+		if len(personTokens) > 0 {
+			allTokens[account] = personTokens
+		}
 	}
 	Success := 0
 	Fail := 0
@@ -81,6 +90,15 @@ func (f *Fcm) Push(ctx context.Context, userIDs []string, title, content string,
 	var messages []*messaging.Message
 	for userID, personTokens := range allTokens {
 		apns := &messaging.APNSConfig{Payload: &messaging.APNSPayload{Aps: &messaging.Aps{Sound: opts.IOSPushSound}}}
+		if opts.Silent {
+			apns.Payload.Aps.Sound = ""
+		}
+		if opts.ThreadID != "" {
+			apns.Payload.Aps.ThreadID = opts.ThreadID
+		}
+		if opts.CollapseID != "" {
+			apns.Headers = map[string]string{"apns-collapse-id": opts.CollapseID}
+		}
 		messageCount := len(messages)
 		if messageCount >= SinglePushCountLimit {
 			response, err := f.fcmMsgCli.SendAll(ctx, messages)
@@ -92,7 +110,8 @@ func (f *Fcm) Push(ctx context.Context, userIDs []string, title, content string,
 			}
 			messages = messages[0:0]
 		}
-		if opts.IOSBadgeCount {
+		// without an iOS token pushed here the badge is left to the provider pushing the iOS devices of the user
+		if iosUsers[userID] && opts.IOSBadgeCount {
 			unreadCountSum, err := f.cache.IncrUserBadgeUnreadCountSum(ctx, userID)
 			if err == nil {
 				apns.Payload.Aps.Badge = &unreadCountSum
@@ -101,7 +120,7 @@ func (f *Fcm) Push(ctx context.Context, userIDs []string, title, content string,
 				Fail++
 				continue
 			}
-		} else {
+		} else if iosUsers[userID] {
 			unreadCountSum, err := f.cache.GetUserBadgeUnreadCountSum(ctx, userID)
 			if err == nil && unreadCountSum != 0 {
 				apns.Payload.Aps.Badge = &unreadCountSum
@@ -121,6 +140,12 @@ func (f *Fcm) Push(ctx context.Context, userIDs []string, title, content string,
 				Notification: notification,
 				APNS:         apns,
 			}
+			if opts.CollapseID != "" {
+				temp.Android = &messaging.AndroidConfig{
+					CollapseKey:  opts.CollapseID,
+					Notification: &messaging.AndroidNotification{Tag: opts.CollapseID},
+				}
+			}
 			messages = append(messages, temp)
 		}
 	}
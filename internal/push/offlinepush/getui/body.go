@@ -16,6 +16,8 @@ package getui
 
 import (
 	"fmt"
+	"hash/fnv"
+	"math"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 )
@@ -88,9 +90,11 @@ type PushReq struct {
 type Ios struct {
 	NotificationType *string `json:"type"`
 	AutoBadge        *string `json:"auto_badge"`
+	ApnsCollapseID   string  `json:"apns-collapse-id,omitempty"`
 	Aps              struct {
-		Sound string `json:"sound"`
-		Alert Alert  `json:"alert"`
+		Sound    string `json:"sound"`
+		Alert    Alert  `json:"alert"`
+		ThreadID string `json:"thread-id,omitempty"`
 	} `json:"aps"`
 }
 
@@ -112,6 +116,7 @@ type Notification struct {
 	ChannelID   string `json:"channelID"`
 	ChannelName string `json:"ChannelName"`
 	ClickType   string `json:"click_type"`
+	NotifyID    *int64 `json:"notify_id,omitempty"`
 }
 
 type Options struct {
@@ -144,6 +149,25 @@ func newPushReq(pushConf *config.Push, title, content string) PushReq {
 	return pushReq
 }
 
+func (pushReq *PushReq) setSilent() {
+	pushReq.PushChannel.Ios.Aps.Sound = ""
+	pushReq.PushChannel.Android.Ups.Options.HW.Sound = ""
+	pushReq.PushChannel.Android.Ups.Options.HW.Importance = "LOW"
+}
+
+// setCollapse makes notifications with the same collapseID replace each other, android needs a numeric notify_id.
+func (pushReq *PushReq) setCollapse(collapseID, threadID string) {
+	pushReq.PushChannel.Ios.Aps.ThreadID = threadID
+	if collapseID == "" {
+		return
+	}
+	pushReq.PushChannel.Ios.ApnsCollapseID = collapseID
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(collapseID))
+	notifyID := int64(h.Sum32() & math.MaxInt32)
+	pushReq.PushChannel.Android.Ups.Notification.NotifyID = &notifyID
+}
+
 func newBatchPushReq(userIDs []string, taskID string) PushReq {
 	IsAsync := true
 	return PushReq{Audience: &Audience{Alias: userIDs}, IsAsync: &IsAsync, TaskID: &taskID}
@@ -82,6 +82,10 @@ func (g *Client) Push(ctx context.Context, userIDs []string, title, content stri
 	}
 	pushReq := newPushReq(g.pushConf, title, content)
 	pushReq.setPushChannel(title, content)
+	if opts.Silent {
+		pushReq.setSilent()
+	}
+	pushReq.setCollapse(opts.CollapseID, opts.ThreadID)
 	if len(userIDs) > 1 {
 		maxNum := 999
 		if len(userIDs) > maxNum {
@@ -37,6 +37,7 @@ type Ios struct {
 	Badge          string `json:"badge,omitempty"`
 	Extras         Extras `json:"extras"`
 	MutableContent bool   `json:"mutable-content"`
+	ThreadID       string `json:"thread-id,omitempty"`
 }
 
 type Extras struct {
@@ -51,6 +52,14 @@ func (n *Notification) SetAlert(alert string) {
 	n.IOS.Badge = "+1"
 }
 
+func (n *Notification) SetSilent() {
+	n.IOS.Sound = ""
+}
+
+func (n *Notification) SetThreadID(threadID string) {
+	n.IOS.ThreadID = threadID
+}
+
 func (n *Notification) SetExtras(extras Extras) {
 	n.IOS.Extras = extras
 	n.Android.Extras = extras
@@ -64,12 +64,17 @@ func (j *JPush) Push(ctx context.Context, userIDs []string, title, content strin
 	no.IOSEnableMutableContent()
 	no.SetExtras(extras)
 	no.SetAlert(title)
+	if opts.Silent {
+		no.SetSilent()
+	}
+	no.SetThreadID(opts.ThreadID)
 	no.SetAndroidIntent(j.pushConf)
 
 	var msg body.Message
 	msg.SetMsgContent(content)
 	var opt body.Options
 	opt.SetApnsProduction(j.pushConf.IOSPush.Production)
+	opt.SetApnsCollapseID(opts.CollapseID)
 	var pushObj body.PushObj
 	pushObj.SetPlatform(&pf)
 	pushObj.SetAudience(&au)
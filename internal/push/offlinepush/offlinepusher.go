@@ -16,11 +16,13 @@ package offlinepush
 
 import (
 	"context"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/apns"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/dummy"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/fcm"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/getui"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/jpush"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 )
@@ -29,6 +31,8 @@ const (
 	geTUI    = "geTui"
 	firebase = "fcm"
 	jPush    = "jpush"
+	aPNs     = "apns"
+	webHook  = "webhook"
 )
 
 // OfflinePusher Offline Pusher.
@@ -37,14 +41,24 @@ type OfflinePusher interface {
 }
 
 func NewOfflinePusher(pushConf *config.Push, cache cache.ThirdCache) (OfflinePusher, error) {
+	return newOfflinePusher(pushConf.Enable, pushConf, cache, func(terminal []int) []int { return terminal })
+}
+
+// newOfflinePusher creates the pusher of enable, the token based pushers reading the tokens of several
+// platforms only push the tokens of the platforms platformIDs keeps from their terminal.
+func newOfflinePusher(enable string, pushConf *config.Push, cache cache.ThirdCache, platformIDs func(terminal []int) []int) (OfflinePusher, error) {
 	var offlinePusher OfflinePusher
-	switch pushConf.Enable {
+	switch enable {
 	case geTUI:
 		offlinePusher = getui.NewClient(pushConf, cache)
 	case firebase:
-		return fcm.NewClient(pushConf, cache)
+		return fcm.NewClient(pushConf, cache, platformIDs(fcm.Terminal))
 	case jPush:
 		offlinePusher = jpush.NewClient(pushConf)
+	case aPNs:
+		return apns.NewClient(pushConf, cache, platformIDs(apns.Terminal))
+	case webHook:
+		offlinePusher = webhook.NewClient(pushConf)
 	default:
 		offlinePusher = dummy.NewClient()
 	}
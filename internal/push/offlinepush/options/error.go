@@ -0,0 +1,43 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"errors"
+	"fmt"
+)
+
+// UsersError is returned by the pushers that pushed some of the users, UserIDs are the users to push again.
+type UsersError struct {
+	UserIDs []string
+	Err     error
+}
+
+func (e *UsersError) Error() string {
+	return fmt.Sprintf("push failed for %d users: %v", len(e.UserIDs), e.Err)
+}
+
+func (e *UsersError) Unwrap() error {
+	return e.Err
+}
+
+// FailedUserIDs returns the users of userIDs whose push failed with err, all of them unless err is a UsersError.
+func FailedUserIDs(err error, userIDs []string) []string {
+	var usersErr *UsersError
+	if errors.As(err, &usersErr) {
+		return usersErr.UserIDs
+	}
+	return userIDs
+}
@@ -6,6 +6,12 @@ type Opts struct {
 	IOSPushSound  string
 	IOSBadgeCount bool
 	Ex            string
+	// CollapseID lets a later notification replace an earlier one with the same id on the device.
+	CollapseID string
+	// ThreadID groups notifications of the same conversation together on the device.
+	ThreadID string
+	// Silent asks the provider to deliver the notification without sound, e.g. inside a do not disturb window.
+	Silent bool
 }
 
 // Signal message id.
@@ -0,0 +1,138 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offlinepush
+
+import (
+	"context"
+	"sort"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// Router selects the offline push providers of each user by the platforms their push tokens were registered from.
+type Router struct {
+	cache           cache.ThirdCache
+	defaultProvider string
+	pushers         map[string]OfflinePusher
+	// platformID -> provider
+	routes map[int]string
+	// platformIDs are all the platforms a push token can be registered from
+	platformIDs []int
+}
+
+func NewRouter(pushConf *config.Push, cache cache.ThirdCache) (*Router, error) {
+	r := &Router{
+		cache:           cache,
+		defaultProvider: pushConf.Enable,
+		pushers:         make(map[string]OfflinePusher),
+		routes:          make(map[int]string),
+	}
+	for _, route := range pushConf.Routes {
+		for _, platformID := range route.PlatformIDs {
+			if provider, ok := r.routes[platformID]; ok && provider != route.Enable {
+				return nil, errs.New("platform routed to multiple push providers", "platformID", platformID,
+					"providers", []string{provider, route.Enable}).Wrap()
+			}
+			r.routes[platformID] = route.Enable
+		}
+	}
+	r.platformIDs = datautil.Keys(constant.PlatformID2Name)
+	sort.Ints(r.platformIDs)
+	if err := r.addPusher(pushConf.Enable, pushConf); err != nil {
+		return nil, err
+	}
+	for _, route := range pushConf.Routes {
+		if err := r.addPusher(route.Enable, pushConf); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+func (r *Router) addPusher(provider string, pushConf *config.Push) error {
+	if _, ok := r.pushers[provider]; ok {
+		return nil
+	}
+	pusher, err := newOfflinePusher(provider, pushConf, r.cache, func(terminal []int) []int {
+		return r.providerPlatformIDs(provider, terminal)
+	})
+	if err != nil {
+		return err
+	}
+	r.pushers[provider] = pusher
+	return nil
+}
+
+// providerPlatformIDs returns the platforms of platformIDs pushed by provider: the platforms routed to it and,
+// for the default provider, the platforms not routed to any provider.
+func (r *Router) providerPlatformIDs(provider string, platformIDs []int) []int {
+	var res []int
+	for _, platformID := range platformIDs {
+		routed, ok := r.routes[platformID]
+		if routed == provider || (!ok && provider == r.defaultProvider) {
+			res = append(res, platformID)
+		}
+	}
+	return res
+}
+
+// Default returns the pusher configured by Push.Enable.
+func (r *Router) Default() OfflinePusher {
+	return r.pushers[r.defaultProvider]
+}
+
+// Pusher returns the pusher of the given provider, or nil if it is not configured.
+func (r *Router) Pusher(provider string) OfflinePusher {
+	return r.pushers[provider]
+}
+
+// Split groups userIDs by push provider. A user is returned under every provider routed from a platform
+// they have a token on, and under the default provider when they have a token on a platform that is not routed
+// or no routed platform has a token. Each platform is pushed by a single provider, so a device routed to one
+// provider is not also pushed by another.
+func (r *Router) Split(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	if len(r.routes) == 0 {
+		return map[string][]string{r.defaultProvider: userIDs}, nil
+	}
+	userPlatformIDs, err := r.cache.GetFcmTokenPlatformIDs(ctx, userIDs, r.platformIDs)
+	if err != nil {
+		return nil, err
+	}
+	providerUserIDs := make(map[string][]string)
+	for _, userID := range userIDs {
+		var (
+			providers []string
+			unrouted  bool
+		)
+		for _, platformID := range userPlatformIDs[userID] {
+			if provider, ok := r.routes[platformID]; ok {
+				providers = append(providers, provider)
+			} else {
+				unrouted = true
+			}
+		}
+		if unrouted || len(providers) == 0 {
+			providers = append(providers, r.defaultProvider)
+		}
+		for _, provider := range datautil.Distinct(providers) {
+			providerUserIDs[provider] = append(providerUserIDs[provider], userID)
+		}
+	}
+	return providerUserIDs, nil
+}
@@ -0,0 +1,94 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package offlinepush
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/apns"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/fcm"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockThirdCache struct {
+	cache.ThirdCache
+	platforms map[string][]int
+	lookups   int
+}
+
+func (m *mockThirdCache) GetFcmTokenPlatformIDs(_ context.Context, accounts []string, platformIDs []int) (map[string][]int, error) {
+	m.lookups++
+	exist := make(map[string][]int)
+	for _, account := range accounts {
+		for _, platformID := range m.platforms[account] {
+			if datautil.Contain(platformID, platformIDs...) {
+				exist[account] = append(exist[account], platformID)
+			}
+		}
+	}
+	return exist, nil
+}
+
+func TestRouterSplit(t *testing.T) {
+	var pushConf config.Push
+	pushConf.Enable = "dummy"
+	pushConf.Webhook.URL = "http://127.0.0.1"
+	pushConf.Routes = []struct {
+		PlatformIDs []int  `mapstructure:"platformIDs"`
+		Enable      string `mapstructure:"enable"`
+	}{
+		{PlatformIDs: []int{constant.AndroidPlatformID, constant.AndroidPadPlatformID}, Enable: webHook},
+		{PlatformIDs: []int{constant.IOSPlatformID}, Enable: "dummyIOS"},
+	}
+	thirdCache := &mockThirdCache{platforms: map[string][]int{
+		"android":   {constant.AndroidPlatformID, constant.AndroidPadPlatformID},
+		"both":      {constant.AndroidPlatformID, constant.IOSPlatformID},
+		"web":       {constant.WebPlatformID},
+		"iosAndWeb": {constant.IOSPlatformID, constant.WebPlatformID},
+	}}
+	router, err := NewRouter(&pushConf, thirdCache)
+	assert.NoError(t, err)
+
+	providerUserIDs, err := router.Split(context.Background(), []string{"android", "both", "web", "iosAndWeb", "none"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		webHook:    {"android", "both"},
+		"dummyIOS": {"both", "iosAndWeb"},
+		// the web device of iosAndWeb is only pushed by the default provider
+		"dummy": {"web", "iosAndWeb", "none"},
+	}, providerUserIDs)
+	assert.Equal(t, 1, thirdCache.lookups)
+	assert.NotNil(t, router.Pusher(webHook))
+}
+
+func TestRouterProviderPlatformIDs(t *testing.T) {
+	router := &Router{
+		defaultProvider: firebase,
+		routes:          map[int]string{constant.IOSPlatformID: aPNs, constant.IPadPlatformID: aPNs, constant.WebPlatformID: firebase},
+	}
+	// the iOS tokens are pushed by apns only, fcm keeps the platforms routed to it and the unrouted ones
+	assert.Equal(t, []int{constant.AndroidPlatformID, constant.WebPlatformID}, router.providerPlatformIDs(firebase, fcm.Terminal))
+	assert.Equal(t, []int{constant.IOSPlatformID}, router.providerPlatformIDs(aPNs, fcm.Terminal))
+	assert.Equal(t, []int{constant.IOSPlatformID, constant.IPadPlatformID}, router.providerPlatformIDs(aPNs, apns.Terminal))
+	assert.Empty(t, router.providerPlatformIDs(firebase, apns.Terminal))
+
+	router.defaultProvider = webHook
+	assert.Equal(t, []int{constant.WebPlatformID}, router.providerPlatformIDs(firebase, fcm.Terminal))
+}
@@ -0,0 +1,54 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import "github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+
+// PushReq is the JSON body posted to the configured push relay.
+type PushReq struct {
+	OperationID string   `json:"operationID"`
+	UserIDs     []string `json:"userIDs"`
+	Title       string   `json:"title"`
+	Content     string   `json:"content"`
+	Opts        *Opts    `json:"opts"`
+}
+
+type Opts struct {
+	ClientMsgID   string `json:"clientMsgID,omitempty"`
+	IOSPushSound  string `json:"iosPushSound,omitempty"`
+	IOSBadgeCount bool   `json:"iosBadgeCount"`
+	Ex            string `json:"ex,omitempty"`
+	CollapseID    string `json:"collapseID,omitempty"`
+	ThreadID      string `json:"threadID,omitempty"`
+	Silent        bool   `json:"silent"`
+}
+
+func newOpts(opts *options.Opts) *Opts {
+	if opts == nil {
+		return &Opts{}
+	}
+	o := &Opts{
+		IOSPushSound:  opts.IOSPushSound,
+		IOSBadgeCount: opts.IOSBadgeCount,
+		Ex:            opts.Ex,
+		CollapseID:    opts.CollapseID,
+		ThreadID:      opts.ThreadID,
+		Silent:        opts.Silent,
+	}
+	if opts.Signal != nil {
+		o.ClientMsgID = opts.Signal.ClientMsgID
+	}
+	return o
+}
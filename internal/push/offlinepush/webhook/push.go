@@ -0,0 +1,157 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/splitter"
+)
+
+const (
+	TimestampHeader = "X-OpenIM-Timestamp"
+	SignatureHeader = "X-OpenIM-Signature"
+
+	defaultTimeout   = 5
+	defaultBatchSize = 500
+	retryBackoff     = 200 * time.Millisecond
+)
+
+var (
+	ErrUserIDEmpty = errs.New("userIDs is empty")
+	ErrPushFailed  = errs.New("webhook push failed")
+)
+
+type Webhook struct {
+	pushConf   *config.Push
+	httpClient *http.Client
+}
+
+func NewClient(pushConf *config.Push) *Webhook {
+	return &Webhook{
+		pushConf:   pushConf,
+		httpClient: &http.Client{},
+	}
+}
+
+func (w *Webhook) Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error {
+	if len(userIDs) == 0 {
+		return ErrUserIDEmpty
+	}
+	batchSize := w.pushConf.Webhook.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	var (
+		failedUserIDs []string
+		lastErr       error
+	)
+	for _, batch := range splitter.NewSplitter(batchSize, userIDs).GetSplitResult() {
+		req := &PushReq{
+			OperationID: mcontext.GetOperationID(ctx),
+			UserIDs:     batch.Item,
+			Title:       title,
+			Content:     content,
+			Opts:        newOpts(opts),
+		}
+		if err := w.postWithRetry(ctx, req); err != nil {
+			log.ZWarn(ctx, "webhook push batch failed", err, "userIDs", batch.Item)
+			failedUserIDs = append(failedUserIDs, batch.Item...)
+			lastErr = err
+		}
+	}
+	if lastErr != nil {
+		return &options.UsersError{UserIDs: failedUserIDs, Err: ErrPushFailed.WrapMsg(lastErr.Error(), "failedNum", len(failedUserIDs), "total", len(userIDs))}
+	}
+	return nil
+}
+
+func (w *Webhook) postWithRetry(ctx context.Context, req *PushReq) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	for i := 0; ; i++ {
+		retry, err := w.post(ctx, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || i >= w.pushConf.Webhook.RetryTimes {
+			return err
+		}
+		log.ZDebug(ctx, "webhook push retry", "times", i+1, "err", err)
+		select {
+		case <-ctx.Done():
+			return errs.Wrap(ctx.Err())
+		case <-time.After(retryBackoff << i):
+		}
+	}
+}
+
+// post sends one signed request, the returned bool reports whether a failure is worth retrying.
+func (w *Webhook) post(ctx context.Context, body []byte) (bool, error) {
+	timeout := w.pushConf.Webhook.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.pushConf.Webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set(constant.OperationID, mcontext.GetOperationID(ctx))
+	req.Header.Set(TimestampHeader, timestamp)
+	if w.pushConf.Webhook.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(w.pushConf.Webhook.Secret, timestamp, body))
+	}
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return true, errs.WrapMsg(err, "webhook push request failed", "url", w.pushConf.Webhook.URL)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+	retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+	return retry, ErrPushFailed.WrapMsg("unexpected status code", "status", resp.StatusCode, "url", w.pushConf.Webhook.URL)
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of "timestamp.body", receivers recompute it to authenticate the request.
+func Sign(secret, timestamp string, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp))
+	h.Write([]byte("."))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
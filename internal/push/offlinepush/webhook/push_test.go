@@ -0,0 +1,74 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookPush(t *testing.T) {
+	var (
+		calls   int32
+		batches [][]string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(SignatureHeader) != Sign("secret", r.Header.Get(TimestampHeader), body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		// The first request fails to exercise the retry path.
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var req PushReq
+		if err := json.Unmarshal(body, &req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		assert.Equal(t, "title", req.Title)
+		assert.Equal(t, "msg1", req.Opts.ClientMsgID)
+		batches = append(batches, req.UserIDs)
+	}))
+	defer srv.Close()
+
+	var pushConf config.Push
+	pushConf.Webhook.URL = srv.URL
+	pushConf.Webhook.Secret = "secret"
+	pushConf.Webhook.RetryTimes = 1
+	pushConf.Webhook.BatchSize = 2
+	client := NewClient(&pushConf)
+
+	opts := &options.Opts{Signal: &options.Signal{ClientMsgID: "msg1"}}
+	err := client.Push(context.Background(), []string{"u1", "u2", "u3"}, "title", "content", opts)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"u1", "u2"}, {"u3"}}, batches)
+
+	pushConf.Webhook.Secret = "wrong"
+	err = client.Push(context.Background(), []string{"u1"}, "title", "content", opts)
+	assert.ErrorIs(t, err, ErrPushFailed)
+	assert.Equal(t, []string{"u1"}, options.FailedUserIDs(err, []string{"u1", "u2"}))
+}
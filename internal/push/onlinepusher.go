@@ -15,6 +15,10 @@ import (
 const (
 	KUBERNETES = "k8s"
 	ZOOKEEPER  = "zookeeper"
+	DIRECT     = "direct"
+	ETCD       = "etcd"
+	CONSUL     = "consul"
+	STANDALONE = "standalone"
 )
 
 type OnlinePusher interface {
@@ -45,7 +49,7 @@ func NewOnlinePusher(disCov discovery.SvcDiscoveryRegistry, config *Config) Onli
 	switch config.Share.Env {
 	case KUBERNETES:
 		return NewK8sStaticConsistentHash(disCov, config)
-	case ZOOKEEPER:
+	case ZOOKEEPER, DIRECT, ETCD, CONSUL, STANDALONE:
 		return NewDefaultAllNode(disCov, config)
 	default:
 		return newEmptyOnlinePUsher()
@@ -6,17 +6,21 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/dbconn"
+	pbpushretry "github.com/openimsdk/open-im-server/v3/pkg/protocol/pushretry"
 	pbpush "github.com/openimsdk/protocol/push"
-	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/discovery"
 	"google.golang.org/grpc"
 )
 
 type pushServer struct {
+	pbpushretry.UnimplementedPushRetryServer
 	database      controller.PushDatabase
 	disCov        discovery.SvcDiscoveryRegistry
-	offlinePusher offlinepush.OfflinePusher
+	offlinePusher *offlinepush.Router
 	pushCh        *ConsumerHandler
+	retryQueue    *retryQueue
+	config        *Config
 }
 
 type Config struct {
@@ -45,12 +49,12 @@ func (p pushServer) DelUserPushToken(ctx context.Context,
 }
 
 func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
-	rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
+	rdb, err := dbconn.NewRedisClient(ctx, config.RedisConfig.Build())
 	if err != nil {
 		return err
 	}
 	cacheModel := cache.NewThirdCache(rdb)
-	offlinePusher, err := offlinepush.NewOfflinePusher(&config.RpcConfig, cacheModel)
+	offlinePusher, err := offlinepush.NewRouter(&config.RpcConfig, cacheModel)
 	if err != nil {
 		return err
 	}
@@ -60,12 +64,18 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
-	pbpush.RegisterPushMsgServiceServer(server, &pushServer{
+	srv := &pushServer{
 		database:      database,
 		disCov:        client,
 		offlinePusher: offlinePusher,
 		pushCh:        consumer,
-	})
+		retryQueue:    consumer.retryQueue,
+		config:        config,
+	}
+	pbpush.RegisterPushMsgServiceServer(server, srv)
+	pbpushretry.RegisterPushRetryServer(server, srv)
 	go consumer.pushConsumerGroup.RegisterHandleAndConsumer(ctx, consumer)
+	go consumer.retryQueue.Run(ctx)
+	go consumer.runPushDigest(ctx)
 	return nil
 }
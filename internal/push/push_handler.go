@@ -19,7 +19,9 @@ import (
 	"encoding/json"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
@@ -27,6 +29,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/util/conversationutil"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/utils/jsonutil"
 	"github.com/redis/go-redis/v9"
@@ -39,23 +42,28 @@ import (
 	"github.com/openimsdk/tools/mq/kafka"
 	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/timeutil"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
 )
 
 type ConsumerHandler struct {
 	pushConsumerGroup      *kafka.MConsumerGroup
-	offlinePusher          offlinepush.OfflinePusher
+	offlinePusher          *offlinepush.Router
 	onlinePusher           OnlinePusher
 	groupLocalCache        *rpccache.GroupLocalCache
 	conversationLocalCache *rpccache.ConversationLocalCache
+	userLocalCache         *rpccache.UserLocalCache
+	userRpcClient          rpcclient.UserRpcClient
 	msgRpcClient           rpcclient.MessageRpcClient
 	conversationRpcClient  rpcclient.ConversationRpcClient
 	groupRpcClient         rpcclient.GroupRpcClient
 	webhookClient          *webhook.Client
+	retryQueue             *retryQueue
+	aggregator             *pushAggregator
 	config                 *Config
 }
 
-func NewConsumerHandler(config *Config, offlinePusher offlinepush.OfflinePusher, rdb redis.UniversalClient,
+func NewConsumerHandler(config *Config, offlinePusher *offlinepush.Router, rdb redis.UniversalClient,
 	client discovery.SvcDiscoveryRegistry) (*ConsumerHandler, error) {
 	var consumerHandler ConsumerHandler
 	var err error
@@ -72,7 +80,11 @@ func NewConsumerHandler(config *Config, offlinePusher offlinepush.OfflinePusher,
 	consumerHandler.conversationRpcClient = rpcclient.NewConversationRpcClient(client, config.Share.RpcRegisterName.Conversation)
 	consumerHandler.conversationLocalCache = rpccache.NewConversationLocalCache(consumerHandler.conversationRpcClient,
 		&config.LocalCacheConfig, rdb)
-	consumerHandler.webhookClient = webhook.NewWebhookClient(config.WebhooksConfig.URL)
+	consumerHandler.userRpcClient = rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User, config.Share.AdminUserIDs)
+	consumerHandler.userLocalCache = rpccache.NewUserLocalCache(consumerHandler.userRpcClient, &config.LocalCacheConfig, rdb)
+	consumerHandler.webhookClient = webhook.NewWebhookClient(&config.WebhooksConfig)
+	consumerHandler.retryQueue = newRetryQueue(&config.RpcConfig, cache.NewPushRetryCache(rdb), offlinePusher.Pusher)
+	consumerHandler.aggregator = newPushAggregator(&config.RpcConfig, cache.NewPushDigestCache(rdb))
 	consumerHandler.config = config
 	return &consumerHandler, nil
 }
@@ -97,6 +109,8 @@ func (c *ConsumerHandler) handleMs2PsChat(ctx context.Context, msg []byte) {
 	switch msgFromMQ.MsgData.SessionType {
 	case constant.ReadGroupChatType:
 		err = c.Push2Group(ctx, pbData.MsgData.GroupID, pbData.MsgData)
+	case msgprocessor.ThreadChatType:
+		err = c.Push2Thread(ctx, pbData.ConversationID, pbData.MsgData)
 	default:
 		var pushUserIDList []string
 		isSenderSync := datautil.GetSwitchFromOptions(pbData.MsgData.Options, constant.IsSenderSync)
@@ -118,8 +132,9 @@ func (*ConsumerHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil
 
 func (c *ConsumerHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	for msg := range claim.Messages() {
-		ctx := c.pushConsumerGroup.GetContextFromMsg(msg)
+		ctx, span := tracing.StartConsume(msg, c.config.KafkaConfig.ToPushGroupID)
 		c.handleMs2PsChat(ctx, msg.Value)
+		span.End()
 		sess.MarkMessage(msg, "")
 	}
 	return nil
@@ -128,7 +143,7 @@ func (c *ConsumerHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim s
 // Push2User Suitable for two types of conversations, one is SingleChatType and the other is NotificationChatType.
 func (c *ConsumerHandler) Push2User(ctx context.Context, userIDs []string, msg *sdkws.MsgData) error {
 	log.ZDebug(ctx, "Get msg from msg_transfer And push msg", "userIDs", userIDs, "msg", msg.String())
-	if err := c.webhookBeforeOnlinePush(ctx, &c.config.WebhooksConfig.BeforeOnlinePush, userIDs, msg); err != nil {
+	if err := c.webhookBeforeOnlinePush(ctx, &c.config.WebhooksConfig.Current().BeforeOnlinePush, userIDs, msg); err != nil {
 		return err
 	}
 	wsResults, err := c.onlinePusher.GetConnsAndOnlinePush(ctx, msg, userIDs)
@@ -155,7 +170,7 @@ func (c *ConsumerHandler) Push2User(ctx context.Context, userIDs []string, msg *
 	offlinePUshUserID := []string{msg.RecvID}
 
 	//receiver offline push
-	if err = c.webhookBeforeOfflinePush(ctx, &c.config.WebhooksConfig.BeforeOfflinePush,
+	if err = c.webhookBeforeOfflinePush(ctx, &c.config.WebhooksConfig.Current().BeforeOfflinePush,
 		offlinePUshUserID, msg, nil); err != nil {
 		return err
 	}
@@ -182,7 +197,7 @@ func (c *ConsumerHandler) shouldPushOffline(_ context.Context, msg *sdkws.MsgDat
 func (c *ConsumerHandler) Push2Group(ctx context.Context, groupID string, msg *sdkws.MsgData) (err error) {
 	log.ZDebug(ctx, "Get super group msg from msg_transfer and push msg", "msg", msg.String(), "groupID", groupID)
 	var pushToUserIDs []string
-	if err = c.webhookBeforeGroupOnlinePush(ctx, &c.config.WebhooksConfig.BeforeGroupOnlinePush, groupID, msg,
+	if err = c.webhookBeforeGroupOnlinePush(ctx, &c.config.WebhooksConfig.Current().BeforeGroupOnlinePush, groupID, msg,
 		&pushToUserIDs); err != nil {
 		return err
 	}
@@ -212,7 +227,7 @@ func (c *ConsumerHandler) Push2Group(ctx context.Context, groupID string, msg *s
 	// Use offline push messaging
 	if len(needOfflinePushUserIDs) > 0 {
 		var offlinePushUserIDs []string
-		err = c.webhookBeforeOfflinePush(ctx, &c.config.WebhooksConfig.BeforeOfflinePush, needOfflinePushUserIDs, msg, &offlinePushUserIDs)
+		err = c.webhookBeforeOfflinePush(ctx, &c.config.WebhooksConfig.Current().BeforeOfflinePush, needOfflinePushUserIDs, msg, &offlinePushUserIDs)
 		if err != nil {
 			return err
 		}
@@ -221,6 +236,11 @@ func (c *ConsumerHandler) Push2Group(ctx context.Context, groupID string, msg *s
 			needOfflinePushUserIDs = offlinePushUserIDs
 		}
 
+		needOfflinePushUserIDs = c.aggregator.Collapse(ctx, msg, needOfflinePushUserIDs)
+		if len(needOfflinePushUserIDs) == 0 {
+			return nil
+		}
+
 		err = c.offlinePushMsg(ctx, msg, needOfflinePushUserIDs)
 		if err != nil {
 			log.ZError(ctx, "offlinePushMsg failed", err, "groupID", groupID, "msg", msg)
@@ -231,6 +251,64 @@ func (c *ConsumerHandler) Push2Group(ctx context.Context, groupID string, msg *s
 
 	return nil
 }
+
+// Push2Thread pushes thread messages to the thread participants that are still in the group, in the same way as Push2Group.
+func (c *ConsumerHandler) Push2Thread(ctx context.Context, threadID string, msg *sdkws.MsgData) error {
+	log.ZDebug(ctx, "Get thread msg from msg_transfer and push msg", "msg", msg.String(), "threadID", threadID)
+	participantIDs, err := c.msgRpcClient.GetThreadParticipantIDs(ctx, threadID)
+	if err != nil {
+		return err
+	}
+	memberIDs, err := c.groupLocalCache.GetGroupMemberIDs(ctx, msg.GroupID)
+	if err != nil {
+		return err
+	}
+	pushToUserIDs := threadPushUserIDs(participantIDs, memberIDs)
+	if len(pushToUserIDs) == 0 {
+		return nil
+	}
+	wsResults, err := c.onlinePusher.GetConnsAndOnlinePush(ctx, msg, pushToUserIDs)
+	if err != nil {
+		return err
+	}
+
+	log.ZDebug(ctx, "thread push result", "result", wsResults, "msg", msg)
+
+	if !c.shouldPushOffline(ctx, msg) {
+		return nil
+	}
+	needOfflinePushUserIDs := c.onlinePusher.GetOnlinePushFailedUserIDs(ctx, msg, wsResults, &pushToUserIDs)
+	needOfflinePushUserIDs, err = c.filterGroupMessageOfflinePush(ctx, msg.GroupID, msg, needOfflinePushUserIDs)
+	if err != nil {
+		return err
+	}
+	if len(needOfflinePushUserIDs) > 0 {
+		var offlinePushUserIDs []string
+		err = c.webhookBeforeOfflinePush(ctx, &c.config.WebhooksConfig.Current().BeforeOfflinePush, needOfflinePushUserIDs, msg, &offlinePushUserIDs)
+		if err != nil {
+			return err
+		}
+		if len(offlinePushUserIDs) > 0 {
+			needOfflinePushUserIDs = offlinePushUserIDs
+		}
+		if err = c.offlinePushMsg(ctx, msg, needOfflinePushUserIDs); err != nil {
+			log.ZError(ctx, "offlinePushMsg failed", err, "threadID", threadID, "msg", msg)
+			return err
+		}
+	}
+	return nil
+}
+
+// threadPushUserIDs returns the participants that are still members of the group,
+// users who left or were kicked stay participants of the threads they replied to.
+func threadPushUserIDs(participantIDs, memberIDs []string) []string {
+	members := datautil.SliceSet(memberIDs)
+	return datautil.Filter(participantIDs, func(userID string) (string, bool) {
+		_, ok := members[userID]
+		return userID, ok
+	})
+}
+
 func (c *ConsumerHandler) groupMessagesHandler(ctx context.Context, groupID string, pushToUserIDs *[]string, msg *sdkws.MsgData) (err error) {
 	if len(*pushToUserIDs) == 0 {
 		*pushToUserIDs, err = c.groupLocalCache.GetGroupMemberIDs(ctx, groupID)
@@ -265,8 +343,8 @@ func (c *ConsumerHandler) groupMessagesHandler(ctx context.Context, groupID stri
 					return err
 				}
 				log.ZInfo(ctx, "GroupDismissedNotificationInfo****", "groupID", groupID, "num", len(*pushToUserIDs), "list", pushToUserIDs)
-				if len(c.config.Share.IMAdminUserID) > 0 {
-					ctx = mcontext.WithOpUserIDContext(ctx, c.config.Share.IMAdminUserID[0])
+				if imAdminUserID := c.config.Share.AdminUserIDs(); len(imAdminUserID) > 0 {
+					ctx = mcontext.WithOpUserIDContext(ctx, imAdminUserID[0])
 				}
 				defer func(groupID string) {
 					if err = c.groupRpcClient.DismissGroup(ctx, groupID); err != nil {
@@ -284,12 +362,56 @@ func (c *ConsumerHandler) offlinePushMsg(ctx context.Context, msg *sdkws.MsgData
 	if err != nil {
 		return err
 	}
-	err = c.offlinePusher.Push(ctx, offlinePushUserIDs, title, content, opts)
+	conversationID := msgprocessor.GetConversationIDByMsg(msg)
+	if c.aggregator.conversationWindow(conversationID) > 0 {
+		// Lets the digest of the window replace this notification on the device.
+		opts.CollapseID = conversationID
+		opts.ThreadID = conversationID
+	}
+	return c.offlinePush(ctx, conversationID, atUserIDs(msg), offlinePushUserIDs, title, content, opts)
+}
+
+// offlinePush pushes through the providers after applying the receivers' do not disturb schedules.
+func (c *ConsumerHandler) offlinePush(ctx context.Context, conversationID string, atUsers []string, offlinePushUserIDs []string,
+	title, content string, opts *options.Opts) error {
+	normalUserIDs, silentUserIDs := c.filterDoNotDisturb(ctx, conversationID, atUsers, offlinePushUserIDs)
+	var g errgroup.Group
+	if len(normalUserIDs) > 0 {
+		g.Go(func() error {
+			return c.offlinePushProviders(ctx, normalUserIDs, title, content, opts)
+		})
+	}
+	if len(silentUserIDs) > 0 {
+		silentOpts := *opts
+		silentOpts.Silent = true
+		g.Go(func() error {
+			return c.offlinePushProviders(ctx, silentUserIDs, title, content, &silentOpts)
+		})
+	}
+	return g.Wait()
+}
+
+func (c *ConsumerHandler) offlinePushProviders(ctx context.Context, offlinePushUserIDs []string, title, content string, opts *options.Opts) error {
+	providerUserIDs, err := c.offlinePusher.Split(ctx, offlinePushUserIDs)
 	if err != nil {
-		prommetrics.MsgOfflinePushFailedCounter.Inc()
 		return err
 	}
-	return nil
+	var g errgroup.Group
+	for provider, userIDs := range providerUserIDs {
+		provider, userIDs := provider, userIDs
+		g.Go(func() error {
+			if err := c.offlinePusher.Pusher(provider).Push(ctx, userIDs, title, content, opts); err != nil {
+				prommetrics.MsgOfflinePushFailedCounter.WithLabelValues(provider).Inc()
+				log.ZWarn(ctx, "offline push failed", err, "provider", provider, "userIDs", userIDs)
+				if retryErr := c.retryQueue.Add(ctx, provider, userIDs, title, content, opts, err); retryErr != nil {
+					log.ZError(ctx, "add offline push retry task failed", retryErr, "provider", provider)
+				}
+				return errs.WrapMsg(err, "offline push failed", "provider", provider)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
 }
 
 func (c *ConsumerHandler) filterGroupMessageOfflinePush(ctx context.Context, groupID string, msg *sdkws.MsgData,
@@ -0,0 +1,301 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	pbpushretry "github.com/openimsdk/open-im-server/v3/pkg/protocol/pushretry"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/idutil"
+)
+
+const (
+	defaultRetryMaxAttempts = 5
+	defaultRetryBackoff     = 10
+	defaultRetryMaxBackoff  = 600
+	defaultRetryInterval    = 5
+	defaultRetryBatchSize   = 100
+	defaultRetryLease       = 300
+)
+
+// retryTask is a failed offline push of one provider, stored as JSON in the retry queue. UserIDs are only
+// the users whose push failed.
+type retryTask struct {
+	ID          string        `json:"id"`
+	OperationID string        `json:"operationID"`
+	Provider    string        `json:"provider"`
+	UserIDs     []string      `json:"userIDs"`
+	Title       string        `json:"title"`
+	Content     string        `json:"content"`
+	Opts        *options.Opts `json:"opts"`
+	Attempts    int           `json:"attempts"`
+	LastError   string        `json:"lastError"`
+	CreateTime  int64         `json:"createTime"`
+	UpdateTime  int64         `json:"updateTime"`
+}
+
+type retryQueue struct {
+	cache cache.PushRetryCache
+	// pusher returns the pusher of a provider, nil if it is not configured
+	pusher      func(provider string) offlinepush.OfflinePusher
+	enable      bool
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	interval    time.Duration
+	batchSize   int
+	lease       time.Duration
+}
+
+func newRetryQueue(pushConf *config.Push, cache cache.PushRetryCache, pusher func(provider string) offlinepush.OfflinePusher) *retryQueue {
+	conf := pushConf.Retry
+	positive := func(v, def int) int {
+		if v <= 0 {
+			return def
+		}
+		return v
+	}
+	return &retryQueue{
+		cache:       cache,
+		pusher:      pusher,
+		enable:      conf.Enable,
+		maxAttempts: positive(conf.MaxAttempts, defaultRetryMaxAttempts),
+		backoff:     time.Duration(positive(conf.Backoff, defaultRetryBackoff)) * time.Second,
+		maxBackoff:  time.Duration(positive(conf.MaxBackoff, defaultRetryMaxBackoff)) * time.Second,
+		interval:    time.Duration(positive(conf.Interval, defaultRetryInterval)) * time.Second,
+		batchSize:   positive(conf.BatchSize, defaultRetryBatchSize),
+		lease:       time.Duration(positive(conf.Lease, defaultRetryLease)) * time.Second,
+	}
+}
+
+// Add stores a push that failed on its first attempt so it is retried later, for the users it failed for.
+func (q *retryQueue) Add(ctx context.Context, provider string, userIDs []string, title, content string,
+	opts *options.Opts, pushErr error) error {
+	if !q.enable {
+		return nil
+	}
+	userIDs = options.FailedUserIDs(pushErr, userIDs)
+	if len(userIDs) == 0 {
+		return nil
+	}
+	now := time.Now().UnixMilli()
+	task := &retryTask{
+		ID:          idutil.OperationIDGenerator(),
+		OperationID: mcontext.GetOperationID(ctx),
+		Provider:    provider,
+		UserIDs:     userIDs,
+		Title:       title,
+		Content:     content,
+		Opts:        opts,
+		Attempts:    1,
+		LastError:   pushErr.Error(),
+		CreateTime:  now,
+		UpdateTime:  now,
+	}
+	return q.reschedule(ctx, task)
+}
+
+// backoffDelay returns the delay before the next attempt of a task that has already been tried attempts times.
+func (q *retryQueue) backoffDelay(attempts int) time.Duration {
+	delay := q.backoff
+	for i := 1; i < attempts && delay < q.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > q.maxBackoff {
+		delay = q.maxBackoff
+	}
+	return delay
+}
+
+func (q *retryQueue) reschedule(ctx context.Context, task *retryTask) error {
+	data, err := json.Marshal(task)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if task.Attempts >= q.maxAttempts {
+		log.ZWarn(ctx, "offline push moved to dead letter", nil, "id", task.ID, "provider", task.Provider,
+			"attempts", task.Attempts, "lastError", task.LastError)
+		return q.cache.MoveToDeadLetter(ctx, task.ID, string(data), time.Now())
+	}
+	return q.cache.AddRetryTask(ctx, task.ID, string(data), time.Now().Add(q.backoffDelay(task.Attempts)))
+}
+
+// Run retries due pushes until ctx is done.
+func (q *retryQueue) Run(ctx context.Context) {
+	if !q.enable {
+		return
+	}
+	ticker := time.NewTicker(q.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			q.retryDue(ctx)
+		}
+	}
+}
+
+func (q *retryQueue) retryDue(ctx context.Context) {
+	for {
+		now := time.Now()
+		tasks, err := q.cache.LeaseDueRetryTasks(ctx, now, now.Add(q.lease), q.batchSize)
+		if err != nil {
+			log.ZError(ctx, "lease offline push retry tasks failed", err)
+			return
+		}
+		for id, data := range tasks {
+			var task retryTask
+			if err := json.Unmarshal([]byte(data), &task); err != nil {
+				log.ZError(ctx, "unmarshal offline push retry task failed", err, "task", data)
+				q.ack(ctx, id)
+				continue
+			}
+			q.retry(mcontext.SetOperationID(ctx, task.OperationID), &task)
+		}
+		if len(tasks) < q.batchSize {
+			return
+		}
+	}
+}
+
+func (q *retryQueue) ack(ctx context.Context, id string) {
+	if err := q.cache.AckRetryTask(ctx, id); err != nil {
+		log.ZError(ctx, "ack offline push retry task failed", err, "id", id)
+	}
+}
+
+// retry pushes a leased task again, it is acked once pushed and rescheduled with the users still failing otherwise.
+func (q *retryQueue) retry(ctx context.Context, task *retryTask) {
+	pusher := q.pusher(task.Provider)
+	if pusher == nil {
+		task.LastError = "push provider not configured"
+		task.Attempts = q.maxAttempts
+	} else if err := pusher.Push(ctx, task.UserIDs, task.Title, task.Content, task.Opts); err != nil {
+		prommetrics.MsgOfflinePushFailedCounter.WithLabelValues(task.Provider).Inc()
+		task.UserIDs = options.FailedUserIDs(err, task.UserIDs)
+		task.LastError = err.Error()
+		task.Attempts++
+		if len(task.UserIDs) == 0 {
+			q.ack(ctx, task.ID)
+			return
+		}
+	} else {
+		log.ZDebug(ctx, "offline push retry success", "id", task.ID, "provider", task.Provider, "attempts", task.Attempts+1)
+		q.ack(ctx, task.ID)
+		return
+	}
+	task.UpdateTime = time.Now().UnixMilli()
+	if err := q.reschedule(ctx, task); err != nil {
+		log.ZError(ctx, "reschedule offline push retry task failed", err, "id", task.ID)
+	}
+}
+
+func (q *retryQueue) decodeDeadLetters(tasks []string) []*pbpushretry.DeadLetter {
+	deadLetters := make([]*pbpushretry.DeadLetter, 0, len(tasks))
+	for _, data := range tasks {
+		var task retryTask
+		if err := json.Unmarshal([]byte(data), &task); err != nil {
+			continue
+		}
+		deadLetter := &pbpushretry.DeadLetter{
+			Id:         task.ID,
+			Provider:   task.Provider,
+			UserIDs:    task.UserIDs,
+			Title:      task.Title,
+			Content:    task.Content,
+			Attempts:   int32(task.Attempts),
+			LastError:  task.LastError,
+			CreateTime: task.CreateTime,
+			UpdateTime: task.UpdateTime,
+		}
+		if task.Opts != nil {
+			deadLetter.Ex = task.Opts.Ex
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	return deadLetters
+}
+
+func (p pushServer) GetDeadLetters(ctx context.Context, req *pbpushretry.GetDeadLettersReq) (*pbpushretry.GetDeadLettersResp, error) {
+	if err := authverify.CheckAdmin(ctx, p.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	var offset, count int64 = 0, 20
+	if req.Pagination != nil && req.Pagination.ShowNumber > 0 {
+		count = int64(req.Pagination.ShowNumber)
+		if req.Pagination.PageNumber > 0 {
+			offset = int64(req.Pagination.PageNumber-1) * count
+		}
+	}
+	total, tasks, err := p.retryQueue.cache.GetDeadLetters(ctx, offset, count)
+	if err != nil {
+		return nil, err
+	}
+	return &pbpushretry.GetDeadLettersResp{Total: total, DeadLetters: p.retryQueue.decodeDeadLetters(tasks)}, nil
+}
+
+func (p pushServer) ReplayDeadLetters(ctx context.Context, req *pbpushretry.ReplayDeadLettersReq) (*pbpushretry.ReplayDeadLettersResp, error) {
+	if err := authverify.CheckAdmin(ctx, p.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	if len(req.Ids) == 0 {
+		return nil, errs.ErrArgs.WrapMsg("ids is empty")
+	}
+	tasks, err := p.retryQueue.cache.TakeDeadLetters(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	for id, data := range tasks {
+		var task retryTask
+		if err := json.Unmarshal([]byte(data), &task); err != nil {
+			log.ZError(ctx, "unmarshal dead letter failed", err, "id", id)
+			continue
+		}
+		task.Attempts = 0
+		task.UpdateTime = time.Now().UnixMilli()
+		data, err := json.Marshal(&task)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		if err := p.retryQueue.cache.AddRetryTask(ctx, task.ID, string(data), time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	return &pbpushretry.ReplayDeadLettersResp{NotFoundIDs: datautil.SliceSub(req.Ids, datautil.Keys(tasks))}, nil
+}
+
+func (p pushServer) DeleteDeadLetters(ctx context.Context, req *pbpushretry.DeleteDeadLettersReq) (*pbpushretry.DeleteDeadLettersResp, error) {
+	if err := authverify.CheckAdmin(ctx, p.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	if _, err := p.retryQueue.cache.TakeDeadLetters(ctx, req.Ids); err != nil {
+		return nil, err
+	}
+	return &pbpushretry.DeleteDeadLettersResp{}, nil
+}
@@ -0,0 +1,174 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockPushRetryCache struct {
+	cache.PushRetryCache
+	tasks map[string]string
+	due   map[string]time.Time
+	dead  map[string]string
+}
+
+func newMockPushRetryCache() *mockPushRetryCache {
+	return &mockPushRetryCache{tasks: make(map[string]string), due: make(map[string]time.Time), dead: make(map[string]string)}
+}
+
+func (m *mockPushRetryCache) AddRetryTask(ctx context.Context, id string, task string, next time.Time) error {
+	m.tasks[id] = task
+	m.due[id] = next
+	return nil
+}
+
+func (m *mockPushRetryCache) LeaseDueRetryTasks(ctx context.Context, now time.Time, leaseUntil time.Time, count int) (map[string]string, error) {
+	tasks := make(map[string]string)
+	for id, due := range m.due {
+		if len(tasks) < count && !due.After(now) {
+			tasks[id] = m.tasks[id]
+			m.due[id] = leaseUntil
+		}
+	}
+	return tasks, nil
+}
+
+func (m *mockPushRetryCache) AckRetryTask(ctx context.Context, id string) error {
+	delete(m.tasks, id)
+	delete(m.due, id)
+	return nil
+}
+
+func (m *mockPushRetryCache) MoveToDeadLetter(ctx context.Context, id string, task string, failTime time.Time) error {
+	delete(m.tasks, id)
+	delete(m.due, id)
+	m.dead[id] = task
+	return nil
+}
+
+func (m *mockPushRetryCache) task(t *testing.T, id string) *retryTask {
+	var task retryTask
+	assert.NoError(t, json.Unmarshal([]byte(m.tasks[id]), &task))
+	return &task
+}
+
+// mockPusher fails the users in fail, with a UsersError when it pushed the others.
+type mockPusher struct {
+	fail   map[string]bool
+	pushed [][]string
+}
+
+func (m *mockPusher) Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error {
+	m.pushed = append(m.pushed, userIDs)
+	var failed []string
+	for _, userID := range userIDs {
+		if m.fail[userID] {
+			failed = append(failed, userID)
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return &options.UsersError{UserIDs: failed, Err: errors.New("push failed")}
+}
+
+func newTestRetryQueue(retryCache cache.PushRetryCache, pusher offlinepush.OfflinePusher) *retryQueue {
+	var pushConf config.Push
+	pushConf.Retry.Enable = true
+	pushConf.Retry.MaxAttempts = 3
+	return newRetryQueue(&pushConf, retryCache, func(provider string) offlinepush.OfflinePusher {
+		if provider != "apns" {
+			return nil
+		}
+		return pusher
+	})
+}
+
+func TestRetryBackoffDelay(t *testing.T) {
+	q := newTestRetryQueue(newMockPushRetryCache(), &mockPusher{})
+	assert.Equal(t, 10*time.Second, q.backoffDelay(1))
+	assert.Equal(t, 20*time.Second, q.backoffDelay(2))
+	assert.Equal(t, 80*time.Second, q.backoffDelay(4))
+	assert.Equal(t, 600*time.Second, q.backoffDelay(7))
+	assert.Equal(t, 600*time.Second, q.backoffDelay(100))
+}
+
+func TestRetryQueueAdd(t *testing.T) {
+	retryCache := newMockPushRetryCache()
+	q := newTestRetryQueue(retryCache, &mockPusher{})
+	ctx := context.Background()
+
+	// only the users the first push failed for are queued
+	pushErr := &options.UsersError{UserIDs: []string{"u2"}, Err: errors.New("push failed")}
+	assert.NoError(t, q.Add(ctx, "apns", []string{"u1", "u2"}, "title", "content", &options.Opts{}, pushErr))
+	assert.NoError(t, q.Add(ctx, "apns", []string{"u3", "u4"}, "title", "content", &options.Opts{}, errors.New("push failed")))
+	assert.NoError(t, q.Add(ctx, "apns", []string{"u5"}, "title", "content", &options.Opts{}, &options.UsersError{Err: errors.New("token invalid")}))
+	var userIDs [][]string
+	for id := range retryCache.tasks {
+		userIDs = append(userIDs, retryCache.task(t, id).UserIDs)
+	}
+	assert.ElementsMatch(t, [][]string{{"u2"}, {"u3", "u4"}}, userIDs)
+}
+
+func TestRetryQueueRetryDue(t *testing.T) {
+	retryCache := newMockPushRetryCache()
+	pusher := &mockPusher{fail: map[string]bool{"u2": true}}
+	q := newTestRetryQueue(retryCache, pusher)
+	ctx := context.Background()
+	assert.NoError(t, q.Add(ctx, "apns", []string{"u1", "u2"}, "title", "content", &options.Opts{}, errors.New("push failed")))
+	assert.NoError(t, q.Add(ctx, "getui", []string{"u3"}, "title", "content", &options.Opts{}, errors.New("push failed")))
+	for id := range retryCache.due {
+		retryCache.due[id] = time.Now()
+	}
+
+	q.retryDue(ctx)
+	assert.Equal(t, [][]string{{"u1", "u2"}}, pusher.pushed)
+	// the provider no longer configured is given up, the users pushed are not pushed again
+	assert.Len(t, retryCache.dead, 1)
+	assert.Len(t, retryCache.tasks, 1)
+	id := datautil.Keys(retryCache.tasks)[0]
+	task := retryCache.task(t, id)
+	assert.Equal(t, []string{"u2"}, task.UserIDs)
+	assert.Equal(t, 2, task.Attempts)
+	assert.WithinDuration(t, time.Now().Add(q.backoffDelay(2)), retryCache.due[id], time.Second)
+
+	// the last attempt moves the task to the dead letters
+	retryCache.due[id] = time.Now()
+	q.retryDue(ctx)
+	assert.Empty(t, retryCache.tasks)
+	assert.Len(t, retryCache.dead, 2)
+
+	// a task pushed is acked
+	delete(pusher.fail, "u2")
+	assert.NoError(t, q.Add(ctx, "apns", []string{"u2"}, "title", "content", &options.Opts{}, errors.New("push failed")))
+	for id := range retryCache.due {
+		retryCache.due[id] = time.Now()
+	}
+	q.retryDue(ctx)
+	assert.Empty(t, retryCache.tasks)
+	assert.Empty(t, retryCache.due)
+}
@@ -0,0 +1,30 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThreadPushUserIDs(t *testing.T) {
+	participantIDs := []string{"u1", "u2", "u3", "u4"}
+	// u2 left the group and u4 was kicked, u5 never replied to the thread
+	memberIDs := []string{"u1", "u3", "u5"}
+	assert.Equal(t, []string{"u1", "u3"}, threadPushUserIDs(participantIDs, memberIDs))
+	assert.Empty(t, threadPushUserIDs(participantIDs, nil))
+	assert.Empty(t, threadPushUserIDs(nil, memberIDs))
+}
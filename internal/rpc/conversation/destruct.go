@@ -0,0 +1,57 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	pbmsgdestruct "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgdestruct"
+)
+
+// GetConversationsNeedDestruct returns the timed destruct conversations whose msgs are due, it is called by the msg rpc.
+func (c *conversationServer) GetConversationsNeedDestruct(ctx context.Context, req *pbmsgdestruct.GetConversationsNeedDestructReq) (*pbmsgdestruct.GetConversationsNeedDestructResp, error) {
+	if err := authverify.CheckAdmin(ctx, c.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	conversations, err := c.conversationDatabase.GetConversationIDsNeedDestruct(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbmsgdestruct.GetConversationsNeedDestructResp{Conversations: make([]*pbmsgdestruct.DestructConversation, 0, len(conversations))}
+	for _, conversation := range conversations {
+		resp.Conversations = append(resp.Conversations, &pbmsgdestruct.DestructConversation{
+			OwnerUserID:           conversation.OwnerUserID,
+			ConversationID:        conversation.ConversationID,
+			MsgDestructTime:       conversation.MsgDestructTime,
+			LatestMsgDestructTime: conversation.LatestMsgDestructTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+// SetLatestMsgDestructTime records a sweep of a timed destruct conversation and tells its owner about the change.
+func (c *conversationServer) SetLatestMsgDestructTime(ctx context.Context, req *pbmsgdestruct.SetLatestMsgDestructTimeReq) (*pbmsgdestruct.SetLatestMsgDestructTimeResp, error) {
+	if err := authverify.CheckAdmin(ctx, c.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	args := map[string]any{"latest_msg_destruct_time": time.UnixMilli(req.LatestMsgDestructTime)}
+	if err := c.conversationDatabase.UpdateUsersConversationField(ctx, []string{req.OwnerUserID}, req.ConversationID, args); err != nil {
+		return nil, err
+	}
+	c.conversationNotificationSender.ConversationChangeNotification(ctx, req.OwnerUserID, []string{req.ConversationID})
+	return &pbmsgdestruct.SetLatestMsgDestructTimeResp{}, nil
+}
@@ -16,6 +16,7 @@ package msg
 
 import (
 	"context"
+	"strings"
 
 	cbapi "github.com/openimsdk/open-im-server/v3/pkg/callbackstruct"
 	"github.com/openimsdk/protocol/constant"
@@ -80,9 +81,22 @@ func (m *msgServer) SetConversationHasReadSeq(ctx context.Context, req *msg.SetC
 	if req.HasReadSeq > maxSeq {
 		return nil, errs.ErrArgs.WrapMsg("hasReadSeq must not be bigger than maxSeq")
 	}
+	hasReadSeq, err := m.MsgDatabase.GetHasReadSeq(ctx, req.UserID, req.ConversationID)
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		return nil, err
+	}
 	if err := m.MsgDatabase.SetHasReadSeq(ctx, req.UserID, req.ConversationID, req.HasReadSeq); err != nil {
 		return nil, err
 	}
+	if req.HasReadSeq > hasReadSeq && strings.HasPrefix(req.ConversationID, "si_") {
+		conversation, err := m.ConversationLocalCache.GetConversation(ctx, req.UserID, req.ConversationID)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.addBurnMsgs(ctx, conversation, req.UserID, hasReadSeq, req.HasReadSeq); err != nil {
+			return nil, err
+		}
+	}
 	m.sendMarkAsReadNotification(ctx, req.ConversationID, constant.SingleChatType, req.UserID, req.UserID, nil, req.HasReadSeq)
 	return &msg.SetConversationHasReadSeqResp{}, nil
 }
@@ -115,6 +129,12 @@ func (m *msgServer) MarkMsgsAsRead(ctx context.Context, req *msg.MarkMsgsAsReadR
 		if err != nil {
 			return nil, err
 		}
+		if err := m.addBurnMsgs(ctx, conversation, req.UserID, currentHasReadSeq, hasReadSeq); err != nil {
+			return nil, err
+		}
+		if conversation.ConversationType == constant.ReadGroupChatType {
+			m.sendGroupMsgReadNotification(ctx, req.ConversationID, conversation.GroupID, req.UserID, currentHasReadSeq, hasReadSeq)
+		}
 	}
 
 	reqCallback := &cbapi.CallbackSingleMsgReadReq{
@@ -123,7 +143,7 @@ func (m *msgServer) MarkMsgsAsRead(ctx context.Context, req *msg.MarkMsgsAsReadR
 		Seqs:           req.Seqs,
 		ContentType:    conversation.ConversationType,
 	}
-	m.webhookAfterSingleMsgRead(ctx, &m.config.WebhooksConfig.AfterSingleMsgRead, reqCallback)
+	m.webhookAfterSingleMsgRead(ctx, &m.config.WebhooksConfig.Current().AfterSingleMsgRead, reqCallback)
 	m.sendMarkAsReadNotification(ctx, req.ConversationID, conversation.ConversationType, req.UserID,
 		m.conversationAndGetRecvID(conversation, req.UserID), req.Seqs, hasReadSeq)
 	return &msg.MarkMsgsAsReadResp{}, nil
@@ -162,6 +182,9 @@ func (m *msgServer) MarkConversationAsRead(ctx context.Context, req *msg.MarkCon
 			if err != nil {
 				return nil, err
 			}
+			if err := m.addBurnMsgs(ctx, conversation, req.UserID, hasReadSeq, req.HasReadSeq); err != nil {
+				return nil, err
+			}
 			hasReadSeq = req.HasReadSeq
 		}
 		m.sendMarkAsReadNotification(ctx, req.ConversationID, conversation.ConversationType, req.UserID,
@@ -173,6 +196,9 @@ func (m *msgServer) MarkConversationAsRead(ctx context.Context, req *msg.MarkCon
 			if err != nil {
 				return nil, err
 			}
+			if conversation.ConversationType == constant.ReadGroupChatType {
+				m.sendGroupMsgReadNotification(ctx, req.ConversationID, conversation.GroupID, req.UserID, hasReadSeq, req.HasReadSeq)
+			}
 			hasReadSeq = req.HasReadSeq
 		}
 		m.sendMarkAsReadNotification(ctx, req.ConversationID, constant.SingleChatType, req.UserID,
@@ -186,7 +212,7 @@ func (m *msgServer) MarkConversationAsRead(ctx context.Context, req *msg.MarkCon
 		ContentType:  int64(conversation.ConversationType),
 	}
 
-	m.webhookAfterGroupMsgRead(ctx, &m.config.WebhooksConfig.AfterGroupMsgRead, reqCall)
+	m.webhookAfterGroupMsgRead(ctx, &m.config.WebhooksConfig.Current().AfterGroupMsgRead, reqCall)
 	return &msg.MarkConversationAsReadResp{}, nil
 }
 
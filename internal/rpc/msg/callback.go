@@ -176,3 +176,20 @@ func (m *msgServer) webhookAfterRevokeMsg(ctx context.Context, after *config.Aft
 	}
 	m.webhookClient.AsyncPost(ctx, callbackReq.GetCallbackCommand(), callbackReq, &cbapi.CallbackAfterRevokeMsgResp{}, after)
 }
+
+func (m *msgServer) webhookBeforeEditMsg(ctx context.Context, before *config.BeforeConfig, req *cbapi.CallbackBeforeEditMsgReq) error {
+	return webhook.WithCondition(ctx, before, func(ctx context.Context) error {
+		req.CallbackCommand = cbapi.CallbackBeforeEditMsgCommand
+		resp := &cbapi.CallbackBeforeEditMsgResp{}
+		if err := m.webhookClient.SyncPost(ctx, req.GetCallbackCommand(), req, resp, before); err != nil {
+			return err
+		}
+		datautil.NotNilReplace(&req.Content, resp.Content)
+		return nil
+	})
+}
+
+func (m *msgServer) webhookAfterEditMsg(ctx context.Context, after *config.AfterConfig, req *cbapi.CallbackAfterEditMsgReq) {
+	req.CallbackCommand = cbapi.CallbackAfterEditMsgCommand
+	m.webhookClient.AsyncPost(ctx, req.GetCallbackCommand(), req, &cbapi.CallbackAfterEditMsgResp{}, after)
+}
@@ -2,48 +2,44 @@ package msg
 
 import (
 	"context"
+	"fmt"
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
-	"github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/protocol/msg"
-	"github.com/openimsdk/protocol/wrapperspb"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
-	"strings"
 	"time"
 )
 
 func (m *msgServer) ClearMsg(ctx context.Context, req *msg.ClearMsgReq) (_ *msg.ClearMsgResp, err error) {
-	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.AdminUserIDs()); err != nil {
 		return nil, err
 	}
 	if req.Timestamp > time.Now().UnixMilli() {
 		return nil, errs.ErrArgs.WrapMsg("request millisecond timestamp error")
 	}
+	holds, err := m.legalHold.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the docs of the held conversations are skipped by the query, the holds are audited once per clearing
+	skipDocIDPattern := holds.DocIDPattern()
+	if !holds.Empty() {
+		m.legalHold.Audit(ctx, holds.HoldIDs(), "ClearMsg", "*", fmt.Sprintf("timestamp: %d", req.Timestamp))
+	}
 	var (
 		docNum int
 		msgNum int
 		start  = time.Now()
 	)
 	clearMsg := func(ctx context.Context) (bool, error) {
-		conversationSeqs := make(map[string]struct{})
-		defer func() {
-			req := &conversation.UpdateConversationReq{
-				MsgDestructTime: wrapperspb.Int64(time.Now().UnixMilli()),
-			}
-			for conversationID := range conversationSeqs {
-				req.ConversationID = conversationID
-				if err := m.Conversation.UpdateConversations(ctx, req); err != nil {
-					log.ZError(ctx, "update conversation max seq failed", err, "conversationID", conversationID, "msgDestructTime", req.MsgDestructTime)
-				}
-			}
-		}()
-		msgs, err := m.MsgDatabase.GetBeforeMsg(ctx, req.Timestamp, 100)
+		msgs, err := m.MsgDatabase.GetBeforeMsg(ctx, req.Timestamp, skipDocIDPattern, 100)
 		if err != nil {
 			return false, err
 		}
 		if len(msgs) == 0 {
 			return false, nil
 		}
+		// DeleteDocMsgBefore moves the min seq of each conversation forward, so no member pulls the cleared msgs
 		for _, msg := range msgs {
 			index, err := m.MsgDatabase.DeleteDocMsgBefore(ctx, req.Timestamp, msg)
 			if err != nil {
@@ -54,10 +50,6 @@ func (m *msgServer) ClearMsg(ctx context.Context, req *msg.ClearMsgReq) (_ *msg.
 			}
 			docNum++
 			msgNum += len(index)
-			conversationID := msg.DocID[:strings.LastIndex(msg.DocID, ":")]
-			if _, ok := conversationSeqs[conversationID]; !ok {
-				conversationSeqs[conversationID] = struct{}{}
-			}
 		}
 		return true, nil
 	}
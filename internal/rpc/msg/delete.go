@@ -23,6 +23,7 @@ import (
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/timeutil"
 )
 
@@ -42,7 +43,10 @@ func (m *msgServer) validateDeleteSyncOpt(opt *msg.DeleteSyncOpt) (isSyncSelf, i
 }
 
 func (m *msgServer) ClearConversationsMsg(ctx context.Context, req *msg.ClearConversationsMsgReq) (*msg.ClearConversationsMsgResp, error) {
-	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	if err := m.checkConversationsHold(ctx, "ClearConversationsMsg", req.UserID, req.ConversationIDs); err != nil {
 		return nil, err
 	}
 	if err := m.clearConversation(ctx, req.ConversationIDs, req.UserID, req.DeleteSyncOpt); err != nil {
@@ -52,13 +56,28 @@ func (m *msgServer) ClearConversationsMsg(ctx context.Context, req *msg.ClearCon
 }
 
 func (m *msgServer) UserClearAllMsg(ctx context.Context, req *msg.UserClearAllMsgReq) (*msg.UserClearAllMsgResp, error) {
-	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.AdminUserIDs()); err != nil {
 		return nil, err
 	}
 	conversationIDs, err := m.ConversationLocalCache.GetConversationIDs(ctx, req.UserID)
 	if err != nil {
 		return nil, err
 	}
+	holds, err := m.legalHold.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if holdIDs := holds.UserHoldIDs(req.UserID); len(holdIDs) > 0 {
+		return nil, m.legalHold.Block(ctx, holdIDs, "UserClearAllMsg", req.UserID, "")
+	}
+	// the held conversations are kept, the others are cleared
+	conversationIDs = datautil.Filter(conversationIDs, func(conversationID string) (string, bool) {
+		if holdIDs := holds.ConversationHoldIDs(conversationID); len(holdIDs) > 0 {
+			m.legalHold.Audit(ctx, holdIDs, "UserClearAllMsg", conversationID, "userID: "+req.UserID)
+			return "", false
+		}
+		return conversationID, true
+	})
 	if err := m.clearConversation(ctx, conversationIDs, req.UserID, req.DeleteSyncOpt); err != nil {
 		return nil, err
 	}
@@ -66,7 +85,10 @@ func (m *msgServer) UserClearAllMsg(ctx context.Context, req *msg.UserClearAllMs
 }
 
 func (m *msgServer) DeleteMsgs(ctx context.Context, req *msg.DeleteMsgsReq) (*msg.DeleteMsgsResp, error) {
-	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	if err := m.checkConversationsHold(ctx, "DeleteMsgs", req.UserID, []string{req.ConversationID}); err != nil {
 		return nil, err
 	}
 	isSyncSelf, isSyncOther := m.validateDeleteSyncOpt(req.DeleteSyncOpt)
@@ -100,6 +122,9 @@ func (m *msgServer) DeleteMsgs(ctx context.Context, req *msg.DeleteMsgsReq) (*ms
 }
 
 func (m *msgServer) DeleteMsgPhysicalBySeq(ctx context.Context, req *msg.DeleteMsgPhysicalBySeqReq) (*msg.DeleteMsgPhysicalBySeqResp, error) {
+	if err := m.checkConversationsHold(ctx, "DeleteMsgPhysicalBySeq", "", []string{req.ConversationID}); err != nil {
+		return nil, err
+	}
 	err := m.MsgDatabase.DeleteMsgsPhysicalBySeqs(ctx, req.ConversationID, req.Seqs)
 	if err != nil {
 		return nil, err
@@ -108,11 +133,19 @@ func (m *msgServer) DeleteMsgPhysicalBySeq(ctx context.Context, req *msg.DeleteM
 }
 
 func (m *msgServer) DeleteMsgPhysical(ctx context.Context, req *msg.DeleteMsgPhysicalReq) (*msg.DeleteMsgPhysicalResp, error) {
-	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	holds, err := m.legalHold.Load(ctx)
+	if err != nil {
 		return nil, err
 	}
 	remainTime := timeutil.GetCurrentTimestampBySecond() - req.Timestamp
 	for _, conversationID := range req.ConversationIDs {
+		if holdIDs := holds.ConversationHoldIDs(conversationID); len(holdIDs) > 0 {
+			m.legalHold.Audit(ctx, holdIDs, "DeleteMsgPhysical", conversationID, "")
+			continue
+		}
 		if err := m.MsgDatabase.DeleteConversationMsgsAndSetMinSeq(ctx, conversationID, remainTime); err != nil {
 			log.ZWarn(ctx, "DeleteConversationMsgsAndSetMinSeq error", err, "conversationID", conversationID, "err", err)
 		}
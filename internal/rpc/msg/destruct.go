@@ -0,0 +1,183 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	pbmsgdestruct "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgdestruct"
+	"github.com/openimsdk/protocol/constant"
+	pbconversation "github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+)
+
+const (
+	// burnBatchSize bounds the seqs loaded and deleted at a time when burning a range.
+	burnBatchSize = 500
+	// burnRetryMaxDelay caps the back off of a range that keeps failing to burn.
+	burnRetryMaxDelay = time.Hour * 24
+	// burnHoldDelay is how long a range frozen by a legal hold waits before its hold is checked again.
+	burnHoldDelay = time.Hour
+)
+
+// burnRetryDelay is the back off applied to a range after its attempts-th failure.
+func burnRetryDelay(attempts int32) time.Duration {
+	if attempts >= 10 {
+		return burnRetryMaxDelay
+	}
+	return min(time.Minute<<attempts, burnRetryMaxDelay)
+}
+
+// addBurnMsgs schedules the msgs of a burn after reading conversation that userID has just read in (startSeq, endSeq].
+func (m *msgServer) addBurnMsgs(ctx context.Context, conversation *pbconversation.Conversation, userID string, startSeq, endSeq int64) error {
+	if conversation.ConversationType != constant.SingleChatType || !conversation.IsPrivateChat || conversation.BurnDuration <= 0 || endSeq <= startSeq {
+		return nil
+	}
+	burnTime := time.Now().Add(time.Duration(conversation.BurnDuration) * time.Second)
+	return m.MsgBurnDatabase.AddBurnMsgs(ctx, conversation.ConversationID, userID, startSeq, endSeq, burnTime)
+}
+
+// DestructMsgs physically deletes the burn after reading msgs whose burn duration has passed since they were read,
+// and hides the msgs older than the destruct time of the timed destruct conversations from their owners.
+func (m *msgServer) DestructMsgs(ctx context.Context, req *pbmsgdestruct.DestructMsgsReq) (*pbmsgdestruct.DestructMsgsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	holds, err := m.legalHold.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbmsgdestruct.DestructMsgsResp{}
+	burns, err := m.MsgBurnDatabase.FindDueBurnMsgs(ctx, time.Now(), int(req.BurnLimit))
+	if err != nil {
+		return nil, err
+	}
+	for _, burn := range burns {
+		if holdIDs := holds.ConversationHoldIDs(burn.ConversationID); len(holdIDs) > 0 {
+			// the range is kept, so the msgs are still burnt after the hold is released
+			m.legalHold.Audit(ctx, holdIDs, "DestructMsgs", burn.ConversationID, "burn after reading")
+			if err := m.MsgBurnDatabase.HoldBurnMsgs(ctx, burn, time.Now().Add(burnHoldDelay)); err != nil {
+				log.ZError(ctx, "hold burn msgs failed", err, "conversationID", burn.ConversationID, "userID", burn.UserID, "endSeq", burn.EndSeq)
+			}
+			continue
+		}
+		num, err := m.burnMsgs(ctx, burn)
+		if err != nil {
+			log.ZError(ctx, "burn msgs failed", err, "conversationID", burn.ConversationID, "userID", burn.UserID, "startSeq", burn.StartSeq, "endSeq", burn.EndSeq, "attempts", burn.Attempts)
+			burnTime := time.Now().Add(burnRetryDelay(burn.Attempts))
+			if err := m.MsgBurnDatabase.DelayBurnMsgs(ctx, burn, burnTime); err != nil {
+				log.ZError(ctx, "delay burn msgs failed", err, "conversationID", burn.ConversationID, "userID", burn.UserID, "endSeq", burn.EndSeq)
+			}
+			continue
+		}
+		resp.BurnMsgNum += num
+		if err := m.MsgBurnDatabase.DeleteBurnMsgs(ctx, burn); err != nil {
+			log.ZError(ctx, "delete burn msgs failed", err, "conversationID", burn.ConversationID, "userID", burn.UserID, "endSeq", burn.EndSeq)
+		}
+	}
+	conversations, err := m.Conversation.GetConversationsNeedDestruct(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, conversation := range conversations {
+		holdIDs := holds.UserHoldIDs(conversation.OwnerUserID)
+		if len(holdIDs) == 0 {
+			holdIDs = holds.ConversationHoldIDs(conversation.ConversationID)
+		}
+		num, err := m.destructUserMsgs(ctx, conversation, holdIDs)
+		if err != nil {
+			log.ZError(ctx, "destruct user msgs failed", err, "conversationID", conversation.ConversationID, "ownerUserID", conversation.OwnerUserID)
+			continue
+		}
+		resp.DestructConversationNum++
+		resp.DestructMsgNum += num
+	}
+	return resp, nil
+}
+
+// burnMsgs physically deletes the msgs of the range sent by the peer of the reader and tells both sides to drop them,
+// burnBatchSize seqs at a time. The batches already burnt are skipped when a failed range is retried.
+func (m *msgServer) burnMsgs(ctx context.Context, burn *relation.MsgBurnModel) (int64, error) {
+	var num int64
+	for start := burn.StartSeq + 1; start <= burn.EndSeq; start += burnBatchSize {
+		end := min(start+burnBatchSize-1, burn.EndSeq)
+		seqs := make([]int64, 0, end-start+1)
+		for seq := start; seq <= end; seq++ {
+			seqs = append(seqs, seq)
+		}
+		n, err := m.burnMsgsBySeqs(ctx, burn, seqs)
+		if err != nil {
+			return num, err
+		}
+		num += n
+	}
+	return num, nil
+}
+
+func (m *msgServer) burnMsgsBySeqs(ctx context.Context, burn *relation.MsgBurnModel, seqs []int64) (int64, error) {
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, burn.UserID, burn.ConversationID, seqs)
+	if err != nil {
+		return 0, err
+	}
+	var (
+		peerUserID string
+		burnSeqs   []int64
+	)
+	for _, msg := range msgs {
+		if msg.SendID == "" || msg.SendID == burn.UserID {
+			continue
+		}
+		peerUserID = msg.SendID
+		burnSeqs = append(burnSeqs, msg.Seq)
+	}
+	if len(burnSeqs) == 0 {
+		return 0, nil
+	}
+	if err := m.MsgDatabase.DeleteMsgsPhysicalBySeqs(ctx, burn.ConversationID, burnSeqs); err != nil {
+		return 0, err
+	}
+	tips := &sdkws.DeleteMsgsTips{UserID: burn.UserID, ConversationID: burn.ConversationID, Seqs: burnSeqs}
+	m.notificationSender.NotificationWithSessionType(ctx, burn.UserID, peerUserID, constant.DeleteMsgsNotification, constant.SingleChatType, tips)
+	return int64(len(burnSeqs)), nil
+}
+
+// destructUserMsgs hides the expired msgs of a timed destruct conversation from its owner, the sweep is skipped
+// but still recorded when the owner or the conversation is under legal hold. The sweep time is saved through
+// the conversation rpc, which clears its cache and tells the owner the conversation changed.
+func (m *msgServer) destructUserMsgs(ctx context.Context, conversation *pbmsgdestruct.DestructConversation, holdIDs []string) (int64, error) {
+	now := time.Now()
+	var seqs []int64
+	if len(holdIDs) > 0 {
+		m.legalHold.Audit(ctx, holdIDs, "DestructMsgs", conversation.ConversationID, conversation.OwnerUserID)
+	} else {
+		var err error
+		seqs, err = m.MsgDatabase.UserMsgsDestruct(ctx, conversation.OwnerUserID, conversation.ConversationID, conversation.MsgDestructTime, time.UnixMilli(conversation.LatestMsgDestructTime))
+		if err != nil {
+			return 0, err
+		}
+	}
+	if err := m.Conversation.SetLatestMsgDestructTime(ctx, conversation.OwnerUserID, conversation.ConversationID, now); err != nil {
+		return 0, err
+	}
+	if len(seqs) > 0 {
+		tips := &sdkws.DeleteMsgsTips{UserID: conversation.OwnerUserID, ConversationID: conversation.ConversationID, Seqs: seqs}
+		m.notificationSender.NotificationWithSessionType(ctx, conversation.OwnerUserID, conversation.OwnerUserID, constant.DeleteMsgsNotification, constant.SingleChatType, tips)
+	}
+	return int64(len(seqs)), nil
+}
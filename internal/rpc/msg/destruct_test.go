@@ -0,0 +1,227 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/legalhold"
+	pbmsgdestruct "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgdestruct"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type mockDestructMsgDatabase struct {
+	controller.CommonMsgDatabase
+	msgs      map[int64]*sdkws.MsgData
+	getSeqs   [][]int64
+	deleted   []int64
+	deleteErr error
+}
+
+func (m *mockDestructMsgDatabase) GetMsgBySeqs(ctx context.Context, userID string, conversationID string, seqs []int64) (int64, int64, []*sdkws.MsgData, error) {
+	m.getSeqs = append(m.getSeqs, seqs)
+	var msgs []*sdkws.MsgData
+	for _, seq := range seqs {
+		if msg, ok := m.msgs[seq]; ok {
+			msgs = append(msgs, msg)
+		}
+	}
+	return 0, 0, msgs, nil
+}
+
+func (m *mockDestructMsgDatabase) DeleteMsgsPhysicalBySeqs(ctx context.Context, conversationID string, seqs []int64) error {
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+	m.deleted = append(m.deleted, seqs...)
+	for _, seq := range seqs {
+		delete(m.msgs, seq)
+	}
+	return nil
+}
+
+func (m *mockDestructMsgDatabase) UserMsgsDestruct(ctx context.Context, userID string, conversationID string, destructTime int64, lastMsgDestructTime time.Time) ([]int64, error) {
+	return []int64{1, 2}, nil
+}
+
+type mockMsgBurnDatabase struct {
+	controller.MsgBurnDatabase
+	burns   []*relation.MsgBurnModel
+	deleted []*relation.MsgBurnModel
+	delayed map[*relation.MsgBurnModel]time.Time
+	held    map[*relation.MsgBurnModel]time.Time
+}
+
+func (m *mockMsgBurnDatabase) FindDueBurnMsgs(ctx context.Context, now time.Time, limit int) ([]*relation.MsgBurnModel, error) {
+	return m.burns, nil
+}
+
+func (m *mockMsgBurnDatabase) DeleteBurnMsgs(ctx context.Context, burn *relation.MsgBurnModel) error {
+	m.deleted = append(m.deleted, burn)
+	return nil
+}
+
+func (m *mockMsgBurnDatabase) DelayBurnMsgs(ctx context.Context, burn *relation.MsgBurnModel, burnTime time.Time) error {
+	m.delayed[burn] = burnTime
+	return nil
+}
+
+func (m *mockMsgBurnDatabase) HoldBurnMsgs(ctx context.Context, burn *relation.MsgBurnModel, burnTime time.Time) error {
+	m.held[burn] = burnTime
+	return nil
+}
+
+type mockLegalHoldDatabase struct {
+	controller.LegalHoldDatabase
+	holds  []*relation.LegalHoldModel
+	audits []*relation.LegalHoldAuditModel
+}
+
+func (m *mockLegalHoldDatabase) FindActiveLegalHolds(ctx context.Context) ([]*relation.LegalHoldModel, error) {
+	return m.holds, nil
+}
+
+func (m *mockLegalHoldDatabase) CreateLegalHoldAudits(ctx context.Context, audits []*relation.LegalHoldAuditModel) error {
+	m.audits = append(m.audits, audits...)
+	return nil
+}
+
+type mockConversationDestructClient struct {
+	pbmsgdestruct.ConversationDestructClient
+	conversations []*pbmsgdestruct.DestructConversation
+	latest        map[string]int64
+}
+
+func (m *mockConversationDestructClient) GetConversationsNeedDestruct(ctx context.Context, in *pbmsgdestruct.GetConversationsNeedDestructReq, opts ...grpc.CallOption) (*pbmsgdestruct.GetConversationsNeedDestructResp, error) {
+	return &pbmsgdestruct.GetConversationsNeedDestructResp{Conversations: m.conversations}, nil
+}
+
+func (m *mockConversationDestructClient) SetLatestMsgDestructTime(ctx context.Context, in *pbmsgdestruct.SetLatestMsgDestructTimeReq, opts ...grpc.CallOption) (*pbmsgdestruct.SetLatestMsgDestructTimeResp, error) {
+	m.latest[in.OwnerUserID+":"+in.ConversationID] = in.LatestMsgDestructTime
+	return &pbmsgdestruct.SetLatestMsgDestructTimeResp{}, nil
+}
+
+func newDestructMsgServer(msgDB *mockDestructMsgDatabase, burnDB *mockMsgBurnDatabase, conversation *mockConversationDestructClient) *msgServer {
+	conf := &Config{Share: config.Share{IMAdminUserID: []string{"admin"}}}
+	sendMsg := func(ctx context.Context, req *msg.SendMsgReq) (*msg.SendMsgResp, error) {
+		return &msg.SendMsgResp{}, nil
+	}
+	return &msgServer{
+		MsgDatabase:        msgDB,
+		MsgBurnDatabase:    burnDB,
+		Conversation:       &rpcclient.ConversationRpcClient{DestructClient: conversation},
+		config:             conf,
+		legalHold:          legalhold.NewChecker(&mockLegalHoldDatabase{}, nil),
+		notificationSender: rpcclient.NewNotificationSender(&config.Notification{}, rpcclient.WithLocalSendMsg(sendMsg)),
+	}
+}
+
+func TestBurnMsgsBatches(t *testing.T) {
+	msgDB := &mockDestructMsgDatabase{msgs: make(map[int64]*sdkws.MsgData)}
+	for seq := int64(1); seq <= 1200; seq++ {
+		sendID := "peer"
+		if seq%2 == 0 {
+			sendID = "reader"
+		}
+		msgDB.msgs[seq] = &sdkws.MsgData{Seq: seq, SendID: sendID}
+	}
+	m := newDestructMsgServer(msgDB, &mockMsgBurnDatabase{}, &mockConversationDestructClient{})
+	num, err := m.burnMsgs(context.Background(), &relation.MsgBurnModel{ConversationID: "si_peer_reader", UserID: "reader", StartSeq: 100, EndSeq: 1200})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(550), num)
+	assert.Len(t, msgDB.getSeqs, 3)
+	for _, seqs := range msgDB.getSeqs {
+		assert.LessOrEqual(t, len(seqs), burnBatchSize)
+	}
+	assert.Equal(t, int64(101), msgDB.getSeqs[0][0])
+	assert.Equal(t, int64(1200), msgDB.getSeqs[2][len(msgDB.getSeqs[2])-1])
+	for _, seq := range msgDB.deleted {
+		assert.Equal(t, int64(1), seq%2)
+	}
+}
+
+func TestBurnRetryDelay(t *testing.T) {
+	assert.Equal(t, time.Minute, burnRetryDelay(0))
+	assert.Equal(t, time.Minute*8, burnRetryDelay(3))
+	assert.Equal(t, burnRetryMaxDelay, burnRetryDelay(11))
+	assert.Equal(t, burnRetryMaxDelay, burnRetryDelay(100))
+}
+
+func TestDestructMsgs(t *testing.T) {
+	ctx := mcontext.WithOpUserIDContext(context.Background(), "admin")
+	msgDB := &mockDestructMsgDatabase{
+		msgs:      map[int64]*sdkws.MsgData{1: {Seq: 1, SendID: "peer"}},
+		deleteErr: errors.New("mongo down"),
+	}
+	failing := &relation.MsgBurnModel{ConversationID: "si_peer_reader", UserID: "reader", StartSeq: 0, EndSeq: 1, Attempts: 2}
+	burnDB := &mockMsgBurnDatabase{burns: []*relation.MsgBurnModel{failing}, delayed: make(map[*relation.MsgBurnModel]time.Time)}
+	conversation := &mockConversationDestructClient{
+		conversations: []*pbmsgdestruct.DestructConversation{{OwnerUserID: "owner", ConversationID: "si_owner_peer", MsgDestructTime: 60}},
+		latest:        make(map[string]int64),
+	}
+	m := newDestructMsgServer(msgDB, burnDB, conversation)
+
+	now := time.Now()
+	resp, err := m.DestructMsgs(ctx, &pbmsgdestruct.DestructMsgsReq{BurnLimit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), resp.BurnMsgNum)
+	assert.Empty(t, burnDB.deleted)
+	assert.WithinDuration(t, now.Add(burnRetryDelay(2)), burnDB.delayed[failing], time.Second)
+
+	assert.Equal(t, int64(1), resp.DestructConversationNum)
+	assert.Equal(t, int64(2), resp.DestructMsgNum)
+	assert.GreaterOrEqual(t, conversation.latest["owner:si_owner_peer"], now.UnixMilli())
+
+	_, err = m.DestructMsgs(context.Background(), &pbmsgdestruct.DestructMsgsReq{BurnLimit: 10})
+	assert.Error(t, err)
+}
+
+func TestDestructMsgsHeld(t *testing.T) {
+	ctx := mcontext.WithOpUserIDContext(context.Background(), "admin")
+	msgDB := &mockDestructMsgDatabase{msgs: map[int64]*sdkws.MsgData{1: {Seq: 1, SendID: "peer"}}}
+	held := &relation.MsgBurnModel{ConversationID: "si_peer_reader", UserID: "reader", StartSeq: 0, EndSeq: 1}
+	burnDB := &mockMsgBurnDatabase{
+		burns:   []*relation.MsgBurnModel{held},
+		delayed: make(map[*relation.MsgBurnModel]time.Time),
+		held:    make(map[*relation.MsgBurnModel]time.Time),
+	}
+	m := newDestructMsgServer(msgDB, burnDB, &mockConversationDestructClient{latest: make(map[string]int64)})
+	holdDB := &mockLegalHoldDatabase{holds: []*relation.LegalHoldModel{
+		{HoldID: "hold1", TargetType: relation.LegalHoldTargetUser, TargetID: "reader"},
+	}}
+	m.legalHold = legalhold.NewChecker(holdDB, nil)
+
+	now := time.Now()
+	resp, err := m.DestructMsgs(ctx, &pbmsgdestruct.DestructMsgsReq{BurnLimit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), resp.BurnMsgNum)
+	// the range is kept to be burnt once the hold is released
+	assert.Empty(t, burnDB.deleted)
+	assert.Empty(t, burnDB.delayed)
+	assert.WithinDuration(t, now.Add(burnHoldDelay), burnDB.held[held], time.Second)
+	assert.Len(t, holdDB.audits, 1)
+	assert.Equal(t, []string{"hold1"}, holdDB.audits[0].HoldIDs)
+}
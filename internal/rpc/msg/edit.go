@@ -0,0 +1,197 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	cbapi "github.com/openimsdk/open-im-server/v3/pkg/callbackstruct"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/search"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	pbmsgedit "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgedit"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notification"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+const defaultMaxEditHistory = 20
+
+func (m *msgServer) EditMsg(ctx context.Context, req *pbmsgedit.EditMsgReq) (*pbmsgedit.EditMsgResp, error) {
+	conf := m.config.RpcConfig.EditMsg
+	if !conf.Enable {
+		return nil, errs.ErrNoPermission.WrapMsg("message editing is disabled")
+	}
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.WrapMsg("msg not found")
+	}
+	msgData := msgs[0]
+	if msgData.ContentType == constant.MsgRevokeNotification {
+		return nil, servererrs.ErrMsgAlreadyRevoke.WrapMsg("msg already revoke")
+	}
+	if msgData.ContentType >= constant.NotificationBegin {
+		return nil, errs.ErrArgs.WrapMsg("notification can not be edited", "contentType", msgData.ContentType)
+	}
+	if msgData.SendID != req.UserID {
+		return nil, errs.ErrNoPermission.WrapMsg("only the sender can edit the msg")
+	}
+	now := time.Now()
+	if conf.Window > 0 && now.Sub(time.UnixMilli(msgData.SendTime)) > time.Duration(conf.Window)*time.Second {
+		return nil, servererrs.ErrMsgEditExpired.WrapMsg("msg edit window has passed", "window", conf.Window)
+	}
+	oldContent := string(msgData.Content)
+	var recvID string
+	if msgData.SessionType == constant.ReadGroupChatType {
+		recvID = msgData.GroupID
+	} else {
+		recvID = msgData.RecvID
+	}
+	cbReq := &cbapi.CallbackBeforeEditMsgReq{
+		ConversationID: req.ConversationID,
+		Seq:            req.Seq,
+		ClientMsgID:    msgData.ClientMsgID,
+		SendID:         msgData.SendID,
+		RecvID:         msgData.RecvID,
+		GroupID:        msgData.GroupID,
+		SessionType:    msgData.SessionType,
+		ContentType:    msgData.ContentType,
+		OldContent:     oldContent,
+		Content:        req.Content,
+	}
+	if err := m.webhookBeforeEditMsg(ctx, &m.config.WebhooksConfig.Current().BeforeEditMsg, cbReq); err != nil {
+		return nil, err
+	}
+	if cbReq.Content == oldContent {
+		return &pbmsgedit.EditMsgResp{}, nil
+	}
+	maxEdits := conf.MaxHistory
+	if maxEdits <= 0 {
+		maxEdits = defaultMaxEditHistory
+	}
+	edit := &relation.EditModel{
+		Content: oldContent,
+		UserID:  mcontext.GetOpUserID(ctx),
+		Time:    now.UnixMilli(),
+	}
+	if err := m.MsgDatabase.EditMsg(ctx, req.ConversationID, msgData, cbReq.Content, edit, maxEdits); err != nil {
+		return nil, err
+	}
+	edited := proto.Clone(msgData).(*sdkws.MsgData)
+	edited.Content = []byte(cbReq.Content)
+	m.indexEditedMsg(ctx, req.ConversationID, edited)
+	tips := &pbmsgedit.MsgEditedTips{
+		ConversationID: req.ConversationID,
+		Seq:            req.Seq,
+		ClientMsgID:    msgData.ClientMsgID,
+		SessionType:    msgData.SessionType,
+		EditorUserID:   edit.UserID,
+		Content:        cbReq.Content,
+		EditTime:       edit.Time,
+	}
+	m.notificationSender.NotificationWithSessionType(ctx, req.UserID, recvID, notification.MsgEditNotification, msgData.SessionType, tips)
+	m.webhookAfterEditMsg(ctx, &m.config.WebhooksConfig.Current().AfterEditMsg, &cbapi.CallbackAfterEditMsgReq{
+		ConversationID: req.ConversationID,
+		Seq:            req.Seq,
+		ClientMsgID:    msgData.ClientMsgID,
+		SendID:         msgData.SendID,
+		SessionType:    msgData.SessionType,
+		ContentType:    msgData.ContentType,
+		Content:        cbReq.Content,
+		EditTime:       edit.Time,
+	})
+	return &pbmsgedit.EditMsgResp{EditTime: edit.Time}, nil
+}
+
+// indexEditedMsg replaces the indexed text of an edited message, failures only leave the old text searchable.
+func (m *msgServer) indexEditedMsg(ctx context.Context, conversationID string, msgData *sdkws.MsgData) {
+	if m.searchIndex == nil {
+		return
+	}
+	doc := search.NewMsgDoc(conversationID, msgData)
+	if doc == nil {
+		return
+	}
+	if err := m.searchIndex.Index(ctx, []*search.MsgDoc{doc}); err != nil {
+		log.ZWarn(ctx, "index edited msg failed", err, "conversationID", conversationID, "seq", msgData.Seq)
+	}
+}
+
+func (m *msgServer) GetMsgEditHistory(ctx context.Context, req *pbmsgedit.GetMsgEditHistoryReq) (*pbmsgedit.GetMsgEditHistoryResp, error) {
+	if err := m.checkConversationAccess(ctx, req.ConversationID); err != nil {
+		return nil, err
+	}
+	if err := m.checkMsgVisible(ctx, req.ConversationID, req.Seq); err != nil {
+		return nil, err
+	}
+	edits, err := m.MsgDatabase.GetMsgEdits(ctx, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	return &pbmsgedit.GetMsgEditHistoryResp{
+		Edits: datautil.Slice(edits, func(e *relation.EditModel) *pbmsgedit.MsgEditRecord {
+			return &pbmsgedit.MsgEditRecord{Content: e.Content, EditorUserID: e.UserID, EditTime: e.Time}
+		}),
+	}, nil
+}
+
+// checkConversationAccess allows app managers and the users owning the conversation.
+func (m *msgServer) checkConversationAccess(ctx context.Context, conversationID string) error {
+	if authverify.IsAppManagerUid(ctx, m.config.Share.AdminUserIDs()) {
+		return nil
+	}
+	conversationIDs, err := m.ConversationLocalCache.GetConversationIDs(ctx, mcontext.GetOpUserID(ctx))
+	if err != nil {
+		return err
+	}
+	if !datautil.Contain(conversationID, conversationIDs...) {
+		return errs.ErrNoPermission.WrapMsg("conversation not found")
+	}
+	return nil
+}
+
+// checkMsgVisible rejects the msgs before the min seq of the conversation or of the op user, e.g. the msgs cleared
+// or sent before the user joined the group, as GetMsgBySeqsRange does. App managers see every msg.
+func (m *msgServer) checkMsgVisible(ctx context.Context, conversationID string, seq int64) error {
+	if authverify.IsAppManagerUid(ctx, m.config.Share.AdminUserIDs()) {
+		return nil
+	}
+	minSeq, err := m.MsgDatabase.GetMinSeq(ctx, conversationID)
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		return err
+	}
+	userMinSeq, err := m.MsgDatabase.GetConversationUserMinSeq(ctx, conversationID, mcontext.GetOpUserID(ctx))
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		return err
+	}
+	if seq < max(minSeq, userMinSeq) {
+		return errs.ErrRecordNotFound.WrapMsg("msg not found", "conversationID", conversationID, "seq", seq)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockMinSeqMsgDatabase struct {
+	controller.CommonMsgDatabase
+	minSeq      int64
+	userMinSeqs map[string]int64
+}
+
+func (m *mockMinSeqMsgDatabase) GetMinSeq(ctx context.Context, conversationID string) (int64, error) {
+	if m.minSeq == 0 {
+		return 0, errs.Wrap(redis.Nil)
+	}
+	return m.minSeq, nil
+}
+
+func (m *mockMinSeqMsgDatabase) GetConversationUserMinSeq(ctx context.Context, conversationID string, userID string) (int64, error) {
+	seq, ok := m.userMinSeqs[userID]
+	if !ok {
+		return 0, errs.Wrap(redis.Nil)
+	}
+	return seq, nil
+}
+
+func TestCheckMsgVisible(t *testing.T) {
+	m := &msgServer{
+		MsgDatabase: &mockMinSeqMsgDatabase{minSeq: 10, userMinSeqs: map[string]int64{"joined": 50}},
+		config:      &Config{Share: config.Share{IMAdminUserID: []string{"admin"}}},
+	}
+	const conversationID = "sg_group1"
+	cases := []struct {
+		userID  string
+		seq     int64
+		visible bool
+	}{
+		{"member", 9, false},
+		{"member", 10, true},
+		{"joined", 49, false},
+		{"joined", 50, true},
+		{"admin", 1, true},
+	}
+	for _, c := range cases {
+		ctx := mcontext.WithOpUserIDContext(context.Background(), c.userID)
+		err := m.checkMsgVisible(ctx, conversationID, c.seq)
+		if c.visible {
+			assert.NoError(t, err, c.userID, c.seq)
+		} else {
+			assert.ErrorIs(t, err, errs.ErrRecordNotFound, c.userID, c.seq)
+		}
+	}
+	m.MsgDatabase = &mockMinSeqMsgDatabase{}
+	assert.NoError(t, m.checkMsgVisible(mcontext.WithOpUserIDContext(context.Background(), "member"), conversationID, 1))
+}
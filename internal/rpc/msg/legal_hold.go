@@ -0,0 +1,165 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	pblegalhold "github.com/openimsdk/open-im-server/v3/pkg/protocol/legalhold"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// checkConversationsHold refuses a deletion requested by userID in conversationIDs when the user or one of them is held.
+func (m *msgServer) checkConversationsHold(ctx context.Context, operation string, userID string, conversationIDs []string) error {
+	holds, err := m.legalHold.Load(ctx)
+	if err != nil {
+		return err
+	}
+	if holds.Empty() {
+		return nil
+	}
+	if holdIDs := holds.UserHoldIDs(userID); len(holdIDs) > 0 {
+		return m.legalHold.Block(ctx, holdIDs, operation, userID, "")
+	}
+	for _, conversationID := range conversationIDs {
+		if holdIDs := holds.ConversationHoldIDs(conversationID); len(holdIDs) > 0 {
+			return m.legalHold.Block(ctx, holdIDs, operation, conversationID, "")
+		}
+	}
+	return nil
+}
+
+func (m *msgServer) CreateLegalHold(ctx context.Context, req *pblegalhold.CreateLegalHoldReq) (*pblegalhold.CreateLegalHoldResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	hold := &relation.LegalHoldModel{
+		HoldID:         GetMsgID(req.TargetID),
+		TargetType:     int32(req.TargetType),
+		TargetID:       req.TargetID,
+		Reason:         req.Reason,
+		OperatorUserID: mcontext.GetOpUserID(ctx),
+		CreateTime:     now,
+		UpdateTime:     now,
+	}
+	if req.ExpireTime > 0 {
+		if req.ExpireTime <= now.UnixMilli() {
+			return nil, errs.ErrArgs.WrapMsg("expireTime must be in the future")
+		}
+		hold.ExpireTime = time.UnixMilli(req.ExpireTime)
+	}
+	if err := m.LegalHoldDatabase.CreateLegalHold(ctx, hold); err != nil {
+		return nil, err
+	}
+	return &pblegalhold.CreateLegalHoldResp{HoldID: hold.HoldID}, nil
+}
+
+func (m *msgServer) UpdateLegalHold(ctx context.Context, req *pblegalhold.UpdateLegalHoldReq) (*pblegalhold.UpdateLegalHoldResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	update := map[string]any{"update_time": time.Now()}
+	if req.Reason != nil {
+		update["reason"] = *req.Reason
+	}
+	if req.ExpireTime != nil {
+		if *req.ExpireTime == 0 {
+			update["expire_time"] = time.Time{}
+		} else {
+			update["expire_time"] = time.UnixMilli(*req.ExpireTime)
+		}
+	}
+	if err := m.LegalHoldDatabase.UpdateLegalHold(ctx, req.HoldID, update); err != nil {
+		return nil, err
+	}
+	return &pblegalhold.UpdateLegalHoldResp{}, nil
+}
+
+func (m *msgServer) ReleaseLegalHold(ctx context.Context, req *pblegalhold.ReleaseLegalHoldReq) (*pblegalhold.ReleaseLegalHoldResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	if _, err := m.LegalHoldDatabase.TakeLegalHold(ctx, req.HoldID); err != nil {
+		return nil, err
+	}
+	if err := m.LegalHoldDatabase.DeleteLegalHold(ctx, req.HoldID); err != nil {
+		return nil, err
+	}
+	return &pblegalhold.ReleaseLegalHoldResp{}, nil
+}
+
+func (m *msgServer) GetLegalHolds(ctx context.Context, req *pblegalhold.GetLegalHoldsReq) (*pblegalhold.GetLegalHoldsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	total, holds, err := m.LegalHoldDatabase.SearchLegalHolds(ctx, int32(req.TargetType), req.TargetID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &pblegalhold.GetLegalHoldsResp{
+		Total: total,
+		Holds: datautil.Slice(holds, func(hold *relation.LegalHoldModel) *pblegalhold.LegalHold {
+			return legalHoldDB2PB(hold, now)
+		}),
+	}, nil
+}
+
+func (m *msgServer) GetLegalHoldAudits(ctx context.Context, req *pblegalhold.GetLegalHoldAuditsReq) (*pblegalhold.GetLegalHoldAuditsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	total, audits, err := m.LegalHoldDatabase.SearchLegalHoldAudits(ctx, req.HoldID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &pblegalhold.GetLegalHoldAuditsResp{Total: total, Audits: datautil.Slice(audits, legalHoldAuditDB2PB)}, nil
+}
+
+func legalHoldDB2PB(hold *relation.LegalHoldModel, now time.Time) *pblegalhold.LegalHold {
+	var expireTime int64
+	if !hold.ExpireTime.IsZero() {
+		expireTime = hold.ExpireTime.UnixMilli()
+	}
+	return &pblegalhold.LegalHold{
+		HoldID:         hold.HoldID,
+		TargetType:     pblegalhold.LegalHoldTargetType(hold.TargetType),
+		TargetID:       hold.TargetID,
+		Reason:         hold.Reason,
+		OperatorUserID: hold.OperatorUserID,
+		ExpireTime:     expireTime,
+		CreateTime:     hold.CreateTime.UnixMilli(),
+		UpdateTime:     hold.UpdateTime.UnixMilli(),
+		Active:         hold.IsActive(now),
+	}
+}
+
+func legalHoldAuditDB2PB(audit *relation.LegalHoldAuditModel) *pblegalhold.LegalHoldAudit {
+	return &pblegalhold.LegalHoldAudit{
+		HoldIDs:        audit.HoldIDs,
+		Operation:      audit.Operation,
+		TargetID:       audit.TargetID,
+		OperatorUserID: audit.OperatorUserID,
+		OperationID:    audit.OperationID,
+		Detail:         audit.Detail,
+		CreateTime:     audit.CreateTime.UnixMilli(),
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"sort"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	pbmsgreaction "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgreaction"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notification"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const defaultMaxReactionsPerUser = 20
+
+// getReactableMsg returns the message if userID can see it and it accepts reactions.
+func (m *msgServer) getReactableMsg(ctx context.Context, userID, conversationID string, seq int64) (*sdkws.MsgData, error) {
+	if err := authverify.CheckAccessV3(ctx, userID, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, userID, conversationID, []int64{seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.WrapMsg("msg not found")
+	}
+	msgData := msgs[0]
+	if msgData.ContentType >= constant.NotificationBegin {
+		return nil, errs.ErrArgs.WrapMsg("msg does not accept reactions", "contentType", msgData.ContentType)
+	}
+	switch msgData.SessionType {
+	case constant.SingleChatType:
+		if userID != msgData.SendID && userID != msgData.RecvID {
+			return nil, errs.ErrNoPermission.WrapMsg("not in the conversation")
+		}
+	case constant.ReadGroupChatType:
+		if _, err := m.GroupLocalCache.GetGroupMember(ctx, msgData.GroupID, userID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errs.ErrArgs.WrapMsg("msg sessionType not supported")
+	}
+	return msgData, nil
+}
+
+func (m *msgServer) AddMsgReaction(ctx context.Context, req *pbmsgreaction.AddMsgReactionReq) (*pbmsgreaction.AddMsgReactionResp, error) {
+	msgData, err := m.getReactableMsg(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	maxPerUser := m.config.RpcConfig.Reaction.MaxPerUser
+	if maxPerUser <= 0 {
+		maxPerUser = defaultMaxReactionsPerUser
+	}
+	// adding a reaction the user already made changes nothing, so it is not limited either
+	added, err := m.ReactionDatabase.AddReaction(ctx, req.ConversationID, req.Seq, req.Emoji, req.UserID, maxPerUser)
+	if err != nil {
+		return nil, err
+	}
+	if added {
+		m.reactionNotification(ctx, msgData, req.ConversationID, req.UserID, req.Emoji, false)
+	}
+	return &pbmsgreaction.AddMsgReactionResp{}, nil
+}
+
+func (m *msgServer) RemoveMsgReaction(ctx context.Context, req *pbmsgreaction.RemoveMsgReactionReq) (*pbmsgreaction.RemoveMsgReactionResp, error) {
+	msgData, err := m.getReactableMsg(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	removed, err := m.ReactionDatabase.RemoveReaction(ctx, req.ConversationID, req.Seq, req.Emoji, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if removed {
+		m.reactionNotification(ctx, msgData, req.ConversationID, req.UserID, req.Emoji, true)
+	}
+	return &pbmsgreaction.RemoveMsgReactionResp{}, nil
+}
+
+func (m *msgServer) reactionNotification(ctx context.Context, msgData *sdkws.MsgData, conversationID, userID, emoji string, isRemove bool) {
+	tips := &pbmsgreaction.MsgReactionTips{
+		ConversationID: conversationID,
+		Seq:            msgData.Seq,
+		UserID:         userID,
+		Emoji:          emoji,
+		IsRemove:       isRemove,
+	}
+	if counts, err := m.ReactionDatabase.GetReactionCounts(ctx, conversationID, []int64{msgData.Seq}); err == nil {
+		tips.Count = counts[msgData.Seq][emoji]
+	}
+	var recvID string
+	switch {
+	case msgData.SessionType == constant.ReadGroupChatType:
+		recvID = msgData.GroupID
+	case userID == msgData.SendID:
+		recvID = msgData.RecvID
+	default:
+		recvID = msgData.SendID
+	}
+	m.notificationSender.NotificationWithSessionType(ctx, userID, recvID, notification.MsgReactionNotification, msgData.SessionType, tips)
+}
+
+func (m *msgServer) GetMsgReactionUsers(ctx context.Context, req *pbmsgreaction.GetMsgReactionUsersReq) (*pbmsgreaction.GetMsgReactionUsersResp, error) {
+	if err := m.checkConversationAccess(ctx, req.ConversationID); err != nil {
+		return nil, err
+	}
+	total, userIDs, err := m.ReactionDatabase.FindReactionUserIDs(ctx, req.ConversationID, req.Seq, req.Emoji, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &pbmsgreaction.GetMsgReactionUsersResp{Total: total, UserIDs: userIDs}, nil
+}
+
+func (m *msgServer) PullMsgReactions(ctx context.Context, req *pbmsgreaction.PullMsgReactionsReq) (*pbmsgreaction.PullMsgReactionsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	if err := m.checkConversationAccess(ctx, req.ConversationID); err != nil {
+		return nil, err
+	}
+	seqs := make([]int64, 0, req.EndSeq-req.BeginSeq+1)
+	for seq := req.BeginSeq; seq <= req.EndSeq; seq++ {
+		seqs = append(seqs, seq)
+	}
+	counts, err := m.ReactionDatabase.GetReactionCounts(ctx, req.ConversationID, seqs)
+	if err != nil {
+		return nil, err
+	}
+	userEmojis, err := m.ReactionDatabase.GetUserEmojis(ctx, req.ConversationID, datautil.Keys(counts), req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbmsgreaction.PullMsgReactionsResp{Msgs: make([]*pbmsgreaction.MsgReactions, 0, len(counts))}
+	for _, seq := range seqs {
+		emojiCounts, ok := counts[seq]
+		if !ok {
+			continue
+		}
+		reactions := make([]*pbmsgreaction.MsgReactionCount, 0, len(emojiCounts))
+		for emoji, count := range emojiCounts {
+			reactions = append(reactions, &pbmsgreaction.MsgReactionCount{
+				Emoji:   emoji,
+				Count:   count,
+				Reacted: datautil.Contain(emoji, userEmojis[seq]...),
+			})
+		}
+		sort.Slice(reactions, func(i, j int) bool {
+			if reactions[i].Count != reactions[j].Count {
+				return reactions[i].Count > reactions[j].Count
+			}
+			return reactions[i].Emoji < reactions[j].Emoji
+		})
+		resp.Msgs = append(resp.Msgs, &pbmsgreaction.MsgReactions{Seq: seq, Reactions: reactions})
+	}
+	return resp, nil
+}
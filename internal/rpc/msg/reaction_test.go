@@ -0,0 +1,193 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	pbmsgreaction "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgreaction"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notification"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockReactionDatabase keeps the user ids of each emoji by seq, in the order they reacted.
+type mockReactionDatabase struct {
+	controller.MsgReactionDatabase
+	reactions map[int64]map[string][]string
+}
+
+func (m *mockReactionDatabase) AddReaction(ctx context.Context, conversationID string, seq int64, emoji string, userID string, maxPerUser int) (bool, error) {
+	if m.reactions[seq] == nil {
+		m.reactions[seq] = make(map[string][]string)
+	}
+	if datautil.Contain(userID, m.reactions[seq][emoji]...) {
+		return false, nil
+	}
+	userEmojis, _ := m.GetUserEmojis(ctx, conversationID, []int64{seq}, userID)
+	if len(userEmojis[seq]) >= maxPerUser {
+		return false, errs.ErrArgs.WrapMsg("too many reactions to the msg", "maxPerUser", maxPerUser)
+	}
+	m.reactions[seq][emoji] = append(m.reactions[seq][emoji], userID)
+	return true, nil
+}
+
+func (m *mockReactionDatabase) RemoveReaction(ctx context.Context, conversationID string, seq int64, emoji string, userID string) (bool, error) {
+	userIDs := m.reactions[seq][emoji]
+	if !datautil.Contain(userID, userIDs...) {
+		return false, nil
+	}
+	userIDs = datautil.Filter(userIDs, func(id string) (string, bool) { return id, id != userID })
+	if len(userIDs) == 0 {
+		delete(m.reactions[seq], emoji)
+	} else {
+		m.reactions[seq][emoji] = userIDs
+	}
+	return true, nil
+}
+
+func (m *mockReactionDatabase) GetReactionCounts(ctx context.Context, conversationID string, seqs []int64) (map[int64]map[string]int64, error) {
+	res := make(map[int64]map[string]int64)
+	for _, seq := range seqs {
+		for emoji, userIDs := range m.reactions[seq] {
+			if res[seq] == nil {
+				res[seq] = make(map[string]int64)
+			}
+			res[seq][emoji] = int64(len(userIDs))
+		}
+	}
+	return res, nil
+}
+
+func (m *mockReactionDatabase) GetUserEmojis(ctx context.Context, conversationID string, seqs []int64, userID string) (map[int64][]string, error) {
+	res := make(map[int64][]string)
+	for _, seq := range seqs {
+		for emoji, userIDs := range m.reactions[seq] {
+			if datautil.Contain(userID, userIDs...) {
+				res[seq] = append(res[seq], emoji)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (m *mockReactionDatabase) FindReactionUserIDs(ctx context.Context, conversationID string, seq int64, emoji string, pagination pagination.Pagination) (int64, []string, error) {
+	userIDs := m.reactions[seq][emoji]
+	return int64(len(userIDs)), userIDs, nil
+}
+
+func newReactionMsgServer(maxPerUser int) (*msgServer, chan *msg.SendMsgReq) {
+	notifications := make(chan *msg.SendMsgReq, 16)
+	sendMsg := func(ctx context.Context, req *msg.SendMsgReq) (*msg.SendMsgResp, error) {
+		notifications <- req
+		return &msg.SendMsgResp{}, nil
+	}
+	conf := &Config{Share: config.Share{IMAdminUserID: []string{"admin"}}}
+	conf.RpcConfig.Reaction.MaxPerUser = maxPerUser
+	msgDB := &mockDestructMsgDatabase{msgs: map[int64]*sdkws.MsgData{
+		1: {Seq: 1, SendID: "sender", RecvID: "receiver", SessionType: constant.SingleChatType, ContentType: constant.Text},
+	}}
+	return &msgServer{
+		MsgDatabase:        msgDB,
+		ReactionDatabase:   &mockReactionDatabase{reactions: make(map[int64]map[string][]string)},
+		config:             conf,
+		notificationSender: rpcclient.NewNotificationSender(&config.Notification{}, rpcclient.WithLocalSendMsg(sendMsg)),
+	}, notifications
+}
+
+// notified waits for the next reaction notification and reports whether one was sent.
+func notified(t *testing.T, notifications chan *msg.SendMsgReq) bool {
+	select {
+	case req := <-notifications:
+		assert.Equal(t, int32(notification.MsgReactionNotification), req.MsgData.ContentType)
+		return true
+	case <-time.After(200 * time.Millisecond):
+		return false
+	}
+}
+
+func TestAddMsgReaction(t *testing.T) {
+	m, notifications := newReactionMsgServer(2)
+	add := func(userID, emoji string) error {
+		ctx := mcontext.WithOpUserIDContext(context.Background(), userID)
+		_, err := m.AddMsgReaction(ctx, &pbmsgreaction.AddMsgReactionReq{ConversationID: "si_receiver_sender", Seq: 1, UserID: userID, Emoji: emoji})
+		return err
+	}
+
+	assert.NoError(t, add("receiver", "👍"))
+	assert.True(t, notified(t, notifications))
+	// Re-adding the same reaction succeeds without a second notification.
+	assert.NoError(t, add("receiver", "👍"))
+	assert.False(t, notified(t, notifications))
+
+	assert.NoError(t, add("receiver", "❤️"))
+	assert.True(t, notified(t, notifications))
+	err := add("receiver", "😂")
+	assert.ErrorIs(t, err, errs.ErrArgs)
+	assert.False(t, notified(t, notifications))
+	// At the limit, the reactions already made can still be re-added.
+	assert.NoError(t, add("receiver", "❤️"))
+
+	// The limit is per user.
+	assert.NoError(t, add("sender", "😂"))
+	assert.True(t, notified(t, notifications))
+	// Users outside the conversation cannot react.
+	assert.ErrorIs(t, add("other", "👍"), errs.ErrNoPermission)
+
+	adminCtx := mcontext.WithOpUserIDContext(context.Background(), "admin")
+	pull, err := m.PullMsgReactions(adminCtx, &pbmsgreaction.PullMsgReactionsReq{ConversationID: "si_receiver_sender", UserID: "receiver", BeginSeq: 1, EndSeq: 3})
+	assert.NoError(t, err)
+	assert.Len(t, pull.Msgs, 1)
+	assert.Equal(t, int64(1), pull.Msgs[0].Seq)
+	assert.Len(t, pull.Msgs[0].Reactions, 3)
+	for _, reaction := range pull.Msgs[0].Reactions {
+		assert.Equal(t, int64(1), reaction.Count)
+		assert.Equal(t, reaction.Emoji != "😂", reaction.Reacted, reaction.Emoji)
+	}
+}
+
+func TestRemoveMsgReaction(t *testing.T) {
+	ctx := mcontext.WithOpUserIDContext(context.Background(), "sender")
+	m, notifications := newReactionMsgServer(0)
+	req := &pbmsgreaction.RemoveMsgReactionReq{ConversationID: "si_receiver_sender", Seq: 1, UserID: "sender", Emoji: "👍"}
+
+	// Removing a reaction the user did not make is a no-op.
+	_, err := m.RemoveMsgReaction(ctx, req)
+	assert.NoError(t, err)
+	assert.False(t, notified(t, notifications))
+
+	_, err = m.AddMsgReaction(ctx, &pbmsgreaction.AddMsgReactionReq{ConversationID: "si_receiver_sender", Seq: 1, UserID: "sender", Emoji: "👍"})
+	assert.NoError(t, err)
+	assert.True(t, notified(t, notifications))
+	_, err = m.RemoveMsgReaction(ctx, req)
+	assert.NoError(t, err)
+	assert.True(t, notified(t, notifications))
+
+	adminCtx := mcontext.WithOpUserIDContext(context.Background(), "admin")
+	users, err := m.GetMsgReactionUsers(adminCtx, &pbmsgreaction.GetMsgReactionUsersReq{ConversationID: "si_receiver_sender", Seq: 1, Emoji: "👍"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), users.Total)
+}
@@ -0,0 +1,142 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"sort"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	pbmsgreceipt "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgreceipt"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notification"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func (m *msgServer) GetGroupMsgReadMembers(ctx context.Context, req *pbmsgreceipt.GetGroupMsgReadMembersReq) (*pbmsgreceipt.GetGroupMsgReadMembersResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.WrapMsg("msg not found")
+	}
+	msgData := msgs[0]
+	if msgData.SessionType != constant.ReadGroupChatType {
+		return nil, errs.ErrArgs.WrapMsg("msg is not a group msg")
+	}
+	if msgData.SendID != req.UserID && !authverify.IsAppManagerUid(ctx, m.config.Share.AdminUserIDs()) {
+		return nil, errs.ErrNoPermission.WrapMsg("only the sender can get the read members")
+	}
+	memberIDs, err := m.GroupLocalCache.GetGroupMemberIDs(ctx, msgData.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	// filtered into a new slice, the cached one must not be sorted in place
+	memberIDs = datautil.Filter(memberIDs, func(userID string) (string, bool) {
+		return userID, userID != msgData.SendID
+	})
+	sort.Strings(memberIDs)
+	members, err := m.GroupLocalCache.GetGroupMemberInfoMap(ctx, msgData.GroupID, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+	userMinSeqs, err := m.MsgDatabase.GetConversationUserMinSeqs(ctx, req.ConversationID, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+	hasReadSeqs, err := m.MsgDatabase.GetConversationHasReadSeqs(ctx, req.ConversationID, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+	readUserIDs, unreadUserIDs := groupMsgReadMembers(msgData, memberIDs, members, userMinSeqs, hasReadSeqs)
+	pageNumber, showNumber := int(req.Pagination.GetPageNumber()), int(req.Pagination.GetShowNumber())
+	return &pbmsgreceipt.GetGroupMsgReadMembersResp{
+		ReadCount:     int64(len(readUserIDs)),
+		UnreadCount:   int64(len(unreadUserIDs)),
+		ReadUserIDs:   datautil.Paginate(readUserIDs, pageNumber, showNumber),
+		UnreadUserIDs: datautil.Paginate(unreadUserIDs, pageNumber, showNumber),
+	}, nil
+}
+
+// groupMsgReadMembers splits the members into those who have read msg and those who have not. Members who joined
+// after msg was sent, or whose min seq is above it, have never been able to see it and are left out of both.
+func groupMsgReadMembers(msg *sdkws.MsgData, memberIDs []string, members map[string]*sdkws.GroupMemberFullInfo,
+	userMinSeqs map[string]int64, hasReadSeqs map[string]int64) (readUserIDs []string, unreadUserIDs []string) {
+	for _, userID := range memberIDs {
+		member, ok := members[userID]
+		if !ok || member.JoinTime > msg.SendTime || userMinSeqs[userID] > msg.Seq {
+			continue
+		}
+		if hasReadSeqs[userID] >= msg.Seq {
+			readUserIDs = append(readUserIDs, userID)
+		} else {
+			unreadUserIDs = append(unreadUserIDs, userID)
+		}
+	}
+	return readUserIDs, unreadUserIDs
+}
+
+// sendGroupMsgReadNotification tells the senders of the group msgs in (oldHasReadSeq, hasReadSeq] that userID has read them.
+// Only the latest msgs up to the configured number are checked and large groups are skipped to bound the fan-out.
+func (m *msgServer) sendGroupMsgReadNotification(ctx context.Context, conversationID, groupID, userID string, oldHasReadSeq, hasReadSeq int64) {
+	conf := m.config.RpcConfig.GroupReadReceipt
+	if !conf.Enable || hasReadSeq <= oldHasReadSeq || conf.MaxNotifyMsgs <= 0 {
+		return
+	}
+	if conf.MaxGroupMemberCount > 0 {
+		groupInfo, err := m.GroupLocalCache.GetGroupInfo(ctx, groupID)
+		if err != nil {
+			log.ZWarn(ctx, "get group info failed", err, "groupID", groupID)
+			return
+		}
+		if int(groupInfo.MemberCount) > conf.MaxGroupMemberCount {
+			return
+		}
+	}
+	begin := max(oldHasReadSeq+1, hasReadSeq-int64(conf.MaxNotifyMsgs)+1)
+	seqs := make([]int64, 0, hasReadSeq-begin+1)
+	for seq := begin; seq <= hasReadSeq; seq++ {
+		seqs = append(seqs, seq)
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, userID, conversationID, seqs)
+	if err != nil {
+		log.ZWarn(ctx, "get read msgs failed", err, "conversationID", conversationID, "seqs", seqs)
+		return
+	}
+	senderSeqs := make(map[string][]int64)
+	for _, msg := range msgs {
+		if msg == nil || msg.SendID == userID || msg.ContentType >= constant.NotificationBegin {
+			continue
+		}
+		senderSeqs[msg.SendID] = append(senderSeqs[msg.SendID], msg.Seq)
+	}
+	for sendID, readSeqs := range senderSeqs {
+		tips := &pbmsgreceipt.GroupMsgReadTips{
+			ConversationID: conversationID,
+			GroupID:        groupID,
+			ReaderUserID:   userID,
+			Seqs:           readSeqs,
+			HasReadSeq:     hasReadSeq,
+		}
+		m.notificationSender.NotificationWithSessionType(ctx, userID, sendID, notification.GroupMsgReadNotification, constant.SingleChatType, tips)
+	}
+}
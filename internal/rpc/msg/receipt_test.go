@@ -0,0 +1,40 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"testing"
+
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupMsgReadMembers(t *testing.T) {
+	msg := &sdkws.MsgData{Seq: 100, SendTime: 5000}
+	memberIDs := []string{"early", "read", "late", "cleared", "unknown", "same"}
+	members := map[string]*sdkws.GroupMemberFullInfo{
+		"early":   {JoinTime: 1000},
+		"read":    {JoinTime: 1000},
+		"late":    {JoinTime: 6000}, // joined after the msg was sent
+		"cleared": {JoinTime: 1000},
+		"same":    {JoinTime: 5000},
+	}
+	userMinSeqs := map[string]int64{"cleared": 101, "read": 100}
+	hasReadSeqs := map[string]int64{"read": 120, "late": 150, "cleared": 150, "early": 99}
+
+	read, unread := groupMsgReadMembers(msg, memberIDs, members, userMinSeqs, hasReadSeqs)
+	assert.Equal(t, []string{"read"}, read)
+	assert.Equal(t, []string{"early", "same"}, unread)
+}
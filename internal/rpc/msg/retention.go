@@ -0,0 +1,194 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	pbmsgretention "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgretention"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// retentionConversationID returns the conversation the policy is attached to and its group if any.
+func (m *msgServer) retentionConversationID(ctx context.Context, conversationID, groupID string) (string, string, error) {
+	if groupID != "" {
+		if _, err := m.GroupLocalCache.GetGroupInfo(ctx, groupID); err != nil {
+			return "", "", err
+		}
+		return msgprocessor.GetConversationIDBySessionType(constant.ReadGroupChatType, groupID), groupID, nil
+	}
+	if strings.HasPrefix(conversationID, "sg_") {
+		return conversationID, strings.TrimPrefix(conversationID, "sg_"), nil
+	}
+	return conversationID, "", nil
+}
+
+func (m *msgServer) SetRetentionPolicy(ctx context.Context, req *pbmsgretention.SetRetentionPolicyReq) (*pbmsgretention.SetRetentionPolicyResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	conversationID, groupID, err := m.retentionConversationID(ctx, req.ConversationID, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	policy := &relation.RetentionPolicyModel{
+		ConversationID: conversationID,
+		GroupID:        groupID,
+		Rules: datautil.Slice(req.Rules, func(rule *pbmsgretention.RetentionRule) *relation.RetentionRuleModel {
+			return &relation.RetentionRuleModel{ContentType: rule.ContentType, RetainDays: rule.RetainDays}
+		}),
+		OperatorUserID: mcontext.GetOpUserID(ctx),
+		CreateTime:     now,
+		UpdateTime:     now,
+	}
+	if err := m.RetentionDatabase.SetRetentionPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+	return &pbmsgretention.SetRetentionPolicyResp{ConversationID: conversationID}, nil
+}
+
+func (m *msgServer) DeleteRetentionPolicy(ctx context.Context, req *pbmsgretention.DeleteRetentionPolicyReq) (*pbmsgretention.DeleteRetentionPolicyResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	conversationID := req.ConversationID
+	if req.GroupID != "" {
+		conversationID = msgprocessor.GetConversationIDBySessionType(constant.ReadGroupChatType, req.GroupID)
+	}
+	if err := m.RetentionDatabase.DeleteRetentionPolicy(ctx, conversationID); err != nil {
+		return nil, err
+	}
+	return &pbmsgretention.DeleteRetentionPolicyResp{}, nil
+}
+
+func (m *msgServer) GetRetentionPolicies(ctx context.Context, req *pbmsgretention.GetRetentionPoliciesReq) (*pbmsgretention.GetRetentionPoliciesResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	var (
+		total    int64
+		policies []*relation.RetentionPolicyModel
+		err      error
+	)
+	if len(req.ConversationIDs) > 0 {
+		policies, err = m.RetentionDatabase.FindRetentionPolicies(ctx, req.ConversationIDs)
+		total = int64(len(policies))
+	} else {
+		total, policies, err = m.RetentionDatabase.PageRetentionPolicies(ctx, req.Pagination)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pbmsgretention.GetRetentionPoliciesResp{Total: total, Policies: datautil.Slice(policies, retentionPolicyDB2PB)}, nil
+}
+
+// ApplyRetentionPolicies deletes the msgs older than the rules of every policy.
+// A rule of all content types moves the min seq of the conversation forward, so no member pulls the purged msgs,
+// others clear the matching msgs in place.
+func (m *msgServer) ApplyRetentionPolicies(ctx context.Context, req *pbmsgretention.ApplyRetentionPoliciesReq) (*pbmsgretention.ApplyRetentionPoliciesResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	var (
+		resp  = &pbmsgretention.ApplyRetentionPoliciesResp{}
+		start = time.Now()
+	)
+	holds, err := m.legalHold.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for pageNumber := int32(1); ; pageNumber++ {
+		_, policies, err := m.RetentionDatabase.PageRetentionPolicies(ctx, &sdkws.RequestPagination{PageNumber: pageNumber, ShowNumber: 100})
+		if err != nil {
+			return nil, err
+		}
+		for _, policy := range policies {
+			if holdIDs := holds.ConversationHoldIDs(policy.ConversationID); len(holdIDs) > 0 {
+				m.legalHold.Audit(ctx, holdIDs, "ApplyRetentionPolicies", policy.ConversationID, "")
+				continue
+			}
+			purged, err := m.applyRetentionPolicy(ctx, policy)
+			if err != nil {
+				log.ZError(ctx, "apply retention policy failed", err, "conversationID", policy.ConversationID, "purged", purged)
+			}
+			var msgNum int64
+			for _, p := range purged {
+				msgNum += p.MsgNum
+			}
+			if err := m.RetentionDatabase.SetRetentionApplyResult(ctx, policy.ConversationID, time.Now(), msgNum); err != nil {
+				log.ZError(ctx, "set retention apply result failed", err, "conversationID", policy.ConversationID, "msgNum", msgNum)
+			}
+			resp.Purged = append(resp.Purged, purged...)
+			resp.MsgNum += msgNum
+		}
+		if len(policies) < 100 {
+			break
+		}
+	}
+	log.ZInfo(ctx, "apply retention policies success", "msgNum", resp.MsgNum, "purged", len(resp.Purged), "cost", time.Since(start))
+	return resp, nil
+}
+
+func (m *msgServer) applyRetentionPolicy(ctx context.Context, policy *relation.RetentionPolicyModel) ([]*pbmsgretention.RetentionPurged, error) {
+	var purged []*pbmsgretention.RetentionPurged
+	for _, rule := range policy.Rules {
+		ts := time.Now().Add(-time.Hour * 24 * time.Duration(rule.RetainDays)).UnixMilli()
+		docNum, msgNum, err := m.MsgDatabase.DeleteConversationMsgsBefore(ctx, policy.ConversationID, ts, rule.ContentType)
+		if msgNum > 0 {
+			purged = append(purged, &pbmsgretention.RetentionPurged{
+				ConversationID: policy.ConversationID,
+				ContentType:    rule.ContentType,
+				DocNum:         int64(docNum),
+				MsgNum:         int64(msgNum),
+			})
+			log.ZInfo(ctx, "retention purged msgs", "conversationID", policy.ConversationID, "contentType", rule.ContentType, "docNum", docNum, "msgNum", msgNum)
+		}
+		if err != nil {
+			return purged, errs.WrapMsg(err, "apply retention rule", "contentType", rule.ContentType)
+		}
+	}
+	return purged, nil
+}
+
+func retentionPolicyDB2PB(policy *relation.RetentionPolicyModel) *pbmsgretention.RetentionPolicy {
+	var lastApplyTime int64
+	if !policy.LastApplyTime.IsZero() {
+		lastApplyTime = policy.LastApplyTime.UnixMilli()
+	}
+	return &pbmsgretention.RetentionPolicy{
+		ConversationID: policy.ConversationID,
+		GroupID:        policy.GroupID,
+		Rules: datautil.Slice(policy.Rules, func(rule *relation.RetentionRuleModel) *pbmsgretention.RetentionRule {
+			return &pbmsgretention.RetentionRule{ContentType: rule.ContentType, RetainDays: rule.RetainDays}
+		}),
+		OperatorUserID:    policy.OperatorUserID,
+		CreateTime:        policy.CreateTime.UnixMilli(),
+		UpdateTime:        policy.UpdateTime.UnixMilli(),
+		LastApplyTime:     lastApplyTime,
+		LastPurgedMsgNum:  policy.LastPurgedMsgNum,
+		TotalPurgedMsgNum: policy.TotalPurgedMsgNum,
+	}
+}
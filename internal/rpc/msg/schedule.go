@@ -0,0 +1,220 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	pbmsgschedule "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgschedule"
+	"github.com/openimsdk/protocol/constant"
+	pbmsg "github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultScheduleDispatchTimeout is used when scheduleMsg.dispatchTimeout is not configured.
+const defaultScheduleDispatchTimeout = 5 * time.Minute
+
+// checkScheduleTime checks sendTime is in the future and within the configured maximum delay.
+func (m *msgServer) checkScheduleTime(sendTime int64) error {
+	now := time.Now()
+	if sendTime <= now.UnixMilli() {
+		return errs.ErrArgs.WrapMsg("sendTime must be in the future")
+	}
+	maxDelay := m.config.RpcConfig.ScheduleMsg.MaxDelay
+	if maxDelay > 0 && time.UnixMilli(sendTime).Sub(now) > time.Duration(maxDelay)*time.Second {
+		return errs.ErrArgs.WrapMsg("sendTime is too far in the future", "maxDelay", maxDelay)
+	}
+	return nil
+}
+
+// getOwnScheduledMsg returns the scheduled msg if the operator is its owner or an app manager.
+func (m *msgServer) getOwnScheduledMsg(ctx context.Context, userID, scheduleID string) (*relation.ScheduledMsgModel, error) {
+	if err := authverify.CheckAccessV3(ctx, userID, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	msg, err := m.ScheduledMsgDatabase.TakeScheduledMsg(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.UserID != userID {
+		return nil, errs.ErrNoPermission.WrapMsg("scheduled msg belongs to another user")
+	}
+	return msg, nil
+}
+
+func (m *msgServer) ScheduleMsg(ctx context.Context, req *pbmsgschedule.ScheduleMsgReq) (*pbmsgschedule.ScheduleMsgResp, error) {
+	if !m.config.RpcConfig.ScheduleMsg.Enable {
+		return nil, errs.ErrNoPermission.WrapMsg("scheduled sending is disabled")
+	}
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	if req.MsgData.SendID != req.UserID && !authverify.IsAppManagerUid(ctx, m.config.Share.AdminUserIDs()) {
+		return nil, errs.ErrNoPermission.WrapMsg("only app managers can schedule msgs for other senders")
+	}
+	switch req.MsgData.SessionType {
+	case constant.SingleChatType, constant.ReadGroupChatType, msgprocessor.ThreadChatType:
+	default:
+		return nil, errs.ErrArgs.WrapMsg("sessionType can not be scheduled", "sessionType", req.MsgData.SessionType)
+	}
+	if err := m.checkScheduleTime(req.SendTime); err != nil {
+		return nil, err
+	}
+	// fail early, the permissions are checked again by SendMsg when the msg is sent
+	if err := m.messageVerification(ctx, &pbmsg.SendMsgReq{MsgData: req.MsgData}); err != nil {
+		return nil, err
+	}
+	msgData := proto.Clone(req.MsgData).(*sdkws.MsgData)
+	msgData.SendTime = 0
+	msgData.ServerMsgID = ""
+	data, err := proto.Marshal(msgData)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "marshal msgData")
+	}
+	now := time.Now()
+	scheduled := &relation.ScheduledMsgModel{
+		ScheduleID:  GetMsgID(req.UserID),
+		UserID:      req.UserID,
+		SendID:      msgData.SendID,
+		RecvID:      msgData.RecvID,
+		GroupID:     msgData.GroupID,
+		SessionType: msgData.SessionType,
+		MsgData:     data,
+		SendTime:    time.UnixMilli(req.SendTime),
+		Status:      relation.ScheduledMsgStatusPending,
+		CreateTime:  now,
+		UpdateTime:  now,
+	}
+	if err := m.ScheduledMsgDatabase.CreateScheduledMsg(ctx, scheduled); err != nil {
+		return nil, err
+	}
+	return &pbmsgschedule.ScheduleMsgResp{ScheduleID: scheduled.ScheduleID}, nil
+}
+
+func (m *msgServer) GetScheduledMsgs(ctx context.Context, req *pbmsgschedule.GetScheduledMsgsReq) (*pbmsgschedule.GetScheduledMsgsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	var status *int32
+	if req.Status != nil {
+		status = datautil.ToPtr(int32(*req.Status))
+	}
+	total, msgs, err := m.ScheduledMsgDatabase.FindUserScheduledMsgs(ctx, req.UserID, status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbmsgschedule.GetScheduledMsgsResp{Total: total, Msgs: make([]*pbmsgschedule.ScheduledMsg, 0, len(msgs))}
+	for _, msg := range msgs {
+		msgData := &sdkws.MsgData{}
+		if err := proto.Unmarshal(msg.MsgData, msgData); err != nil {
+			return nil, errs.WrapMsg(err, "unmarshal scheduled msgData", "scheduleID", msg.ScheduleID)
+		}
+		resp.Msgs = append(resp.Msgs, &pbmsgschedule.ScheduledMsg{
+			ScheduleID: msg.ScheduleID,
+			UserID:     msg.UserID,
+			MsgData:    msgData,
+			SendTime:   msg.SendTime.UnixMilli(),
+			Status:     pbmsgschedule.ScheduledMsgStatus(msg.Status),
+			Error:      msg.Error,
+			CreateTime: msg.CreateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+func (m *msgServer) CancelScheduledMsg(ctx context.Context, req *pbmsgschedule.CancelScheduledMsgReq) (*pbmsgschedule.CancelScheduledMsgResp, error) {
+	if _, err := m.getOwnScheduledMsg(ctx, req.UserID, req.ScheduleID); err != nil {
+		return nil, err
+	}
+	canceled, err := m.ScheduledMsgDatabase.CancelScheduledMsg(ctx, req.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+	if !canceled {
+		return nil, errs.ErrArgs.WrapMsg("scheduled msg is no longer pending")
+	}
+	return &pbmsgschedule.CancelScheduledMsgResp{}, nil
+}
+
+func (m *msgServer) RescheduleMsg(ctx context.Context, req *pbmsgschedule.RescheduleMsgReq) (*pbmsgschedule.RescheduleMsgResp, error) {
+	if _, err := m.getOwnScheduledMsg(ctx, req.UserID, req.ScheduleID); err != nil {
+		return nil, err
+	}
+	if err := m.checkScheduleTime(req.SendTime); err != nil {
+		return nil, err
+	}
+	rescheduled, err := m.ScheduledMsgDatabase.RescheduleMsg(ctx, req.ScheduleID, time.UnixMilli(req.SendTime))
+	if err != nil {
+		return nil, err
+	}
+	if !rescheduled {
+		return nil, errs.ErrArgs.WrapMsg("scheduled msg is no longer pending")
+	}
+	return &pbmsgschedule.RescheduleMsgResp{}, nil
+}
+
+// DispatchScheduledMsgs sends the due msgs through SendMsg, which checks the sender's permissions
+// such as muting and group membership at the time of sending. Msgs claimed longer than the dispatch timeout ago
+// were left behind by a crashed dispatcher and are sent again.
+func (m *msgServer) DispatchScheduledMsgs(ctx context.Context, req *pbmsgschedule.DispatchScheduledMsgsReq) (*pbmsgschedule.DispatchScheduledMsgsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	timeout := time.Duration(m.config.RpcConfig.ScheduleMsg.DispatchTimeout) * time.Second
+	if timeout <= 0 {
+		timeout = defaultScheduleDispatchTimeout
+	}
+	resp := &pbmsgschedule.DispatchScheduledMsgsResp{}
+	for resp.Sent+resp.Failed < req.Limit {
+		now := time.Now()
+		scheduled, err := m.ScheduledMsgDatabase.TakeDueScheduledMsg(ctx, now, now.Add(-timeout))
+		if err != nil {
+			return nil, err
+		}
+		if scheduled == nil {
+			break
+		}
+		status := int32(relation.ScheduledMsgStatusSent)
+		var errMsg string
+		if err := m.sendScheduledMsg(ctx, scheduled); err != nil {
+			log.ZWarn(ctx, "send scheduled msg failed", err, "scheduleID", scheduled.ScheduleID)
+			status, errMsg = relation.ScheduledMsgStatusFailed, err.Error()
+			resp.Failed++
+		} else {
+			resp.Sent++
+		}
+		if err := m.ScheduledMsgDatabase.SetScheduledMsgResult(ctx, scheduled.ScheduleID, status, errMsg); err != nil {
+			log.ZError(ctx, "set scheduled msg result failed", err, "scheduleID", scheduled.ScheduleID, "status", status)
+		}
+	}
+	return resp, nil
+}
+
+func (m *msgServer) sendScheduledMsg(ctx context.Context, scheduled *relation.ScheduledMsgModel) error {
+	msgData := &sdkws.MsgData{}
+	if err := proto.Unmarshal(scheduled.MsgData, msgData); err != nil {
+		return errs.WrapMsg(err, "unmarshal scheduled msgData")
+	}
+	_, err := m.SendMsg(ctx, &pbmsg.SendMsgReq{MsgData: msgData})
+	return err
+}
@@ -0,0 +1,57 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	pbmsgschedule "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgschedule"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockScheduledMsgDatabase struct {
+	controller.ScheduledMsgDatabase
+	staleBefore []time.Duration
+}
+
+func (m *mockScheduledMsgDatabase) TakeDueScheduledMsg(ctx context.Context, now time.Time, staleBefore time.Time) (*relation.ScheduledMsgModel, error) {
+	m.staleBefore = append(m.staleBefore, now.Sub(staleBefore))
+	return nil, nil
+}
+
+func TestDispatchScheduledMsgsTimeout(t *testing.T) {
+	ctx := mcontext.WithOpUserIDContext(context.Background(), "admin")
+	db := &mockScheduledMsgDatabase{}
+	m := &msgServer{
+		ScheduledMsgDatabase: db,
+		config:               &Config{Share: config.Share{IMAdminUserID: []string{"admin"}}},
+	}
+	_, err := m.DispatchScheduledMsgs(ctx, &pbmsgschedule.DispatchScheduledMsgsReq{Limit: 10})
+	assert.NoError(t, err)
+
+	m.config.RpcConfig.ScheduleMsg.DispatchTimeout = 60
+	_, err = m.DispatchScheduledMsgs(ctx, &pbmsgschedule.DispatchScheduledMsgsReq{Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Duration{defaultScheduleDispatchTimeout, time.Minute}, db.staleBefore)
+
+	_, err = m.DispatchScheduledMsgs(mcontext.WithOpUserIDContext(context.Background(), "user1"), &pbmsgschedule.DispatchScheduledMsgsReq{Limit: 10})
+	assert.Error(t, err)
+}
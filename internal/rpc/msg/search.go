@@ -0,0 +1,201 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/search"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/search/bleve"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/search/opensearch"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	pbmsgsearch "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgsearch"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// newSearchIndex returns nil when the search index is disabled.
+func newSearchIndex(ctx context.Context, conf *config.Search) (search.SearchIndex, error) {
+	switch conf.Enable {
+	case "":
+		return nil, nil
+	case search.BleveIndex:
+		return bleve.NewIndex(conf)
+	case search.OpenSearchIndex:
+		return opensearch.NewIndex(ctx, conf)
+	default:
+		return nil, errs.New("unknown search index", "enable", conf.Enable)
+	}
+}
+
+func (m *msgServer) IndexMsgs(ctx context.Context, req *pbmsgsearch.IndexMsgsReq) (*pbmsgsearch.IndexMsgsResp, error) {
+	if m.searchIndex == nil {
+		return &pbmsgsearch.IndexMsgsResp{}, nil
+	}
+	docs := make([]*search.MsgDoc, 0, len(req.Msgs))
+	for _, msgData := range req.Msgs {
+		if doc := search.NewMsgDoc(req.ConversationID, msgData); doc != nil {
+			docs = append(docs, doc)
+		}
+	}
+	if len(docs) == 0 {
+		return &pbmsgsearch.IndexMsgsResp{}, nil
+	}
+	if err := m.searchIndex.Index(ctx, docs); err != nil {
+		return nil, err
+	}
+	return &pbmsgsearch.IndexMsgsResp{}, nil
+}
+
+func (m *msgServer) SearchMsg(ctx context.Context, req *pbmsgsearch.SearchMsgReq) (*pbmsgsearch.SearchMsgResp, error) {
+	if m.searchIndex == nil {
+		return m.searchMsgInMongo(ctx, req)
+	}
+	q, err := newSearchQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	// Users only search their own conversations, and only see messages they can still pull.
+	var userID string
+	if !authverify.IsAppManagerUid(ctx, m.config.Share.AdminUserIDs()) {
+		userID = mcontext.GetOpUserID(ctx)
+		conversationIDs, err := m.ConversationLocalCache.GetConversationIDs(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if len(q.ConversationIDs) == 0 {
+			q.ConversationIDs = conversationIDs
+		} else if len(datautil.SliceSub(q.ConversationIDs, conversationIDs)) > 0 {
+			return nil, servererrs.ErrNoPermission.WrapMsg("conversation not found")
+		}
+		if len(q.ConversationIDs) == 0 {
+			return &pbmsgsearch.SearchMsgResp{}, nil
+		}
+	}
+	res, err := m.searchIndex.Search(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	msgs, hits, err := m.getSearchHitMsgs(ctx, userID, res.Hits)
+	if err != nil {
+		return nil, err
+	}
+	chatLogs, err := m.toChatLogs(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+	return &pbmsgsearch.SearchMsgResp{
+		ChatLogs:    chatLogs,
+		ChatLogsNum: int32(res.Total),
+		Hits:        hits,
+		NextCursor:  res.NextCursor,
+	}, nil
+}
+
+func newSearchQuery(req *pbmsgsearch.SearchMsgReq) (*search.Query, error) {
+	q := &search.Query{
+		Keyword:         req.Keyword,
+		ConversationIDs: req.ConversationIDs,
+		SendID:          req.SendID,
+		RecvID:          req.RecvID,
+		SessionType:     req.SessionType,
+		ContentTypes:    req.ContentTypes,
+		StartTime:       req.StartTime,
+		EndTime:         req.EndTime,
+		Cursor:          req.Cursor,
+		Count:           int(req.Count),
+	}
+	if req.ContentType != 0 {
+		q.ContentTypes = append(q.ContentTypes, req.ContentType)
+	}
+	if req.SendTime != "" {
+		sendTime, err := time.Parse(time.DateOnly, req.SendTime)
+		if err != nil {
+			return nil, errs.ErrArgs.WrapMsg("invalid sendTime", "req", req.SendTime, "format", time.DateOnly)
+		}
+		q.StartTime = sendTime.UnixMilli()
+		q.EndTime = sendTime.Add(24 * time.Hour).UnixMilli()
+	}
+	if req.Pagination != nil && req.Pagination.ShowNumber > 0 {
+		if q.Count == 0 {
+			q.Count = int(req.Pagination.ShowNumber)
+		}
+		if req.Pagination.PageNumber > 1 {
+			q.Offset = int(req.Pagination.PageNumber-1) * int(req.Pagination.ShowNumber)
+		}
+	}
+	return q, nil
+}
+
+// getSearchHitMsgs reads the messages of the hits back from the database, keeping the hit order and dropping
+// messages that were deleted since they were indexed.
+func (m *msgServer) getSearchHitMsgs(ctx context.Context, userID string, hits []*search.Hit) ([]*sdkws.MsgData, []*pbmsgsearch.SearchMsgHit, error) {
+	seqs := make(map[string][]int64)
+	for _, hit := range hits {
+		seqs[hit.ConversationID] = append(seqs[hit.ConversationID], hit.Seq)
+	}
+	found := make(map[string]map[int64]*sdkws.MsgData, len(seqs))
+	for conversationID, conversationSeqs := range seqs {
+		_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, userID, conversationID, conversationSeqs)
+		if err != nil {
+			return nil, nil, err
+		}
+		found[conversationID] = make(map[int64]*sdkws.MsgData, len(msgs))
+		for _, msgData := range msgs {
+			if msgData == nil || msgData.SendID == "" || msgData.Status == constant.MsgDeleted {
+				continue
+			}
+			found[conversationID][msgData.Seq] = msgData
+		}
+	}
+	msgs := make([]*sdkws.MsgData, 0, len(hits))
+	pbHits := make([]*pbmsgsearch.SearchMsgHit, 0, len(hits))
+	for _, hit := range hits {
+		msgData, ok := found[hit.ConversationID][hit.Seq]
+		if !ok {
+			continue
+		}
+		msgs = append(msgs, msgData)
+		pbHits = append(pbHits, &pbmsgsearch.SearchMsgHit{ConversationID: hit.ConversationID, Fragments: hit.Fragments})
+	}
+	return msgs, pbHits, nil
+}
+
+// searchMsgInMongo serves searches without the search index, as /msg/search_msg always did.
+func (m *msgServer) searchMsgInMongo(ctx context.Context, req *pbmsgsearch.SearchMsgReq) (*pbmsgsearch.SearchMsgResp, error) {
+	if req.Keyword != "" || req.Cursor != "" || len(req.ConversationIDs) > 0 || len(req.ContentTypes) > 0 ||
+		req.StartTime != 0 || req.EndTime != 0 {
+		return nil, errs.ErrArgs.WrapMsg("keyword, cursor, conversation, content types and time range filters need the search index")
+	}
+	resp, err := m.SearchMessage(ctx, &msg.SearchMessageReq{
+		SendID:      req.SendID,
+		RecvID:      req.RecvID,
+		ContentType: req.ContentType,
+		SendTime:    req.SendTime,
+		SessionType: req.SessionType,
+		Pagination:  req.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbmsgsearch.SearchMsgResp{ChatLogs: resp.ChatLogs, ChatLogsNum: resp.ChatLogsNum}, nil
+}
@@ -42,6 +42,8 @@ func (m *msgServer) SendMsg(ctx context.Context, req *pbmsg.SendMsgReq) (*pbmsg.
 			return m.sendMsgNotification(ctx, req)
 		case constant.ReadGroupChatType:
 			return m.sendMsgSuperGroupChat(ctx, req)
+		case msgprocessor.ThreadChatType:
+			return m.sendMsgThread(ctx, req)
 		default:
 			return nil, errs.ErrArgs.WrapMsg("unknown sessionType")
 		}
@@ -55,10 +57,10 @@ func (m *msgServer) sendMsgSuperGroupChat(ctx context.Context, req *pbmsg.SendMs
 		return nil, err
 	}
 
-	if err = m.webhookBeforeSendGroupMsg(ctx, &m.config.WebhooksConfig.BeforeSendGroupMsg, req); err != nil {
+	if err = m.webhookBeforeSendGroupMsg(ctx, &m.config.WebhooksConfig.Current().BeforeSendGroupMsg, req); err != nil {
 		return nil, err
 	}
-	if err := m.webhookBeforeMsgModify(ctx, &m.config.WebhooksConfig.BeforeMsgModify, req); err != nil {
+	if err := m.webhookBeforeMsgModify(ctx, &m.config.WebhooksConfig.Current().BeforeMsgModify, req); err != nil {
 		return nil, err
 	}
 	err = m.MsgDatabase.MsgToMQ(ctx, conversationutil.GenConversationUniqueKeyForGroup(req.MsgData.GroupID), req.MsgData)
@@ -69,7 +71,7 @@ func (m *msgServer) sendMsgSuperGroupChat(ctx context.Context, req *pbmsg.SendMs
 		go m.setConversationAtInfo(ctx, req.MsgData)
 	}
 
-	m.webhookAfterSendGroupMsg(ctx, &m.config.WebhooksConfig.AfterSendGroupMsg, req)
+	m.webhookAfterSendGroupMsg(ctx, &m.config.WebhooksConfig.Current().AfterSendGroupMsg, req)
 	prommetrics.GroupChatMsgProcessSuccessCounter.Inc()
 	resp = &pbmsg.SendMsgResp{}
 	resp.SendTime = req.MsgData.SendTime
@@ -152,10 +154,10 @@ func (m *msgServer) sendMsgSingleChat(ctx context.Context, req *pbmsg.SendMsgReq
 		prommetrics.SingleChatMsgProcessFailedCounter.Inc()
 		return nil, nil
 	} else {
-		if err = m.webhookBeforeSendSingleMsg(ctx, &m.config.WebhooksConfig.BeforeSendSingleMsg, req); err != nil {
+		if err = m.webhookBeforeSendSingleMsg(ctx, &m.config.WebhooksConfig.Current().BeforeSendSingleMsg, req); err != nil {
 			return nil, err
 		}
-		if err := m.webhookBeforeMsgModify(ctx, &m.config.WebhooksConfig.BeforeMsgModify, req); err != nil {
+		if err := m.webhookBeforeMsgModify(ctx, &m.config.WebhooksConfig.Current().BeforeMsgModify, req); err != nil {
 			return nil, err
 		}
 
@@ -163,7 +165,7 @@ func (m *msgServer) sendMsgSingleChat(ctx context.Context, req *pbmsg.SendMsgReq
 			prommetrics.SingleChatMsgProcessFailedCounter.Inc()
 			return nil, err
 		}
-		m.webhookAfterSendSingleMsg(ctx, &m.config.WebhooksConfig.AfterSendSingleMsg, req)
+		m.webhookAfterSendSingleMsg(ctx, &m.config.WebhooksConfig.Current().AfterSendSingleMsg, req)
 		prommetrics.SingleChatMsgProcessSuccessCounter.Inc()
 		return &pbmsg.SendMsgResp{
 			ServerMsgID: req.MsgData.ServerMsgID,
@@ -17,14 +17,24 @@ package msg
 import (
 	"context"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/dbconn"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/protocol/sdkws"
-	"github.com/openimsdk/tools/db/mongoutil"
-	"github.com/openimsdk/tools/db/redisutil"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/search"
+	"github.com/openimsdk/open-im-server/v3/pkg/legalhold"
+	pblegalhold "github.com/openimsdk/open-im-server/v3/pkg/protocol/legalhold"
+	pbmsgdestruct "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgdestruct"
+	pbmsgedit "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgedit"
+	pbmsgreaction "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgreaction"
+	pbmsgreceipt "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgreceipt"
+	pbmsgretention "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgretention"
+	pbmsgschedule "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgschedule"
+	pbmsgsearch "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgsearch"
+	pbmsgthread "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgthread"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
@@ -41,8 +51,23 @@ type (
 
 	// MsgServer encapsulates dependencies required for message handling.
 	msgServer struct {
+		pbmsgsearch.UnimplementedMsgSearchServer
+		pbmsgedit.UnimplementedMsgEditServer
+		pbmsgreaction.UnimplementedMsgReactionServer
+		pbmsgthread.UnimplementedMsgThreadServer
+		pbmsgschedule.UnimplementedMsgScheduleServer
+		pbmsgreceipt.UnimplementedMsgReceiptServer
+		pbmsgretention.UnimplementedMsgRetentionServer
+		pblegalhold.UnimplementedLegalHoldServiceServer
+		pbmsgdestruct.UnimplementedMsgDestructServer
 		RegisterCenter         discovery.SvcDiscoveryRegistry   // Service discovery registry for service registration.
 		MsgDatabase            controller.CommonMsgDatabase     // Interface for message database operations.
+		ReactionDatabase       controller.MsgReactionDatabase   // Interface for message reaction operations.
+		ThreadDatabase         controller.ThreadDatabase        // Interface for thread operations.
+		ScheduledMsgDatabase   controller.ScheduledMsgDatabase  // Interface for scheduled message operations.
+		RetentionDatabase      controller.RetentionDatabase     // Interface for retention policy operations.
+		LegalHoldDatabase      controller.LegalHoldDatabase     // Interface for legal hold operations.
+		MsgBurnDatabase        controller.MsgBurnDatabase       // Interface for burn after reading operations.
 		Conversation           *rpcclient.ConversationRpcClient // RPC client for conversation service.
 		UserLocalCache         *rpccache.UserLocalCache         // Local cache for user data.
 		FriendLocalCache       *rpccache.FriendLocalCache       // Local cache for friend data.
@@ -52,6 +77,8 @@ type (
 		notificationSender     *rpcclient.NotificationSender    // RPC client for sending notifications.
 		config                 *Config                          // Global configuration settings.
 		webhookClient          *webhook.Client
+		searchIndex            search.SearchIndex // Full-text message index, nil when disabled.
+		legalHold              *legalhold.Checker // Checks the deletions against the legal holds.
 	}
 
 	Config struct {
@@ -64,6 +91,7 @@ type (
 		Share              config.Share
 		WebhooksConfig     config.Webhooks
 		LocalCacheConfig   config.LocalCache
+		SearchConfig       config.Search
 	}
 )
 
@@ -73,11 +101,11 @@ func (m *msgServer) addInterceptorHandler(interceptorFunc ...MessageInterceptorF
 }
 
 func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
-	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
+	mgocli, err := dbconn.NewMongoDB(ctx, config.MongodbConfig.Build())
 	if err != nil {
 		return err
 	}
-	rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
+	rdb, err := dbconn.NewRedisClient(ctx, config.RedisConfig.Build())
 	if err != nil {
 		return err
 	}
@@ -85,31 +113,87 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	msgReactionModel, err := mgo.NewMsgReactionMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	threadModel, err := mgo.NewThreadMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	threadParticipantModel, err := mgo.NewThreadParticipantMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	scheduledMsgModel, err := mgo.NewScheduledMsgMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	retentionPolicyModel, err := mgo.NewRetentionPolicyMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	legalHoldModel, err := mgo.NewLegalHoldMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	legalHoldAuditModel, err := mgo.NewLegalHoldAuditMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	msgBurnModel, err := mgo.NewMsgBurnMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 	//todo MsgCacheTimeout
 	msgModel := cache.NewMsgCache(rdb, config.RedisConfig.EnablePipeline)
 	seqModel := cache.NewSeqCache(rdb)
 	conversationClient := rpcclient.NewConversationRpcClient(client, config.Share.RpcRegisterName.Conversation)
-	userRpcClient := rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID)
+	userRpcClient := rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User, config.Share.AdminUserIDs)
 	groupRpcClient := rpcclient.NewGroupRpcClient(client, config.Share.RpcRegisterName.Group)
 	friendRpcClient := rpcclient.NewFriendRpcClient(client, config.Share.RpcRegisterName.Friend)
 	msgDatabase, err := controller.NewCommonMsgDatabase(msgDocModel, msgModel, seqModel, &config.KafkaConfig)
 	if err != nil {
 		return err
 	}
+	threadDatabase := controller.NewThreadDatabase(threadModel, threadParticipantModel,
+		cache.NewThreadCacheRedis(rdb, threadModel, threadParticipantModel, cache.GetDefaultOpt()))
+	legalHoldDatabase := controller.NewLegalHoldDatabase(legalHoldModel, legalHoldAuditModel)
+	searchIndex, err := newSearchIndex(ctx, &config.SearchConfig)
+	if err != nil {
+		return err
+	}
 	s := &msgServer{
 		Conversation:           &conversationClient,
 		MsgDatabase:            msgDatabase,
+		ReactionDatabase:       controller.NewMsgReactionDatabase(msgReactionModel, cache.NewMsgReactionCacheRedis(rdb, msgReactionModel, cache.GetDefaultOpt())),
+		ThreadDatabase:         threadDatabase,
+		ScheduledMsgDatabase:   controller.NewScheduledMsgDatabase(scheduledMsgModel),
+		RetentionDatabase:      controller.NewRetentionDatabase(retentionPolicyModel),
+		LegalHoldDatabase:      legalHoldDatabase,
+		MsgBurnDatabase:        controller.NewMsgBurnDatabase(msgBurnModel),
 		RegisterCenter:         client,
 		UserLocalCache:         rpccache.NewUserLocalCache(userRpcClient, &config.LocalCacheConfig, rdb),
 		GroupLocalCache:        rpccache.NewGroupLocalCache(groupRpcClient, &config.LocalCacheConfig, rdb),
 		ConversationLocalCache: rpccache.NewConversationLocalCache(conversationClient, &config.LocalCacheConfig, rdb),
 		FriendLocalCache:       rpccache.NewFriendLocalCache(friendRpcClient, &config.LocalCacheConfig, rdb),
 		config:                 config,
-		webhookClient:          webhook.NewWebhookClient(config.WebhooksConfig.URL),
+		webhookClient:          webhook.NewWebhookClient(&config.WebhooksConfig),
+		searchIndex:            searchIndex,
 	}
 
+	s.legalHold = legalhold.NewChecker(legalHoldDatabase, s.ConversationLocalCache.GetConversationIDs)
 	s.notificationSender = rpcclient.NewNotificationSender(&config.NotificationConfig, rpcclient.WithLocalSendMsg(s.SendMsg))
 	msg.RegisterMsgServer(server, s)
+	pbmsgsearch.RegisterMsgSearchServer(server, s)
+	pbmsgedit.RegisterMsgEditServer(server, s)
+	pbmsgreaction.RegisterMsgReactionServer(server, s)
+	pbmsgthread.RegisterMsgThreadServer(server, s)
+	pbmsgschedule.RegisterMsgScheduleServer(server, s)
+	pbmsgreceipt.RegisterMsgReceiptServer(server, s)
+	pbmsgretention.RegisterMsgRetentionServer(server, s)
+	pblegalhold.RegisterLegalHoldServiceServer(server, s)
+	pbmsgdestruct.RegisterMsgDestructServer(server, s)
 	return nil
 }
 
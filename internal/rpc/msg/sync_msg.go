@@ -87,7 +87,7 @@ func (m *msgServer) PullMessageBySeqs(ctx context.Context, req *sdkws.PullMessag
 }
 
 func (m *msgServer) GetMaxSeq(ctx context.Context, req *sdkws.GetMaxSeqReq) (*sdkws.GetMaxSeqResp, error) {
-	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.AdminUserIDs()); err != nil {
 		return nil, err
 	}
 	conversationIDs, err := m.ConversationLocalCache.GetConversationIDs(ctx, req.UserID)
@@ -116,7 +116,15 @@ func (m *msgServer) SearchMessage(ctx context.Context, req *msg.SearchMessageReq
 	if total, chatLogs, err = m.MsgDatabase.SearchMessage(ctx, req); err != nil {
 		return nil, err
 	}
+	if resp.ChatLogs, err = m.toChatLogs(ctx, chatLogs); err != nil {
+		return nil, err
+	}
+	resp.ChatLogsNum = total
+	return resp, nil
+}
 
+// toChatLogs fills in the nicknames and group details of messages returned by a search.
+func (m *msgServer) toChatLogs(ctx context.Context, chatLogs []*sdkws.MsgData) ([]*msg.ChatLog, error) {
 	var (
 		sendIDs  []string
 		recvIDs  []string
@@ -172,6 +180,7 @@ func (m *msgServer) SearchMessage(ctx context.Context, req *msg.SearchMessageReq
 		}
 	}
 	// Construct response with updated information
+	pbChatLogs := make([]*msg.ChatLog, 0, len(chatLogs))
 	for _, chatLog := range chatLogs {
 		pbchatLog := &msg.ChatLog{}
 		datautil.CopyStructFields(pbchatLog, chatLog)
@@ -192,10 +201,9 @@ func (m *msgServer) SearchMessage(ctx context.Context, req *msg.SearchMessageReq
 			pbchatLog.GroupOwner = groupInfo.OwnerUserID
 			pbchatLog.GroupType = groupInfo.GroupType
 		}
-		resp.ChatLogs = append(resp.ChatLogs, pbchatLog)
+		pbChatLogs = append(pbChatLogs, pbchatLog)
 	}
-	resp.ChatLogsNum = total
-	return resp, nil
+	return pbChatLogs, nil
 }
 
 func (m *msgServer) GetServerTime(ctx context.Context, _ *msg.GetServerTimeReq) (*msg.GetServerTimeResp, error) {
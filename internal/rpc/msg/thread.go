@@ -0,0 +1,229 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	pbmsgthread "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgthread"
+	"github.com/openimsdk/protocol/constant"
+	pbmsg "github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// sendMsgThread sends a reply to a thread, the thread must have been created from its root message first.
+// Replies are keyed by thread so that each batch consumed by msgtransfer belongs to one thread.
+func (m *msgServer) sendMsgThread(ctx context.Context, req *pbmsg.SendMsgReq) (*pbmsg.SendMsgResp, error) {
+	if err := m.messageVerification(ctx, req); err != nil {
+		prommetrics.GroupChatMsgProcessFailedCounter.Inc()
+		return nil, err
+	}
+	threadID := msgprocessor.GetThreadConversationID(req.MsgData.GroupID, req.MsgData.RecvID)
+	if _, err := m.ThreadDatabase.TakeThread(ctx, threadID); err != nil {
+		return nil, err
+	}
+	if err := m.webhookBeforeMsgModify(ctx, &m.config.WebhooksConfig.Current().BeforeMsgModify, req); err != nil {
+		return nil, err
+	}
+	if err := m.MsgDatabase.MsgToMQ(ctx, threadID, req.MsgData); err != nil {
+		return nil, err
+	}
+	prommetrics.GroupChatMsgProcessSuccessCounter.Inc()
+	return &pbmsg.SendMsgResp{
+		ServerMsgID: req.MsgData.ServerMsgID,
+		ClientMsgID: req.MsgData.ClientMsgID,
+		SendTime:    req.MsgData.SendTime,
+	}, nil
+}
+
+// checkThreadAccess allows app managers and members of the group the thread belongs to.
+func (m *msgServer) checkThreadAccess(ctx context.Context, userID string, thread *relation.ThreadModel) error {
+	if authverify.IsAppManagerUid(ctx, m.config.Share.AdminUserIDs()) {
+		return nil
+	}
+	_, err := m.GroupLocalCache.GetGroupMember(ctx, thread.GroupID, userID)
+	return err
+}
+
+func (m *msgServer) getAccessibleThread(ctx context.Context, userID, threadID string) (*relation.ThreadModel, error) {
+	if err := authverify.CheckAccessV3(ctx, userID, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	thread, err := m.ThreadDatabase.TakeThread(ctx, threadID)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkThreadAccess(ctx, userID, thread); err != nil {
+		return nil, err
+	}
+	return thread, nil
+}
+
+func (m *msgServer) CreateThread(ctx context.Context, req *pbmsgthread.CreateThreadReq) (*pbmsgthread.CreateThreadResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.RootSeq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.WrapMsg("msg not found")
+	}
+	root := msgs[0]
+	if root.SessionType != constant.ReadGroupChatType {
+		return nil, errs.ErrArgs.WrapMsg("threads can only be started from group msgs")
+	}
+	if root.ContentType >= constant.NotificationBegin {
+		return nil, errs.ErrArgs.WrapMsg("threads can not be started from notifications", "contentType", root.ContentType)
+	}
+	if _, err := m.GroupLocalCache.GetGroupMember(ctx, root.GroupID, req.UserID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	thread, err := m.ThreadDatabase.CreateThread(ctx, &relation.ThreadModel{
+		ThreadID:        msgprocessor.GetThreadConversationID(root.GroupID, root.ClientMsgID),
+		ConversationID:  req.ConversationID,
+		GroupID:         root.GroupID,
+		RootSeq:         root.Seq,
+		RootClientMsgID: root.ClientMsgID,
+		CreatorUserID:   req.UserID,
+		CreateTime:      now,
+		UpdateTime:      now,
+	}, []string{req.UserID, root.SendID})
+	if err != nil {
+		return nil, err
+	}
+	return &pbmsgthread.CreateThreadResp{Thread: threadDB2PB(thread)}, nil
+}
+
+func (m *msgServer) GetThreadsByRootSeqs(ctx context.Context, req *pbmsgthread.GetThreadsByRootSeqsReq) (*pbmsgthread.GetThreadsByRootSeqsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	if err := m.checkConversationAccess(ctx, req.ConversationID); err != nil {
+		return nil, err
+	}
+	threads, err := m.ThreadDatabase.FindThreadsByRootSeqs(ctx, req.ConversationID, datautil.Distinct(req.RootSeqs))
+	if err != nil {
+		return nil, err
+	}
+	return &pbmsgthread.GetThreadsByRootSeqsResp{Threads: datautil.Slice(threads, threadDB2PB)}, nil
+}
+
+func (m *msgServer) GetUserThreads(ctx context.Context, req *pbmsgthread.GetUserThreadsReq) (*pbmsgthread.GetUserThreadsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	total, threadIDs, err := m.ThreadDatabase.FindUserThreadIDs(ctx, req.UserID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	threads, err := m.ThreadDatabase.FindThreads(ctx, threadIDs)
+	if err != nil {
+		return nil, err
+	}
+	maxSeqs, err := m.MsgDatabase.GetMaxSeqs(ctx, threadIDs)
+	if err != nil {
+		return nil, err
+	}
+	hasReadSeqs, err := m.MsgDatabase.GetHasReadSeqs(ctx, req.UserID, threadIDs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbmsgthread.GetUserThreadsResp{Total: total, Threads: make([]*pbmsgthread.UserThread, 0, len(threads))}
+	for _, thread := range threads {
+		resp.Threads = append(resp.Threads, &pbmsgthread.UserThread{
+			Thread:     threadDB2PB(thread),
+			MaxSeq:     maxSeqs[thread.ThreadID],
+			HasReadSeq: hasReadSeqs[thread.ThreadID],
+		})
+	}
+	return resp, nil
+}
+
+func (m *msgServer) GetThreadParticipantIDs(ctx context.Context, req *pbmsgthread.GetThreadParticipantIDsReq) (*pbmsgthread.GetThreadParticipantIDsResp, error) {
+	userIDs, err := m.ThreadDatabase.GetParticipantIDs(ctx, req.ThreadID)
+	if err != nil {
+		return nil, err
+	}
+	return &pbmsgthread.GetThreadParticipantIDsResp{UserIDs: userIDs}, nil
+}
+
+func (m *msgServer) PullThreadMsgs(ctx context.Context, req *pbmsgthread.PullThreadMsgsReq) (*pbmsgthread.PullThreadMsgsResp, error) {
+	if _, err := m.getAccessibleThread(ctx, req.UserID, req.ThreadID); err != nil {
+		return nil, err
+	}
+	minSeq, maxSeq, msgs, err := m.MsgDatabase.GetMsgBySeqsRange(ctx, req.UserID, req.ThreadID, req.Begin, req.End, req.Num, 0)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbmsgthread.PullThreadMsgsResp{Msgs: msgs}
+	switch req.Order {
+	case sdkws.PullOrder_PullOrderAsc:
+		resp.IsEnd = maxSeq <= req.End
+	case sdkws.PullOrder_PullOrderDesc:
+		resp.IsEnd = req.Begin <= minSeq
+	}
+	return resp, nil
+}
+
+func (m *msgServer) MarkThreadAsRead(ctx context.Context, req *pbmsgthread.MarkThreadAsReadReq) (*pbmsgthread.MarkThreadAsReadResp, error) {
+	if _, err := m.getAccessibleThread(ctx, req.UserID, req.ThreadID); err != nil {
+		return nil, err
+	}
+	maxSeq, err := m.MsgDatabase.GetMaxSeq(ctx, req.ThreadID)
+	if err != nil {
+		return nil, err
+	}
+	if req.HasReadSeq > maxSeq {
+		return nil, errs.ErrArgs.WrapMsg("hasReadSeq must not be bigger than maxSeq")
+	}
+	if err := m.MsgDatabase.SetHasReadSeq(ctx, req.UserID, req.ThreadID, req.HasReadSeq); err != nil {
+		return nil, err
+	}
+	return &pbmsgthread.MarkThreadAsReadResp{}, nil
+}
+
+func threadDB2PB(thread *relation.ThreadModel) *pbmsgthread.ThreadInfo {
+	res := &pbmsgthread.ThreadInfo{
+		ThreadID:        thread.ThreadID,
+		ConversationID:  thread.ConversationID,
+		GroupID:         thread.GroupID,
+		RootSeq:         thread.RootSeq,
+		RootClientMsgID: thread.RootClientMsgID,
+		CreatorUserID:   thread.CreatorUserID,
+		ReplyCount:      thread.ReplyCount,
+		CreateTime:      thread.CreateTime.UnixMilli(),
+	}
+	if thread.LastReply != nil {
+		res.LastReply = &pbmsgthread.ThreadReply{
+			Seq:         thread.LastReply.Seq,
+			SendID:      thread.LastReply.SendID,
+			ClientMsgID: thread.LastReply.ClientMsgID,
+			ContentType: thread.LastReply.ContentType,
+			Content:     thread.LastReply.Content,
+			SendTime:    thread.LastReply.SendTime,
+		}
+	}
+	return res
+}
@@ -17,6 +17,7 @@ package msg
 import (
 	"context"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/encrypt"
 	"github.com/openimsdk/tools/utils/timeutil"
@@ -52,7 +53,7 @@ type MessageRevoked struct {
 func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgReq) error {
 	switch data.MsgData.SessionType {
 	case constant.SingleChatType:
-		if datautil.Contain(data.MsgData.SendID, m.config.Share.IMAdminUserID...) {
+		if datautil.Contain(data.MsgData.SendID, m.config.Share.AdminUserIDs()...) {
 			return nil
 		}
 		if data.MsgData.ContentType <= constant.NotificationEnd &&
@@ -77,7 +78,7 @@ func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgRe
 			return nil
 		}
 		return nil
-	case constant.ReadGroupChatType:
+	case constant.ReadGroupChatType, msgprocessor.ThreadChatType:
 		groupInfo, err := m.GroupLocalCache.GetGroupInfo(ctx, data.MsgData.GroupID)
 		if err != nil {
 			return err
@@ -90,7 +91,7 @@ func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgRe
 			return nil
 		}
 
-		if datautil.Contain(data.MsgData.SendID, m.config.Share.IMAdminUserID...) {
+		if datautil.Contain(data.MsgData.SendID, m.config.Share.AdminUserIDs()...) {
 			return nil
 		}
 		if data.MsgData.ContentType <= constant.NotificationEnd &&
@@ -0,0 +1,326 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package third
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	pbexportjob "github.com/openimsdk/open-im-server/v3/pkg/protocol/exportjob"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const (
+	exportDocBatch = 10
+
+	// exportJobKeepAlive is how often a running job is refreshed, a job not refreshed for exportJobStaleTimeout
+	// belongs to a process which crashed and is run again.
+	exportJobKeepAlive    = time.Second * 30
+	exportJobStaleTimeout = time.Minute * 3
+)
+
+func (t *thirdServer) CreateExportJob(ctx context.Context, req *pbexportjob.CreateExportJobReq) (*pbexportjob.CreateExportJobResp, error) {
+	if err := authverify.CheckAdmin(ctx, t.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	job := &relation.ExportJobModel{
+		JobID:          genLogID(),
+		TargetType:     int32(req.TargetType),
+		TargetID:       req.TargetID,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		OperatorUserID: mcontext.GetOpUserID(ctx),
+		Status:         relation.ExportJobStatusPending,
+		CreateTime:     now,
+		UpdateTime:     now,
+	}
+	if err := t.exportJobDatabase.CreateExportJob(ctx, job); err != nil {
+		return nil, err
+	}
+	return &pbexportjob.CreateExportJobResp{JobID: job.JobID}, nil
+}
+
+func (t *thirdServer) GetExportJob(ctx context.Context, req *pbexportjob.GetExportJobReq) (*pbexportjob.GetExportJobResp, error) {
+	if err := authverify.CheckAdmin(ctx, t.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	job, err := t.exportJobDatabase.TakeExportJob(ctx, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+	pbJob, err := t.exportJobDB2PB(ctx, job, true)
+	if err != nil {
+		return nil, err
+	}
+	return &pbexportjob.GetExportJobResp{Job: pbJob}, nil
+}
+
+func (t *thirdServer) GetExportJobs(ctx context.Context, req *pbexportjob.GetExportJobsReq) (*pbexportjob.GetExportJobsResp, error) {
+	if err := authverify.CheckAdmin(ctx, t.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	total, jobs, err := t.exportJobDatabase.PageExportJobs(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbexportjob.GetExportJobsResp{Total: total}
+	for _, job := range jobs {
+		pbJob, err := t.exportJobDB2PB(ctx, job, false)
+		if err != nil {
+			return nil, err
+		}
+		resp.Jobs = append(resp.Jobs, pbJob)
+	}
+	return resp, nil
+}
+
+// exportJobDB2PB converts the job, the download url is signed when withURL is set and the job is done.
+func (t *thirdServer) exportJobDB2PB(ctx context.Context, job *relation.ExportJobModel, withURL bool) (*pbexportjob.ExportJob, error) {
+	pbJob := &pbexportjob.ExportJob{
+		JobID:          job.JobID,
+		TargetType:     pbexportjob.ExportTargetType(job.TargetType),
+		TargetID:       job.TargetID,
+		StartTime:      job.StartTime,
+		EndTime:        job.EndTime,
+		OperatorUserID: job.OperatorUserID,
+		Status:         pbexportjob.ExportJobStatus(job.Status),
+		Error:          job.Error,
+		Size:           job.Size,
+		MsgNum:         job.MsgNum,
+		FileNum:        job.FileNum,
+		CreateTime:     job.CreateTime.UnixMilli(),
+	}
+	if !job.FinishTime.IsZero() {
+		pbJob.FinishTime = job.FinishTime.UnixMilli()
+	}
+	if withURL && job.Status == relation.ExportJobStatusDone {
+		expireTime, rawURL, err := t.s3dataBase.AccessURL(ctx, job.ObjectName, time.Duration(t.config.RpcConfig.Export.URLExpire)*time.Second, nil)
+		if err != nil {
+			return nil, err
+		}
+		pbJob.Url = rawURL
+		pbJob.UrlExpireTime = expireTime.UnixMilli()
+	}
+	return pbJob, nil
+}
+
+// runExportJobs polls the pending jobs until ctx is done, the jobs are claimed so that several instances can run them.
+func (t *thirdServer) runExportJobs(ctx context.Context) {
+	interval := time.Duration(t.config.RpcConfig.Export.PollInterval) * time.Second
+	if interval <= 0 {
+		interval = time.Second * 10
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for {
+			jobCtx := mcontext.SetOperationID(ctx, fmt.Sprintf("export_%d_%d", os.Getpid(), time.Now().UnixMilli()))
+			job, err := t.exportJobDatabase.TakePendingExportJob(jobCtx, time.Now().Add(-exportJobStaleTimeout))
+			if err != nil {
+				log.ZError(jobCtx, "take pending export job failed", err)
+				break
+			}
+			if job == nil {
+				break
+			}
+			t.runExportJob(mcontext.SetOpUserID(jobCtx, job.OperatorUserID), job)
+		}
+	}
+}
+
+func (t *thirdServer) runExportJob(ctx context.Context, job *relation.ExportJobModel) {
+	start := time.Now()
+	log.ZInfo(ctx, "export job start", "jobID", job.JobID, "targetType", job.TargetType, "targetID", job.TargetID)
+	keepAliveCtx, stopKeepAlive := context.WithCancel(ctx)
+	go t.keepExportJobAlive(keepAliveCtx, job.JobID)
+	err := t.buildExportArchive(ctx, job)
+	stopKeepAlive()
+	if err != nil {
+		log.ZError(ctx, "export job failed", err, "jobID", job.JobID, "cost", time.Since(start))
+		if err := t.exportJobDatabase.SetExportJobFailed(ctx, job.JobID, err.Error()); err != nil {
+			log.ZError(ctx, "set export job failed", err, "jobID", job.JobID)
+		}
+		return
+	}
+	if err := t.exportJobDatabase.SetExportJobDone(ctx, job); err != nil {
+		log.ZError(ctx, "set export job done failed", err, "jobID", job.JobID)
+		return
+	}
+	log.ZInfo(ctx, "export job done", "jobID", job.JobID, "msgNum", job.MsgNum, "fileNum", job.FileNum, "size", job.Size, "cost", time.Since(start))
+}
+
+func (t *thirdServer) keepExportJobAlive(ctx context.Context, jobID string) {
+	ticker := time.NewTicker(exportJobKeepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := t.exportJobDatabase.KeepExportJobAlive(ctx, jobID); err != nil {
+				log.ZWarn(ctx, "keep export job alive failed", err, "jobID", jobID)
+			}
+		}
+	}
+}
+
+func (t *thirdServer) exportConversationIDs(ctx context.Context, job *relation.ExportJobModel) ([]string, error) {
+	switch job.TargetType {
+	case relation.ExportTargetConversation:
+		return []string{job.TargetID}, nil
+	case relation.ExportTargetGroup:
+		return []string{msgprocessor.GetConversationIDBySessionType(constant.ReadGroupChatType, job.TargetID)}, nil
+	case relation.ExportTargetUser:
+		return t.conversationRpcClient.GetConversationIDs(ctx, job.TargetID)
+	}
+	return nil, errs.ErrArgs.WrapMsg("invalid export target type", "targetType", job.TargetType)
+}
+
+// buildExportArchive builds the archive of the job in a temporary file and uploads it, the results are set on job.
+func (t *thirdServer) buildExportArchive(ctx context.Context, job *relation.ExportJobModel) error {
+	conversationIDs, err := t.exportConversationIDs(ctx, job)
+	if err != nil {
+		return err
+	}
+	file, err := os.CreateTemp("", "openim-export-*.zip")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+	archive, err := newExportArchive(file, job)
+	if err != nil {
+		return err
+	}
+	for _, conversationID := range datautil.Distinct(conversationIDs) {
+		if err := t.exportConversation(ctx, archive, job, conversationID); err != nil {
+			archive.cleanup()
+			return err
+		}
+	}
+	if err := archive.WriteTranscript(); err != nil {
+		archive.cleanup()
+		return err
+	}
+	t.exportFiles(ctx, archive)
+	if err := archive.Close(); err != nil {
+		return err
+	}
+	return t.uploadExportArchive(ctx, job, file, archive.manifest.MsgNum, archive.manifest.FileNum)
+}
+
+// exportConversation adds the msgs of the conversation within the time range of the job, the docs are read
+// by index range so that every batch is a lookup by docID.
+func (t *thirdServer) exportConversation(ctx context.Context, archive *exportArchive, job *relation.ExportJobModel, conversationID string) error {
+	begin, end, err := t.exportJobDatabase.GetConversationDocIndexRange(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	for index := begin; index <= end; index += exportDocBatch {
+		docs, err := t.exportJobDatabase.FindConversationDocs(ctx, conversationID, index, min(index+exportDocBatch-1, end), job.StartTime, job.EndTime)
+		if err != nil {
+			return err
+		}
+		for _, doc := range docs {
+			for _, msg := range doc.Msg {
+				if msg == nil || msg.Msg == nil {
+					continue
+				}
+				if err := archive.AddMsg(conversationID, msg); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// exportFiles adds the referenced objects to the archive, the ones which can not be added are listed in the manifest.
+func (t *thirdServer) exportFiles(ctx context.Context, archive *exportArchive) {
+	exportConfig := t.config.RpcConfig.Export
+	for _, name := range archive.FileNames() {
+		if exportConfig.MaxFileNum > 0 && archive.manifest.FileNum >= int64(exportConfig.MaxFileNum) {
+			archive.SkipFile(name, "too many files")
+			continue
+		}
+		obj, err := t.s3dataBase.TakeObject(ctx, name)
+		if err != nil {
+			log.ZWarn(ctx, "export take object failed", err, "name", name)
+			archive.SkipFile(name, "not found")
+			continue
+		}
+		if exportConfig.MaxFileSize > 0 && obj.Size > exportConfig.MaxFileSize {
+			archive.SkipFile(name, "too large")
+			continue
+		}
+		if err := t.exportFile(ctx, archive, name); err != nil {
+			log.ZWarn(ctx, "export object failed", err, "name", name)
+			archive.SkipFile(name, "download failed")
+		}
+	}
+}
+
+func (t *thirdServer) exportFile(ctx context.Context, archive *exportArchive, name string) error {
+	body, err := t.s3dataBase.OpenObject(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	return archive.AddFile(name, body)
+}
+
+func (t *thirdServer) uploadExportArchive(ctx context.Context, job *relation.ExportJobModel, file *os.File, msgNum int64, fileNum int64) error {
+	hash := md5.New()
+	size, err := io.Copy(hash, io.NewSectionReader(file, 0, 1<<62))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	name := "export/" + job.JobID + ".zip"
+	obj := &relation.ObjectModel{
+		Name:        name,
+		UserID:      job.OperatorUserID,
+		Hash:        hex.EncodeToString(hash.Sum(nil)),
+		Key:         "openim/export/" + job.JobID + ".zip",
+		Size:        size,
+		ContentType: "application/zip",
+		Group:       "export",
+		CreateTime:  time.Now(),
+	}
+	if err := t.s3dataBase.PutObject(ctx, obj, io.NewSectionReader(file, 0, size)); err != nil {
+		return err
+	}
+	job.ObjectName, job.Size, job.MsgNum, job.FileNum = name, size, msgNum, fileNum
+	return nil
+}
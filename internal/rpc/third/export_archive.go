@@ -0,0 +1,305 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package third
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/json"
+	"html/template"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const objectURLPath = "/object/"
+
+var transcriptTemplate = template.Must(template.New("transcript").Parse(`{{define "head"}}<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>{{.}}</title>
+<style>body{font-family:sans-serif}h2{border-bottom:1px solid #ccc}.msg{margin:4px 0}.meta{color:#888}</style>
+</head><body><h1>{{.}}</h1>
+{{end}}{{define "conversation"}}<h2>{{.}}</h2>
+{{end}}{{define "msg"}}<div class="msg"><span class="meta">{{.Time}} {{.Sender}}:</span> {{.Text}}{{range .Files}} <a href="files/{{.}}">{{.}}</a>{{end}}</div>
+{{end}}{{define "tail"}}</body></html>
+{{end}}`))
+
+type exportLine struct {
+	ConversationID string         `json:"conversationID"`
+	Revoked        bool           `json:"revoked,omitempty"`
+	Msg            *sdkws.MsgData `json:"msg"`
+}
+
+type transcriptMsg struct {
+	Time   string
+	Sender string
+	Text   string
+	Files  []string
+}
+
+type skippedFile struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+type exportManifest struct {
+	JobID        string        `json:"jobID"`
+	TargetType   int32         `json:"targetType"`
+	TargetID     string        `json:"targetID"`
+	StartTime    int64         `json:"startTime"`
+	EndTime      int64         `json:"endTime"`
+	CreateTime   int64         `json:"createTime"`
+	MsgNum       int64         `json:"msgNum"`
+	FileNum      int64         `json:"fileNum"`
+	SkippedFiles []skippedFile `json:"skippedFiles,omitempty"`
+}
+
+// exportArchive writes messages.jsonl while the msgs are added, the transcript is rendered to a temporary file
+// because a zip entry can not be written once the next one is created.
+type exportArchive struct {
+	zw         *zip.Writer
+	jsonl      *json.Encoder
+	html       *os.File
+	htmlBuf    *bufio.Writer
+	files      map[string]struct{}
+	manifest   exportManifest
+	lastConvID string
+}
+
+func newExportArchive(w io.Writer, job *relation.ExportJobModel) (*exportArchive, error) {
+	html, err := os.CreateTemp("", "openim-export-*.html")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	a := &exportArchive{
+		zw:      zip.NewWriter(w),
+		html:    html,
+		htmlBuf: bufio.NewWriter(html),
+		files:   make(map[string]struct{}),
+		manifest: exportManifest{
+			JobID:      job.JobID,
+			TargetType: job.TargetType,
+			TargetID:   job.TargetID,
+			StartTime:  job.StartTime,
+			EndTime:    job.EndTime,
+			CreateTime: job.CreateTime.UnixMilli(),
+		},
+	}
+	jsonl, err := a.zw.Create("messages.jsonl")
+	if err != nil {
+		a.cleanup()
+		return nil, errs.Wrap(err)
+	}
+	a.jsonl = json.NewEncoder(jsonl)
+	if err := transcriptTemplate.ExecuteTemplate(a.htmlBuf, "head", "Chat history "+job.TargetID); err != nil {
+		a.cleanup()
+		return nil, errs.Wrap(err)
+	}
+	return a, nil
+}
+
+func (a *exportArchive) AddMsg(conversationID string, msg *relation.MsgInfoModel) error {
+	msgData := convert.MsgDB2Pb(msg.Msg)
+	if err := a.jsonl.Encode(&exportLine{ConversationID: conversationID, Revoked: msg.Revoke != nil, Msg: msgData}); err != nil {
+		return errs.Wrap(err)
+	}
+	if conversationID != a.lastConvID {
+		a.lastConvID = conversationID
+		if err := transcriptTemplate.ExecuteTemplate(a.htmlBuf, "conversation", conversationID); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	files := contentObjectNames(msg.Msg.Content)
+	for _, name := range files {
+		a.files[name] = struct{}{}
+	}
+	row := &transcriptMsg{
+		Time:   time.UnixMilli(msgData.SendTime).Format(time.RFC3339),
+		Sender: msgData.SenderNickname,
+		Text:   transcriptText(msgData, msg.Revoke != nil),
+		Files:  files,
+	}
+	if row.Sender == "" {
+		row.Sender = msgData.SendID
+	}
+	if err := transcriptTemplate.ExecuteTemplate(a.htmlBuf, "msg", row); err != nil {
+		return errs.Wrap(err)
+	}
+	a.manifest.MsgNum++
+	return nil
+}
+
+// FileNames returns the names of the objects referenced by the added msgs.
+func (a *exportArchive) FileNames() []string {
+	names := make([]string, 0, len(a.files))
+	for name := range a.files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WriteTranscript ends the msgs, it is called before the files are added.
+func (a *exportArchive) WriteTranscript() error {
+	if err := transcriptTemplate.ExecuteTemplate(a.htmlBuf, "tail", nil); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := a.htmlBuf.Flush(); err != nil {
+		return errs.Wrap(err)
+	}
+	if _, err := a.html.Seek(0, io.SeekStart); err != nil {
+		return errs.Wrap(err)
+	}
+	w, err := a.zw.Create("transcript.html")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	_, err = io.Copy(w, a.html)
+	return errs.Wrap(err)
+}
+
+func (a *exportArchive) AddFile(name string, r io.Reader) error {
+	w, err := a.zw.Create("files/" + name)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		return errs.Wrap(err)
+	}
+	a.manifest.FileNum++
+	return nil
+}
+
+func (a *exportArchive) SkipFile(name string, reason string) {
+	a.manifest.SkippedFiles = append(a.manifest.SkippedFiles, skippedFile{Name: name, Reason: reason})
+}
+
+// Close writes the manifest and ends the zip, the underlying writer is left open.
+func (a *exportArchive) Close() error {
+	defer a.cleanup()
+	w, err := a.zw.Create("manifest.json")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(&a.manifest); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(a.zw.Close())
+}
+
+func (a *exportArchive) cleanup() {
+	_ = a.html.Close()
+	_ = os.Remove(a.html.Name())
+}
+
+// contentObjectNames returns the names of the objects whose url appears in the msg content.
+func contentObjectNames(content string) []string {
+	var value any
+	if err := json.Unmarshal([]byte(content), &value); err != nil {
+		return nil
+	}
+	var names []string
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			for _, item := range v {
+				walk(item)
+			}
+		case []any:
+			for _, item := range v {
+				walk(item)
+			}
+		case string:
+			if name := objectNameFromURL(v); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	walk(value)
+	names = datautil.Distinct(names)
+	sort.Strings(names)
+	return names
+}
+
+func objectNameFromURL(rawURL string) string {
+	i := strings.LastIndex(rawURL, objectURLPath)
+	if i < 0 {
+		return ""
+	}
+	name := rawURL[i+len(objectURLPath):]
+	if j := strings.IndexAny(name, "?#"); j >= 0 {
+		name = name[:j]
+	}
+	name, err := url.PathUnescape(name)
+	if err != nil || name == "" || path.Clean("/"+name) != "/"+name {
+		return ""
+	}
+	return name
+}
+
+func transcriptText(msgData *sdkws.MsgData, revoked bool) string {
+	if revoked {
+		return "[revoked]"
+	}
+	if msgData.ContentType == constant.Text {
+		var text struct {
+			Content string `json:"content"`
+		}
+		if err := json.Unmarshal(msgData.Content, &text); err == nil {
+			return text.Content
+		}
+	}
+	return "[" + contentTypeName(msgData.ContentType) + "]"
+}
+
+func contentTypeName(contentType int32) string {
+	switch contentType {
+	case constant.Picture:
+		return "picture"
+	case constant.Voice:
+		return "voice"
+	case constant.Video:
+		return "video"
+	case constant.File:
+		return "file"
+	case constant.AtText:
+		return "at text"
+	case constant.Location:
+		return "location"
+	case constant.Card:
+		return "card"
+	case constant.Quote:
+		return "quote"
+	case constant.Merger:
+		return "merger"
+	case constant.Custom:
+		return "custom"
+	}
+	return "content type " + strconv.Itoa(int(contentType))
+}
@@ -0,0 +1,88 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package third
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/protocol/constant"
+)
+
+func TestContentObjectNames(t *testing.T) {
+	content := `{"sourcePicture":{"url":"http://127.0.0.1:10002/object/u1/a%20b.png?x=1"},"bigPicture":{"url":"http://127.0.0.1:10002/object/u1/a%20b.png"},"snapshotPicture":{"url":"http://127.0.0.1:10002/object/../etc/passwd"}}`
+	want := []string{"u1/a b.png"}
+	if got := contentObjectNames(content); !reflect.DeepEqual(got, want) {
+		t.Errorf("contentObjectNames() = %v, want %v", got, want)
+	}
+}
+
+func TestExportArchive(t *testing.T) {
+	job := &relation.ExportJobModel{JobID: "job1", TargetType: relation.ExportTargetConversation, TargetID: "si_u1_u2", CreateTime: time.Now()}
+	var buf bytes.Buffer
+	archive, err := newExportArchive(&buf, job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &relation.MsgInfoModel{Msg: &relation.MsgDataModel{SendID: "u1", ContentType: constant.Text, Content: `{"content":"<hello>"}`, Seq: 1}}
+	if err := archive.AddMsg(job.TargetID, msg); err != nil {
+		t.Fatal(err)
+	}
+	if err := archive.WriteTranscript(); err != nil {
+		t.Fatal(err)
+	}
+	if err := archive.AddFile("u1/a.png", strings.NewReader("png")); err != nil {
+		t.Fatal(err)
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]string)
+	for _, file := range zr.File {
+		r, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[file.Name] = string(data)
+	}
+	for _, name := range []string{"messages.jsonl", "transcript.html", "files/u1/a.png", "manifest.json"} {
+		if _, ok := files[name]; !ok {
+			t.Fatalf("%s is missing from the archive", name)
+		}
+	}
+	if !strings.Contains(files["messages.jsonl"], `"conversationID":"si_u1_u2"`) {
+		t.Errorf("messages.jsonl = %s", files["messages.jsonl"])
+	}
+	if !strings.Contains(files["transcript.html"], "&lt;hello&gt;") {
+		t.Errorf("transcript.html does not escape the content: %s", files["transcript.html"])
+	}
+	if !strings.Contains(files["manifest.json"], `"msgNum": 1`) {
+		t.Errorf("manifest.json = %s", files["manifest.json"])
+	}
+}
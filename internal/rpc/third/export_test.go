@@ -0,0 +1,81 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package third
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/protocol/constant"
+)
+
+type mockExportJobDatabase struct {
+	controller.ExportJobDatabase
+	begin, end int64
+	ranges     [][2]int64
+}
+
+func (m *mockExportJobDatabase) GetConversationDocIndexRange(ctx context.Context, conversationID string) (int64, int64, error) {
+	return m.begin, m.end, nil
+}
+
+func (m *mockExportJobDatabase) FindConversationDocs(ctx context.Context, conversationID string, begin int64, end int64, startTime int64, endTime int64) ([]*relation.MsgDocModel, error) {
+	m.ranges = append(m.ranges, [2]int64{begin, end})
+	var docs []*relation.MsgDocModel
+	for index := begin; index <= end; index++ {
+		// every other doc was deleted or has no msg within the time range
+		if index%2 == 1 {
+			continue
+		}
+		msg := &relation.MsgInfoModel{Msg: &relation.MsgDataModel{SendID: "u1", ContentType: constant.Text, Content: `{"content":"hi"}`, Seq: index*100 + 1}}
+		docs = append(docs, &relation.MsgDocModel{DocID: relation.MsgDocModel{}.GetDocIDByIndex(conversationID, index), Msg: []*relation.MsgInfoModel{msg, nil}})
+	}
+	return docs, nil
+}
+
+func TestExportConversation(t *testing.T) {
+	db := &mockExportJobDatabase{begin: 3, end: 25}
+	s := &thirdServer{exportJobDatabase: db}
+	job := &relation.ExportJobModel{JobID: "job1", TargetType: relation.ExportTargetConversation, TargetID: "si_u1_u2", CreateTime: time.Now()}
+	var buf bytes.Buffer
+	archive, err := newExportArchive(&buf, job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer archive.cleanup()
+	if err := s.exportConversation(context.Background(), archive, job, job.TargetID); err != nil {
+		t.Fatal(err)
+	}
+	if want := [][2]int64{{3, 12}, {13, 22}, {23, 25}}; !reflect.DeepEqual(db.ranges, want) {
+		t.Errorf("ranges = %v, want %v", db.ranges, want)
+	}
+	if archive.manifest.MsgNum != 11 {
+		t.Errorf("msgNum = %d, want 11", archive.manifest.MsgNum)
+	}
+
+	// a conversation without docs is not queried
+	db.begin, db.end, db.ranges = 0, -1, nil
+	if err := s.exportConversation(context.Background(), archive, job, job.TargetID); err != nil {
+		t.Fatal(err)
+	}
+	if len(db.ranges) != 0 {
+		t.Errorf("ranges = %v, want none", db.ranges)
+	}
+}
@@ -82,7 +82,7 @@ func (t *thirdServer) UploadLogs(ctx context.Context, req *third.UploadLogsReq)
 }
 
 func (t *thirdServer) DeleteLogs(ctx context.Context, req *third.DeleteLogsReq) (*third.DeleteLogsResp, error) {
-	if err := authverify.CheckAdmin(ctx, t.config.Share.IMAdminUserID); err != nil {
+	if err := authverify.CheckAdmin(ctx, t.config.Share.AdminUserIDs()); err != nil {
 		return nil, err
 	}
 	userID := ""
@@ -97,6 +97,15 @@ func (t *thirdServer) DeleteLogs(ctx context.Context, req *third.DeleteLogsReq)
 	if ids := datautil.Single(req.LogIDs, logIDs); len(ids) > 0 {
 		return nil, errs.ErrRecordNotFound.WrapMsg("logIDs not found", "logIDs", ids)
 	}
+	holds, err := t.legalHold.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, log := range logs {
+		if holdIDs := holds.UserHoldIDs(log.UserID); len(holdIDs) > 0 {
+			return nil, t.legalHold.Block(ctx, holdIDs, "DeleteLogs", log.UserID, "logID: "+log.LogID)
+		}
+	}
 	err = t.thirdDatabase.DeleteLogs(ctx, req.LogIDs, userID)
 	if err != nil {
 		return nil, err
@@ -123,7 +132,7 @@ func dbToPbLogInfos(logs []*relationtb.LogModel) []*third.LogInfo {
 }
 
 func (t *thirdServer) SearchLogs(ctx context.Context, req *third.SearchLogsReq) (*third.SearchLogsResp, error) {
-	if err := authverify.CheckAdmin(ctx, t.config.Share.IMAdminUserID); err != nil {
+	if err := authverify.CheckAdmin(ctx, t.config.Share.AdminUserIDs()); err != nil {
 		return nil, err
 	}
 	var (
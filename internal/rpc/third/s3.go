@@ -70,6 +70,9 @@ func (t *thirdServer) InitiateMultipartUpload(ctx context.Context, req *third.In
 				Group:       req.Cause,
 				CreateTime:  time.Now(),
 			}
+			if err := t.checkObjectHold(ctx, obj.Name); err != nil {
+				return nil, err
+			}
 			if err := t.s3dataBase.SetObject(ctx, obj); err != nil {
 				return nil, err
 			}
@@ -147,6 +150,9 @@ func (t *thirdServer) CompleteMultipartUpload(ctx context.Context, req *third.Co
 		Group:       req.Cause,
 		CreateTime:  time.Now(),
 	}
+	if err := t.checkObjectHold(ctx, obj.Name); err != nil {
+		return nil, err
+	}
 	if err := t.s3dataBase.SetObject(ctx, obj); err != nil {
 		return nil, err
 	}
@@ -273,12 +279,34 @@ func (t *thirdServer) CompleteFormData(ctx context.Context, req *third.CompleteF
 		Group:       mate.Group,
 		CreateTime:  time.Now(),
 	}
+	if err := t.checkObjectHold(ctx, obj.Name); err != nil {
+		return nil, err
+	}
 	if err := t.s3dataBase.SetObject(ctx, obj); err != nil {
 		return nil, err
 	}
 	return &third.CompleteFormDataResp{Url: t.apiAddress(req.UrlPrefix, mate.Name)}, nil
 }
 
+// checkObjectHold refuses to replace the object of name when its owner is under legal hold.
+func (t *thirdServer) checkObjectHold(ctx context.Context, name string) error {
+	obj, err := t.s3dataBase.TakeObject(ctx, name)
+	if err != nil {
+		if relation.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	holds, err := t.legalHold.Load(ctx)
+	if err != nil {
+		return err
+	}
+	if holdIDs := holds.UserHoldIDs(obj.UserID); len(holdIDs) > 0 {
+		return t.legalHold.Block(ctx, holdIDs, "OverwriteObject", obj.UserID, "name: "+name)
+	}
+	return nil
+}
+
 func (t *thirdServer) apiAddress(prefix, name string) string {
 	return prefix + name
 }
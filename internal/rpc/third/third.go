@@ -22,11 +22,12 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/dbconn"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/legalhold"
+	pbexportjob "github.com/openimsdk/open-im-server/v3/pkg/protocol/exportjob"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/third"
-	"github.com/openimsdk/tools/db/mongoutil"
-	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/s3"
 	"github.com/openimsdk/tools/s3/cos"
@@ -36,11 +37,15 @@ import (
 )
 
 type thirdServer struct {
-	thirdDatabase controller.ThirdDatabase
-	s3dataBase    controller.S3Database
-	userRpcClient rpcclient.UserRpcClient
-	defaultExpire time.Duration
-	config        *Config
+	pbexportjob.UnimplementedExportJobServiceServer
+	thirdDatabase         controller.ThirdDatabase
+	s3dataBase            controller.S3Database
+	exportJobDatabase     controller.ExportJobDatabase
+	userRpcClient         rpcclient.UserRpcClient
+	conversationRpcClient rpcclient.ConversationRpcClient
+	defaultExpire         time.Duration
+	config                *Config
+	legalHold             *legalhold.Checker
 }
 type Config struct {
 	RpcConfig          config.Third
@@ -54,11 +59,11 @@ type Config struct {
 }
 
 func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
-	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
+	mgocli, err := dbconn.NewMongoDB(ctx, config.MongodbConfig.Build())
 	if err != nil {
 		return err
 	}
-	rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
+	rdb, err := dbconn.NewRedisClient(ctx, config.RedisConfig.Build())
 	if err != nil {
 		return err
 	}
@@ -70,6 +75,22 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	legalHoldModel, err := mgo.NewLegalHoldMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	legalHoldAuditModel, err := mgo.NewLegalHoldAuditMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	exportJobModel, err := mgo.NewExportJobMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	msgDocModel, err := mgo.NewMsgMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 	// Select the oss method according to the profile policy
 	enable := config.RpcConfig.Object.Enable
 	var o s3.Interface
@@ -87,13 +108,21 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		return err
 	}
 	cache.InitLocalCache(&config.LocalCacheConfig)
-	third.RegisterThirdServer(server, &thirdServer{
-		thirdDatabase: controller.NewThirdDatabase(cache.NewThirdCache(rdb), logdb),
-		userRpcClient: rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID),
-		s3dataBase:    controller.NewS3Database(rdb, o, s3db),
-		defaultExpire: time.Hour * 24 * 7,
-		config:        config,
-	})
+	s := &thirdServer{
+		thirdDatabase:         controller.NewThirdDatabase(cache.NewThirdCache(rdb), logdb),
+		userRpcClient:         rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User, config.Share.AdminUserIDs),
+		conversationRpcClient: rpcclient.NewConversationRpcClient(client, config.Share.RpcRegisterName.Conversation),
+		s3dataBase:            controller.NewS3Database(rdb, o, s3db),
+		exportJobDatabase:     controller.NewExportJobDatabase(exportJobModel, msgDocModel),
+		defaultExpire:         time.Hour * 24 * 7,
+		config:                config,
+		legalHold:             legalhold.NewChecker(controller.NewLegalHoldDatabase(legalHoldModel, legalHoldAuditModel), nil),
+	}
+	third.RegisterThirdServer(server, s)
+	pbexportjob.RegisterExportJobServiceServer(server, s)
+	if config.RpcConfig.Export.Enable {
+		go s.runExportJobs(ctx)
+	}
 	return nil
 }
 
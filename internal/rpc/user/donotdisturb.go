@@ -0,0 +1,48 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	pbdonotdisturb "github.com/openimsdk/open-im-server/v3/pkg/protocol/donotdisturb"
+)
+
+func (s *userServer) SetDoNotDisturb(ctx context.Context, req *pbdonotdisturb.SetDoNotDisturbReq) (*pbdonotdisturb.SetDoNotDisturbResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	if _, err := s.db.FindWithError(ctx, []string{req.UserID}); err != nil {
+		return nil, err
+	}
+	if err := s.db.SetUserDoNotDisturb(ctx, req.UserID, convert.DoNotDisturbPb2DB(req.DoNotDisturb)); err != nil {
+		return nil, err
+	}
+	return &pbdonotdisturb.SetDoNotDisturbResp{}, nil
+}
+
+// GetDoNotDisturb is called by push for every offline receiver as the admin, see rpcclient.GetUserDoNotDisturb.
+func (s *userServer) GetDoNotDisturb(ctx context.Context, req *pbdonotdisturb.GetDoNotDisturbReq) (*pbdonotdisturb.GetDoNotDisturbResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.AdminUserIDs()); err != nil {
+		return nil, err
+	}
+	doNotDisturb, err := s.db.GetUserDoNotDisturb(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &pbdonotdisturb.GetDoNotDisturbResp{DoNotDisturb: convert.DoNotDisturbDB2Pb(doNotDisturb)}, nil
+}
@@ -19,6 +19,10 @@ import (
 	"fmt"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	kdisc "github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgdestruct"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgretention"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgschedule"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/mw"
@@ -47,7 +51,7 @@ func Start(ctx context.Context, config *CronTaskConfig) error {
 	if err != nil {
 		return errs.WrapMsg(err, "failed to register discovery service")
 	}
-	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	client.AddOption(mw.GrpcClient(), tracing.GrpcDialOption(), grpc.WithTransportCredentials(insecure.NewCredentials()))
 	ctx = mcontext.SetOpUserID(ctx, config.Share.IMAdminUserID[0])
 	conn, err := client.GetConn(ctx, config.Share.RpcRegisterName.Msg)
 	if err != nil {
@@ -69,7 +73,65 @@ func Start(ctx context.Context, config *CronTaskConfig) error {
 	if _, err := crontab.AddFunc(config.CronTask.ChatRecordsClearTime, clearFunc); err != nil {
 		return errs.Wrap(err)
 	}
-	log.ZInfo(ctx, "start cron task", "chatRecordsClearTime", config.CronTask.ChatRecordsClearTime)
+	if dispatch := config.CronTask.ScheduledMsgDispatch; dispatch.Enable {
+		scheduleCli := msgschedule.NewMsgScheduleClient(conn)
+		dispatchFunc := func() {
+			now := time.Now()
+			ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("cron_dispatch_%d_%d", os.Getpid(), now.UnixMilli()))
+			resp, err := scheduleCli.DispatchScheduledMsgs(ctx, &msgschedule.DispatchScheduledMsgsReq{Limit: int32(dispatch.Limit)})
+			if err != nil {
+				log.ZError(ctx, "cron dispatch scheduled msgs failed", err, "cont", time.Since(now))
+				return
+			}
+			if resp.Sent > 0 || resp.Failed > 0 {
+				log.ZInfo(ctx, "cron dispatch scheduled msgs success", "sent", resp.Sent, "failed", resp.Failed, "cont", time.Since(now))
+			}
+		}
+		if _, err := crontab.AddFunc(dispatch.Time, dispatchFunc); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	if retention := config.CronTask.RetentionPolicy; retention.Enable {
+		retentionCli := msgretention.NewMsgRetentionClient(conn)
+		retentionFunc := func() {
+			now := time.Now()
+			ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("cron_retention_%d_%d", os.Getpid(), now.UnixMilli()))
+			resp, err := retentionCli.ApplyRetentionPolicies(ctx, &msgretention.ApplyRetentionPoliciesReq{})
+			if err != nil {
+				log.ZError(ctx, "cron apply retention policies failed", err, "cont", time.Since(now))
+				return
+			}
+			for _, purged := range resp.Purged {
+				log.ZInfo(ctx, "cron retention purged", "conversationID", purged.ConversationID, "contentType", purged.ContentType, "docNum", purged.DocNum, "msgNum", purged.MsgNum)
+			}
+			log.ZInfo(ctx, "cron apply retention policies success", "msgNum", resp.MsgNum, "cont", time.Since(now))
+		}
+		if _, err := crontab.AddFunc(retention.Time, retentionFunc); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	if destruct := config.CronTask.MsgDestruct; destruct.Enable {
+		destructCli := msgdestruct.NewMsgDestructClient(conn)
+		destructFunc := func() {
+			now := time.Now()
+			ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("cron_destruct_%d_%d", os.Getpid(), now.UnixMilli()))
+			resp, err := destructCli.DestructMsgs(ctx, &msgdestruct.DestructMsgsReq{BurnLimit: int32(destruct.BurnLimit)})
+			if err != nil {
+				log.ZError(ctx, "cron destruct msgs failed", err, "cont", time.Since(now))
+				return
+			}
+			if resp.BurnMsgNum > 0 || resp.DestructConversationNum > 0 {
+				log.ZInfo(ctx, "cron destruct msgs success", "burnMsgNum", resp.BurnMsgNum, "destructConversationNum", resp.DestructConversationNum,
+					"destructMsgNum", resp.DestructMsgNum, "cont", time.Since(now))
+			}
+		}
+		if _, err := crontab.AddFunc(destruct.Time, destructFunc); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	log.ZInfo(ctx, "start cron task", "chatRecordsClearTime", config.CronTask.ChatRecordsClearTime,
+		"scheduledMsgDispatch", config.CronTask.ScheduledMsgDispatch, "retentionPolicy", config.CronTask.RetentionPolicy,
+		"msgDestruct", config.CronTask.MsgDestruct)
 	crontab.Start()
 	<-ctx.Done()
 	return nil
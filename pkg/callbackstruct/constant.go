@@ -59,4 +59,6 @@ const (
 	CallbackBeforeMemberJoinGroupCommand    = "callbackBeforeMemberJoinGroupCommand"
 	CallbackBeforeSetGroupMemberInfoCommand = "callbackBeforeSetGroupMemberInfoCommand"
 	CallbackAfterSetGroupMemberInfoCommand  = "callbackAfterSetGroupMemberInfoCommand"
+	CallbackBeforeEditMsgCommand            = "callbackBeforeEditMsgCommand"
+	CallbackAfterEditMsgCommand             = "callbackAfterEditMsgCommand"
 )
@@ -0,0 +1,50 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package callbackstruct
+
+type CallbackBeforeEditMsgReq struct {
+	CallbackCommand `json:"callbackCommand"`
+	ConversationID  string `json:"conversationID"`
+	Seq             int64  `json:"seq"`
+	ClientMsgID     string `json:"clientMsgID"`
+	SendID          string `json:"sendID"`
+	RecvID          string `json:"recvID"`
+	GroupID         string `json:"groupID"`
+	SessionType     int32  `json:"sessionType"`
+	ContentType     int32  `json:"contentType"`
+	OldContent      string `json:"oldContent"`
+	Content         string `json:"content"`
+}
+
+type CallbackBeforeEditMsgResp struct {
+	CommonCallbackResp
+	Content *string `json:"content"`
+}
+
+type CallbackAfterEditMsgReq struct {
+	CallbackCommand `json:"callbackCommand"`
+	ConversationID  string `json:"conversationID"`
+	Seq             int64  `json:"seq"`
+	ClientMsgID     string `json:"clientMsgID"`
+	SendID          string `json:"sendID"`
+	SessionType     int32  `json:"sessionType"`
+	ContentType     int32  `json:"contentType"`
+	Content         string `json:"content"`
+	EditTime        int64  `json:"editTime"`
+}
+
+type CallbackAfterEditMsgResp struct {
+	CommonCallbackResp
+}
@@ -17,6 +17,7 @@ package cachekey
 const (
 	UserInfoKey             = "USER_INFO:"
 	UserGlobalRecvMsgOptKey = "USER_GLOBAL_RECV_MSG_OPT_KEY:"
+	UserDoNotDisturbKey     = "USER_DO_NOT_DISTURB:"
 )
 
 func GetUserInfoKey(userID string) string {
@@ -26,3 +27,7 @@ func GetUserInfoKey(userID string) string {
 func GetUserGlobalRecvMsgOptKey(userID string) string {
 	return UserGlobalRecvMsgOptKey + userID
 }
+
+func GetUserDoNotDisturbKey(userID string) string {
+	return UserDoNotDisturbKey + userID
+}
@@ -0,0 +1,111 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/system/program"
+	"github.com/spf13/cobra"
+)
+
+// standaloneEnv is the discovery the services of openim-allinone find each other with,
+// they call each other over in-memory grpc connections.
+const standaloneEnv = "standalone"
+
+type AllInOneCmd struct {
+	*RootCmd
+	ctx context.Context
+}
+
+type allInOneService struct {
+	name string
+	cmd  *RootCmd
+}
+
+func NewAllInOneCmd() *AllInOneCmd {
+	ret := &AllInOneCmd{RootCmd: NewRootCmd(program.GetProcessName())}
+	ret.ctx = context.WithValue(context.Background(), "version", config.Version)
+	ret.Command.RunE = func(cmd *cobra.Command, args []string) error {
+		return ret.runE(cmd)
+	}
+	return ret
+}
+
+func (a *AllInOneCmd) Exec() error {
+	return a.Execute()
+}
+
+// runE starts every service with the same config directory and index, the rpc services first.
+// It returns when all of them stopped, or with the first error.
+func (a *AllInOneCmd) runE(cmd *cobra.Command) error {
+	configDirectory, index, err := a.getFlag(cmd)
+	if err != nil {
+		return err
+	}
+	// every service loads share.yml by itself, the env variable makes all of them use the standalone discovery
+	if err := os.Setenv(ConfigEnvPrefixMap[ShareFileName]+"_ENV", standaloneEnv); err != nil {
+		return errs.WrapMsg(err, "set env failed")
+	}
+	services := []allInOneService{
+		{"openim-rpc-auth", NewAuthRpcCmd().RootCmd},
+		{"openim-rpc-user", NewUserRpcCmd().RootCmd},
+		{"openim-rpc-friend", NewFriendRpcCmd().RootCmd},
+		{"openim-rpc-group", NewGroupRpcCmd().RootCmd},
+		{"openim-rpc-conversation", NewConversationRpcCmd().RootCmd},
+		{"openim-rpc-msg", NewMsgRpcCmd().RootCmd},
+		{"openim-rpc-third", NewThirdRpcCmd().RootCmd},
+		{"openim-push", NewPushRpcCmd().RootCmd},
+		{"openim-msggateway", NewMsgGatewayCmd().RootCmd},
+		{"openim-msgtransfer", NewMsgTransferCmd().RootCmd},
+		{"openim-api", NewApiCmd().RootCmd},
+		{"openim-crontask", NewCronTaskCmd().RootCmd},
+	}
+	args := []string{"--" + FlagConf, configDirectory, "--" + FlagTransferIndex, strconv.Itoa(index)}
+	errCh := make(chan error, len(services))
+	for _, service := range services {
+		if err := service.cmd.prepare(args); err != nil {
+			return errs.WrapMsg(err, "prepare service failed", "service", service.name)
+		}
+		go func(service allInOneService) {
+			err := service.cmd.Command.RunE(&service.cmd.Command, nil)
+			if err != nil {
+				err = errs.WrapMsg(err, "service stopped", "service", service.name)
+			}
+			errCh <- err
+		}(service)
+		log.ZInfo(a.ctx, "service started", "service", service.name)
+	}
+	for range services {
+		if err := <-errCh; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prepare parses args and loads the config and the logger of the command the way Execute does,
+// so the services are prepared one at a time before they run concurrently.
+func (r *RootCmd) prepare(args []string) error {
+	if err := r.Command.ParseFlags(args); err != nil {
+		return errs.Wrap(err)
+	}
+	return r.Command.PersistentPreRunE(&r.Command, nil)
+}
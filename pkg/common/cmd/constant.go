@@ -24,6 +24,7 @@ var (
 	ShareFileName                    string
 	WebhooksConfigFileName           string
 	LocalCacheConfigFileName         string
+	SearchConfigFileName             string
 	KafkaConfigFileName              string
 	RedisConfigFileName              string
 	ZookeeperConfigFileName          string
@@ -52,6 +53,7 @@ func init() {
 	ShareFileName = "share.yml"
 	WebhooksConfigFileName = "webhooks.yml"
 	LocalCacheConfigFileName = "local-cache.yml"
+	SearchConfigFileName = "search.yml"
 	KafkaConfigFileName = "kafka.yml"
 	RedisConfigFileName = "redis.yml"
 	ZookeeperConfigFileName = "zookeeper.yml"
@@ -73,7 +75,7 @@ func init() {
 
 	ConfigEnvPrefixMap = make(map[string]string)
 	fileNames := []string{
-		FileName, NotificationFileName, ShareFileName, WebhooksConfigFileName,
+		FileName, NotificationFileName, ShareFileName, WebhooksConfigFileName, SearchConfigFileName,
 		KafkaConfigFileName, RedisConfigFileName, ZookeeperConfigFileName,
 		MongodbConfigFileName, MinioConfigFileName, LogConfigFileName,
 		OpenIMAPICfgFileName, OpenIMCronTaskCfgFileName, OpenIMMsgGatewayCfgFileName,
@@ -0,0 +1,102 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/log"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// pkgLogger is the logger behind log.ZDebug and the other package functions of openimsdk/tools, it
+// has no setter and its level is fixed when it is built.
+//
+//go:linkname pkgLogger github.com/openimsdk/tools/log.pkgLogger
+var pkgLogger log.Logger
+
+// remainLogLevel maps remainLogLevel of log.yml to the zap level, as the logger of openimsdk/tools does.
+var remainLogLevel = map[int]zapcore.Level{
+	6: zapcore.DebugLevel,
+	5: zapcore.DebugLevel,
+	4: zapcore.InfoLevel,
+	3: zapcore.WarnLevel,
+	2: zapcore.ErrorLevel,
+	1: zapcore.FatalLevel,
+	0: zapcore.PanicLevel,
+}
+
+// debugLogLevel builds the logger of openimsdk/tools once at the most verbose level, levelLogger in
+// front of it filters by a level that log.yml reloads.
+const debugLogLevel = 6
+
+// levelLogger drops the logs below level before they reach the wrapped logger.
+type levelLogger struct {
+	log.Logger
+	level zap.AtomicLevel
+}
+
+// setLogLevel wraps the logger built by log.InitFromConfig, so it logs at remainLogLevel from now on
+// and at the level of log.yml every time log.yml is reloaded in place of logConfig.
+func setLogLevel(logConfig *config.Log) {
+	if pkgLogger == nil {
+		return
+	}
+	level := zap.NewAtomicLevelAt(remainLogLevel[logConfig.RemainLogLevel])
+	// the wrapper adds a frame between the caller and zap
+	pkgLogger = &levelLogger{Logger: pkgLogger.WithCallDepth(1), level: level}
+	logConfig.OnReload(func(logConfig *config.Log) {
+		level.SetLevel(remainLogLevel[logConfig.RemainLogLevel])
+	})
+}
+
+func (l *levelLogger) Debug(ctx context.Context, msg string, keysAndValues ...any) {
+	if l.level.Enabled(zapcore.DebugLevel) {
+		l.Logger.Debug(ctx, msg, keysAndValues...)
+	}
+}
+
+func (l *levelLogger) Info(ctx context.Context, msg string, keysAndValues ...any) {
+	if l.level.Enabled(zapcore.InfoLevel) {
+		l.Logger.Info(ctx, msg, keysAndValues...)
+	}
+}
+
+func (l *levelLogger) Warn(ctx context.Context, msg string, err error, keysAndValues ...any) {
+	if l.level.Enabled(zapcore.WarnLevel) {
+		l.Logger.Warn(ctx, msg, err, keysAndValues...)
+	}
+}
+
+func (l *levelLogger) Error(ctx context.Context, msg string, err error, keysAndValues ...any) {
+	if l.level.Enabled(zapcore.ErrorLevel) {
+		l.Logger.Error(ctx, msg, err, keysAndValues...)
+	}
+}
+
+func (l *levelLogger) WithValues(keysAndValues ...any) log.Logger {
+	return &levelLogger{Logger: l.Logger.WithValues(keysAndValues...), level: l.level}
+}
+
+func (l *levelLogger) WithName(name string) log.Logger {
+	return &levelLogger{Logger: l.Logger.WithName(name), level: l.level}
+}
+
+func (l *levelLogger) WithCallDepth(depth int) log.Logger {
+	return &levelLogger{Logger: l.Logger.WithCallDepth(depth), level: l.level}
+}
@@ -0,0 +1,78 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/log"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordLogger struct {
+	log.Logger
+	msgs *[]string
+}
+
+func (l *recordLogger) Debug(ctx context.Context, msg string, keysAndValues ...any) {
+	*l.msgs = append(*l.msgs, msg)
+}
+
+func (l *recordLogger) Info(ctx context.Context, msg string, keysAndValues ...any) {
+	*l.msgs = append(*l.msgs, msg)
+}
+
+func (l *recordLogger) Warn(ctx context.Context, msg string, err error, keysAndValues ...any) {
+	*l.msgs = append(*l.msgs, msg)
+}
+
+func (l *recordLogger) WithCallDepth(depth int) log.Logger {
+	return l
+}
+
+func TestSetLogLevel(t *testing.T) {
+	defer func(logger log.Logger) { pkgLogger = logger }(pkgLogger)
+	var msgs []string
+	pkgLogger = &recordLogger{msgs: &msgs}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, LogConfigFileName)
+	assert.Nil(t, os.WriteFile(path, []byte("remainLogLevel: 4\n"), 0644))
+	var logConfig config.Log
+	assert.Nil(t, config.LoadConfig(path, ConfigEnvPrefixMap[LogConfigFileName], &logConfig))
+	setLogLevel(&logConfig)
+
+	ctx := context.Background()
+	log.ZDebug(ctx, "debug")
+	log.ZInfo(ctx, "info")
+	assert.Equal(t, []string{"info"}, msgs)
+
+	w := config.NewWatcher(dir, time.Hour, nil)
+	assert.Nil(t, w.Add(LogConfigFileName, ConfigEnvPrefixMap[LogConfigFileName], &logConfig))
+	assert.Nil(t, os.WriteFile(path, []byte("remainLogLevel: 3\n"), 0644))
+	// Run checks the files once before it waits for ctx
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	w.Run(cancelled)
+	msgs = nil
+	log.ZInfo(ctx, "info")
+	log.ZWarn(ctx, "warn", nil)
+	assert.Equal(t, []string{"warn"}, msgs)
+}
@@ -43,6 +43,7 @@ func NewMsgRpcCmd() *MsgRpcCmd {
 		NotificationFileName:     &msgConfig.NotificationConfig,
 		WebhooksConfigFileName:   &msgConfig.WebhooksConfig,
 		LocalCacheConfigFileName: &msgConfig.LocalCacheConfig,
+		SearchConfigFileName:     &msgConfig.SearchConfig,
 	}
 	ret.RootCmd = NewRootCmd(program.GetProcessName(), WithConfigMap(ret.configMap))
 	ret.ctx = context.WithValue(context.Background(), "version", config.Version)
@@ -40,6 +40,7 @@ func NewMsgTransferCmd() *MsgTransferCmd {
 		ZookeeperConfigFileName:      &msgTransferConfig.ZookeeperConfig,
 		ShareFileName:                &msgTransferConfig.Share,
 		WebhooksConfigFileName:       &msgTransferConfig.WebhooksConfig,
+		SearchConfigFileName:         &msgTransferConfig.SearchConfig,
 	}
 	ret.RootCmd = NewRootCmd(program.GetProcessName(), WithConfigMap(ret.configMap))
 	ret.ctx = context.WithValue(context.Background(), "version", config.Version)
@@ -15,10 +15,14 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	kdisc "github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 	"github.com/spf13/cobra"
@@ -87,10 +91,20 @@ func (r *RootCmd) persistentPreRun(cmd *cobra.Command, opts ...func(*CmdOpts)) e
 		return err
 	}
 
-	if err := r.initializeLogger(cmdOpts); err != nil {
+	if err := r.initializeLogger(cmdOpts, &r.log); err != nil {
 		return errs.WrapMsg(err, "failed to initialize logger")
 	}
 
+	if err := r.initializeConfigWatcher(cmd, cmdOpts); err != nil {
+		return errs.WrapMsg(err, "failed to initialize config watcher")
+	}
+
+	if share, ok := cmdOpts.configMap[ShareFileName].(*config.Share); ok {
+		if err := tracing.Init(context.Background(), r.processName, &share.Tracing); err != nil {
+			return errs.WrapMsg(err, "failed to initialize tracing")
+		}
+	}
+
 	return nil
 }
 
@@ -122,26 +136,80 @@ func (r *RootCmd) applyOptions(opts ...func(*CmdOpts)) *CmdOpts {
 	return cmdOpts
 }
 
-func (r *RootCmd) initializeLogger(cmdOpts *CmdOpts) error {
+func (r *RootCmd) initializeLogger(cmdOpts *CmdOpts, logConfig *config.Log) error {
 	err := log.InitFromConfig(
-
 		cmdOpts.loggerPrefixName,
 		r.processName,
-		r.log.RemainLogLevel,
-		r.log.IsStdout,
-		r.log.IsJson,
-		r.log.StorageLocation,
-		r.log.RemainRotationCount,
-		r.log.RotationTime,
+		debugLogLevel,
+		logConfig.IsStdout,
+		logConfig.IsJson,
+		logConfig.StorageLocation,
+		logConfig.RemainRotationCount,
+		logConfig.RotationTime,
 		config.Version,
 	)
 	if err != nil {
 		return errs.Wrap(err)
 	}
-	return errs.Wrap(log.InitConsoleLogger(r.processName, r.log.RemainLogLevel, r.log.IsJson, config.Version))
+	setLogLevel(logConfig)
+	return errs.Wrap(log.InitConsoleLogger(r.processName, logConfig.RemainLogLevel, logConfig.IsJson, config.Version))
 
 }
 
+// initializeConfigWatcher reloads the reloadable files while the service runs. The files the service
+// does not load are watched too, so every service reports the same config version.
+func (r *RootCmd) initializeConfigWatcher(cmd *cobra.Command, cmdOpts *CmdOpts) error {
+	share, ok := cmdOpts.configMap[ShareFileName].(*config.Share)
+	if !ok || !share.ConfigReload.Enable {
+		return nil
+	}
+	configDirectory, _, err := r.getFlag(cmd)
+	if err != nil {
+		return err
+	}
+	var getConf func(key string) ([]byte, error)
+	if share.ConfigReload.Registry {
+		zookeeperConfig, ok := cmdOpts.configMap[ZookeeperConfigFileName].(*config.ZooKeeper)
+		if !ok {
+			zookeeperConfig = new(config.ZooKeeper)
+			if err := config.LoadConfig(filepath.Join(configDirectory, ZookeeperConfigFileName),
+				ConfigEnvPrefixMap[ZookeeperConfigFileName], zookeeperConfig); err != nil {
+				return err
+			}
+		}
+		client, err := kdisc.NewDiscoveryRegister(zookeeperConfig, share)
+		if err != nil {
+			return err
+		}
+		getConf = client.GetConfFromRegistry
+	}
+	if share.ConfigReload.Interval <= 0 {
+		return errs.New("configReload interval must be positive", "interval", share.ConfigReload.Interval).Wrap()
+	}
+	watcher := config.NewWatcher(configDirectory, time.Duration(share.ConfigReload.Interval)*time.Second, getConf)
+	reloadable := map[string]any{
+		WebhooksConfigFileName:   new(config.Webhooks),
+		LocalCacheConfigFileName: new(config.LocalCache),
+		NotificationFileName:     new(config.Notification),
+		ShareFileName:            share,
+		LogConfigFileName:        &r.log,
+	}
+	for fileName, section := range reloadable {
+		if loaded, ok := cmdOpts.configMap[fileName]; ok {
+			section = loaded
+		} else if fileName != LogConfigFileName {
+			if err := config.LoadConfig(filepath.Join(configDirectory, fileName), ConfigEnvPrefixMap[fileName], section); err != nil {
+				return err
+			}
+		}
+		if err := watcher.Add(fileName, ConfigEnvPrefixMap[fileName], section); err != nil {
+			return err
+		}
+	}
+	go watcher.Run(context.Background())
+	return nil
+}
+
 func defaultCmdOpts() *CmdOpts {
 	return &CmdOpts{
 		loggerPrefixName: "openim-service-log",
@@ -162,5 +230,12 @@ func (r *RootCmd) getFlag(cmd *cobra.Command) (string, int, error) {
 }
 
 func (r *RootCmd) Execute() error {
-	return r.Command.Execute()
+	err := r.Command.Execute()
+	// export the spans of the last requests before the process exits
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := tracing.Shutdown(ctx); err != nil {
+		log.ZWarn(ctx, "shutdown tracing failed", err)
+	}
+	return err
 }
@@ -107,6 +107,20 @@ type API struct {
 type CronTask struct {
 	ChatRecordsClearTime string `mapstructure:"chatRecordsClearTime"`
 	RetainChatRecords    int    `mapstructure:"retainChatRecords"`
+	ScheduledMsgDispatch struct {
+		Enable bool   `mapstructure:"enable"`
+		Time   string `mapstructure:"time"`
+		Limit  int    `mapstructure:"limit"`
+	} `mapstructure:"scheduledMsgDispatch"`
+	RetentionPolicy struct {
+		Enable bool   `mapstructure:"enable"`
+		Time   string `mapstructure:"time"`
+	} `mapstructure:"retentionPolicy"`
+	MsgDestruct struct {
+		Enable    bool   `mapstructure:"enable"`
+		Time      string `mapstructure:"time"`
+		BurnLimit int    `mapstructure:"burnLimit"`
+	} `mapstructure:"msgDestruct"`
 }
 
 type OfflinePushConfig struct {
@@ -176,10 +190,40 @@ type MsgGateway struct {
 		WebsocketMaxConnNum int   `mapstructure:"websocketMaxConnNum"`
 		WebsocketMaxMsgLen  int   `mapstructure:"websocketMaxMsgLen"`
 		WebsocketTimeout    int   `mapstructure:"websocketTimeout"`
+		Compression         struct {
+			Enable    bool `mapstructure:"enable"`
+			Level     int  `mapstructure:"level"`
+			Threshold int  `mapstructure:"threshold"`
+		} `mapstructure:"compression"`
+		Drain struct {
+			BatchSize int `mapstructure:"batchSize"`
+			Interval  int `mapstructure:"interval"`
+			Deadline  int `mapstructure:"deadline"`
+		} `mapstructure:"drain"`
 	} `mapstructure:"longConnSvr"`
 	MultiLoginPolicy int `mapstructure:"multiLoginPolicy"`
 }
 
+type Search struct {
+	Enable string `mapstructure:"enable"`
+	Bleve  struct {
+		Path string `mapstructure:"path"`
+	} `mapstructure:"bleve"`
+	OpenSearch struct {
+		Address  []string `mapstructure:"address"`
+		Username string   `mapstructure:"username"`
+		Password string   `mapstructure:"password"`
+		Index    string   `mapstructure:"index"`
+		Timeout  int      `mapstructure:"timeout"`
+	} `mapstructure:"opensearch"`
+	Backfill struct {
+		Interval   int `mapstructure:"interval"`
+		Backoff    int `mapstructure:"backoff"`
+		MaxBackoff int `mapstructure:"maxBackoff"`
+		BatchSize  int `mapstructure:"batchSize"`
+	} `mapstructure:"backfill"`
+}
+
 type MsgTransfer struct {
 	Prometheus Prometheus `mapstructure:"prometheus"`
 }
@@ -193,7 +237,11 @@ type Push struct {
 	Prometheus           Prometheus `mapstructure:"prometheus"`
 	MaxConcurrentWorkers int        `mapstructure:"maxConcurrentWorkers"`
 	Enable               string     `mapstructure:"enable"`
-	GeTui                struct {
+	Routes               []struct {
+		PlatformIDs []int  `mapstructure:"platformIDs"`
+		Enable      string `mapstructure:"enable"`
+	} `mapstructure:"routes"`
+	GeTui struct {
 		PushUrl      string `mapstructure:"pushUrl"`
 		MasterSecret string `mapstructure:"masterSecret"`
 		AppKey       string `mapstructure:"appKey"`
@@ -210,6 +258,36 @@ type Push struct {
 		PushURL      string `mapstructure:"pushURL"`
 		PushIntent   string `mapstructure:"pushIntent"`
 	} `mapstructure:"jpns"`
+	APNs struct {
+		KeyFile  string `mapstructure:"keyFile"`
+		KeyID    string `mapstructure:"keyID"`
+		TeamID   string `mapstructure:"teamID"`
+		BundleID string `mapstructure:"bundleID"`
+	} `mapstructure:"apns"`
+	Webhook struct {
+		URL        string `mapstructure:"url"`
+		Secret     string `mapstructure:"secret"`
+		Timeout    int    `mapstructure:"timeout"`
+		RetryTimes int    `mapstructure:"retryTimes"`
+		BatchSize  int    `mapstructure:"batchSize"`
+	} `mapstructure:"webhook"`
+	Retry struct {
+		Enable      bool `mapstructure:"enable"`
+		MaxAttempts int  `mapstructure:"maxAttempts"`
+		Backoff     int  `mapstructure:"backoff"`
+		MaxBackoff  int  `mapstructure:"maxBackoff"`
+		Interval    int  `mapstructure:"interval"`
+		BatchSize   int  `mapstructure:"batchSize"`
+		Lease       int  `mapstructure:"lease"`
+	} `mapstructure:"retry"`
+	Aggregation struct {
+		Enable        bool `mapstructure:"enable"`
+		Window        int  `mapstructure:"window"`
+		Conversations []struct {
+			ConversationIDs []string `mapstructure:"conversationIDs"`
+			Window          int      `mapstructure:"window"`
+		} `mapstructure:"conversations"`
+	} `mapstructure:"aggregation"`
 	IOSPush struct {
 		PushSound  string `mapstructure:"pushSound"`
 		BadgeCount bool   `mapstructure:"badgeCount"`
@@ -264,6 +342,24 @@ type Msg struct {
 	} `mapstructure:"rpc"`
 	Prometheus   Prometheus `mapstructure:"prometheus"`
 	FriendVerify bool       `mapstructure:"friendVerify"`
+	EditMsg      struct {
+		Enable     bool `mapstructure:"enable"`
+		Window     int  `mapstructure:"window"`
+		MaxHistory int  `mapstructure:"maxHistory"`
+	} `mapstructure:"editMsg"`
+	ScheduleMsg struct {
+		Enable          bool `mapstructure:"enable"`
+		MaxDelay        int  `mapstructure:"maxDelay"`
+		DispatchTimeout int  `mapstructure:"dispatchTimeout"`
+	} `mapstructure:"scheduleMsg"`
+	GroupReadReceipt struct {
+		Enable              bool `mapstructure:"enable"`
+		MaxNotifyMsgs       int  `mapstructure:"maxNotifyMsgs"`
+		MaxGroupMemberCount int  `mapstructure:"maxGroupMemberCount"`
+	} `mapstructure:"groupReadReceipt"`
+	Reaction struct {
+		MaxPerUser int `mapstructure:"maxPerUser"`
+	} `mapstructure:"reaction"`
 }
 
 type Third struct {
@@ -295,6 +391,13 @@ type Third struct {
 			PublicRead      bool   `mapstructure:"publicRead"`
 		} `mapstructure:"aws"`
 	} `mapstructure:"object"`
+	Export struct {
+		Enable       bool  `mapstructure:"enable"`
+		PollInterval int   `mapstructure:"pollInterval"`
+		URLExpire    int   `mapstructure:"urlExpire"`
+		MaxFileSize  int64 `mapstructure:"maxFileSize"`
+		MaxFileNum   int   `mapstructure:"maxFileNum"`
+	} `mapstructure:"export"`
 }
 type Cos struct {
 	BucketURL    string `mapstructure:"bucketURL"`
@@ -348,7 +451,79 @@ type Share struct {
 	Env             string          `mapstructure:"env"`
 	RpcRegisterName RpcRegisterName `mapstructure:"rpcRegisterName"`
 	IMAdminUserID   []string        `mapstructure:"imAdminUserID"`
+	Direct          Direct          `mapstructure:"direct"`
+	Etcd            Etcd            `mapstructure:"etcd"`
+	Consul          Consul          `mapstructure:"consul"`
+	ConfigReload    ConfigReload    `mapstructure:"configReload"`
+	Tracing         Tracing         `mapstructure:"tracing"`
+}
+
+// ConfigReload controls the reload of webhooks.yml, local-cache.yml, notification.yml, the log level
+// and imAdminUserID while the services are running.
+type ConfigReload struct {
+	Enable bool `mapstructure:"enable"`
+	// Interval is the seconds between two checks of the files.
+	Interval int `mapstructure:"interval"`
+	// Registry also applies the files put to the registry keys named after them, e.g. webhooks.yml.
+	Registry bool `mapstructure:"registry"`
+}
+
+// Tracing exports OpenTelemetry spans over OTLP, the W3C trace context is carried in the grpc metadata,
+// the kafka headers and the webhook http headers.
+type Tracing struct {
+	Enable bool `mapstructure:"enable"`
+	// Endpoint is the host:port of the OTLP grpc receiver, e.g. an OpenTelemetry collector.
+	Endpoint string `mapstructure:"endpoint"`
+	Insecure bool   `mapstructure:"insecure"`
+	// SampleRatio is the ratio of the new traces that are sampled, from 0 to 1, the traces continued
+	// from a caller follow the sampling decision of the caller.
+	SampleRatio float64 `mapstructure:"sampleRatio"`
+}
+
+// Etcd is the registry used when env is etcd.
+type Etcd struct {
+	RootDirectory string   `mapstructure:"rootDirectory"`
+	Address       []string `mapstructure:"address"`
+	Username      string   `mapstructure:"username"`
+	Password      string   `mapstructure:"password"`
+}
+
+// Consul is the registry used when env is consul.
+type Consul struct {
+	RootDirectory string `mapstructure:"rootDirectory"`
+	Address       string `mapstructure:"address"`
+	Token         string `mapstructure:"token"`
+	Datacenter    string `mapstructure:"datacenter"`
+}
+
+// Direct lists the host:port of every instance of the services, it is used when env is direct.
+type Direct struct {
+	User           []string `mapstructure:"user"`
+	Friend         []string `mapstructure:"friend"`
+	Msg            []string `mapstructure:"msg"`
+	Push           []string `mapstructure:"push"`
+	MessageGateway []string `mapstructure:"messageGateway"`
+	Group          []string `mapstructure:"group"`
+	Auth           []string `mapstructure:"auth"`
+	Conversation   []string `mapstructure:"conversation"`
+	Third          []string `mapstructure:"third"`
+}
+
+// GetServiceAddresses maps the register name of every service to its addresses.
+func (d *Direct) GetServiceAddresses(r *RpcRegisterName) map[string][]string {
+	return map[string][]string{
+		r.User:           d.User,
+		r.Friend:         d.Friend,
+		r.Msg:            d.Msg,
+		r.Push:           d.Push,
+		r.MessageGateway: d.MessageGateway,
+		r.Group:          d.Group,
+		r.Auth:           d.Auth,
+		r.Conversation:   d.Conversation,
+		r.Third:          d.Third,
+	}
 }
+
 type RpcRegisterName struct {
 	User           string `mapstructure:"user"`
 	Friend         string `mapstructure:"friend"`
@@ -423,6 +598,8 @@ type Webhooks struct {
 	BeforeImportFriends      BeforeConfig `mapstructure:"beforeImportFriends"`
 	AfterImportFriends       AfterConfig  `mapstructure:"afterImportFriends"`
 	AfterRemoveBlack         AfterConfig  `mapstructure:"afterRemoveBlack"`
+	BeforeEditMsg            BeforeConfig `mapstructure:"beforeEditMsg"`
+	AfterEditMsg             AfterConfig  `mapstructure:"afterEditMsg"`
 }
 
 type ZooKeeper struct {
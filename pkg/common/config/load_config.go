@@ -1,6 +1,7 @@
 package config
 
 import (
+	"bytes"
 	"github.com/mitchellh/mapstructure"
 	"github.com/openimsdk/tools/errs"
 	"github.com/spf13/viper"
@@ -8,11 +9,8 @@ import (
 )
 
 func LoadConfig(path string, envPrefix string, config any) error {
-	v := viper.New()
+	v := newViper(envPrefix)
 	v.SetConfigFile(path)
-	v.SetEnvPrefix(envPrefix)
-	v.AutomaticEnv()
-	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 
 	if err := v.ReadInConfig(); err != nil {
 		return errs.WrapMsg(err, "failed to read config file", "path", path, "envPrefix", envPrefix)
@@ -25,3 +23,28 @@ func LoadConfig(path string, envPrefix string, config any) error {
 	}
 	return nil
 }
+
+// loadConfigData is LoadConfig with the yaml content read already, e.g. from the registry.
+func loadConfigData(data []byte, envPrefix string, config any) error {
+	v := newViper(envPrefix)
+	v.SetConfigType("yaml")
+
+	if err := v.ReadConfig(bytes.NewReader(data)); err != nil {
+		return errs.WrapMsg(err, "failed to read config data", "envPrefix", envPrefix)
+	}
+
+	if err := v.Unmarshal(config, func(config *mapstructure.DecoderConfig) {
+		config.TagName = "mapstructure"
+	}); err != nil {
+		return errs.WrapMsg(err, "failed to unmarshal config", "envPrefix", envPrefix)
+	}
+	return nil
+}
+
+func newViper(envPrefix string) *viper.Viper {
+	v := viper.New()
+	v.SetEnvPrefix(envPrefix)
+	v.AutomaticEnv()
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	return v
+}
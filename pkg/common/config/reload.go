@@ -0,0 +1,373 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// reloadable maps a section loaded at startup to its latest reloaded value, so the readers holding the
+// loaded section see the reloaded one, they get the old or the new value as a whole.
+type reloadable[T any] struct {
+	lock      sync.RWMutex
+	values    map[*T]*T
+	listeners map[*T][]func(*T)
+}
+
+func newReloadable[T any]() *reloadable[T] {
+	return &reloadable[T]{
+		values:    make(map[*T]*T),
+		listeners: make(map[*T][]func(*T)),
+	}
+}
+
+func (r *reloadable[T]) current(loaded *T) *T {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if value, ok := r.values[loaded]; ok {
+		return value
+	}
+	return loaded
+}
+
+func (r *reloadable[T]) store(loaded, value *T) {
+	r.lock.Lock()
+	r.values[loaded] = value
+	listeners := r.listeners[loaded]
+	r.lock.Unlock()
+	for _, fn := range listeners {
+		fn(value)
+	}
+}
+
+func (r *reloadable[T]) onReload(loaded *T, fn func(*T)) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.listeners[loaded] = append(r.listeners[loaded], fn)
+}
+
+var (
+	reloadedWebhooks     = newReloadable[Webhooks]()
+	reloadedLocalCache   = newReloadable[LocalCache]()
+	reloadedNotification = newReloadable[Notification]()
+	reloadedLog          = newReloadable[Log]()
+	reloadedShare        = newReloadable[Share]()
+)
+
+// Current returns the webhooks reloaded in place of w, or w if webhooks.yml has not been reloaded.
+func (w *Webhooks) Current() *Webhooks {
+	return reloadedWebhooks.current(w)
+}
+
+// Current returns the local cache config reloaded in place of l, or l if local-cache.yml has not been reloaded.
+func (l *LocalCache) Current() *LocalCache {
+	return reloadedLocalCache.current(l)
+}
+
+// OnReload calls fn with the new config every time local-cache.yml is reloaded in place of l.
+func (l *LocalCache) OnReload(fn func(*LocalCache)) {
+	reloadedLocalCache.onReload(l, fn)
+}
+
+// Current returns the notification config reloaded in place of n, or n if notification.yml has not been reloaded.
+func (n *Notification) Current() *Notification {
+	return reloadedNotification.current(n)
+}
+
+// OnReload calls fn with the new config every time notification.yml is reloaded in place of n.
+func (n *Notification) OnReload(fn func(*Notification)) {
+	reloadedNotification.onReload(n, fn)
+}
+
+// OnReload calls fn with the new config every time log.yml is reloaded in place of l.
+func (l *Log) OnReload(fn func(*Log)) {
+	reloadedLog.onReload(l, fn)
+}
+
+// AdminUserIDs returns the imAdminUserID of the latest share.yml, it is the only reloadable field of Share.
+func (s *Share) AdminUserIDs() []string {
+	return reloadedShare.current(s).IMAdminUserID
+}
+
+// OnReload calls fn with the new config every time share.yml is reloaded in place of s.
+func (s *Share) OnReload(fn func(*Share)) {
+	reloadedShare.onReload(s, fn)
+}
+
+func (w *Webhooks) Validate() error {
+	if w.URL == "" {
+		return nil
+	}
+	u, err := url.Parse(w.URL)
+	if err != nil {
+		return errs.WrapMsg(err, "invalid webhooks url", "url", w.URL)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errs.New("webhooks url must be http or https", "url", w.URL).Wrap()
+	}
+	return nil
+}
+
+func (l *LocalCache) Validate() error {
+	for name, c := range map[string]CacheConfig{"user": l.User, "group": l.Group, "friend": l.Friend, "conversation": l.Conversation} {
+		if c.SlotNum < 0 || c.SlotSize < 0 || c.SuccessExpire < 0 || c.FailedExpire < 0 {
+			return errs.New("local cache config must not be negative", "cache", name).Wrap()
+		}
+	}
+	return nil
+}
+
+// ValidateReload checks l reloaded in place of loaded. The caches subscribed to their topics at startup,
+// so a changed topic or a cache enabled or disabled since would cache values nothing invalidates.
+func (l *LocalCache) ValidateReload(loaded *LocalCache) error {
+	if err := l.Validate(); err != nil {
+		return err
+	}
+	for name, c := range map[string][2]CacheConfig{
+		"user":         {l.User, loaded.User},
+		"group":        {l.Group, loaded.Group},
+		"friend":       {l.Friend, loaded.Friend},
+		"conversation": {l.Conversation, loaded.Conversation},
+	} {
+		if c[0].Topic != c[1].Topic {
+			return errs.New("local cache topic is not reloadable", "cache", name, "topic", c[0].Topic).Wrap()
+		}
+		if c[0].Enable() != c[1].Enable() {
+			return errs.New("enabling or disabling a local cache is not reloadable", "cache", name).Wrap()
+		}
+	}
+	return nil
+}
+
+func (l *Log) Validate() error {
+	if l.RemainLogLevel < 0 || l.RemainLogLevel > 6 {
+		return errs.New("remainLogLevel must be between 0 and 6", "remainLogLevel", l.RemainLogLevel).Wrap()
+	}
+	return nil
+}
+
+func (s *Share) Validate() error {
+	if len(s.IMAdminUserID) == 0 {
+		return errs.New("imAdminUserID must not be empty").Wrap()
+	}
+	return nil
+}
+
+// ConfigVersion identifies the reloadable config files applied by the process.
+type ConfigVersion struct {
+	Version    string            `json:"version"`
+	Files      map[string]string `json:"files"`
+	ReloadTime int64             `json:"reloadTime"`
+}
+
+var activeVersion atomic.Pointer[ConfigVersion]
+
+// ActiveVersion returns the version of the reloadable config files the process runs with.
+func ActiveVersion() *ConfigVersion {
+	if v := activeVersion.Load(); v != nil {
+		return v
+	}
+	return &ConfigVersion{}
+}
+
+// reloadFunc parses a file into a new section, checks it and swaps it in place of loaded.
+func reloadFunc[T any](r *reloadable[T], loaded *T, check func(value *T) error) func(data []byte, envPrefix string) error {
+	return func(data []byte, envPrefix string) error {
+		value := new(T)
+		if err := loadConfigData(data, envPrefix, value); err != nil {
+			return err
+		}
+		if err := check(value); err != nil {
+			return err
+		}
+		r.store(loaded, value)
+		return nil
+	}
+}
+
+type watchedFile struct {
+	fileName     string
+	envPrefix    string
+	reload       func(data []byte, envPrefix string) error
+	fileHash     string // the content last read from the file
+	registryHash string // the content last read from the registry
+	appliedHash  string
+}
+
+// Watcher checks the reloadable config files and the registry keys named after them, a changed file is
+// parsed and validated before it replaces the section loaded at startup, an invalid file is ignored.
+type Watcher struct {
+	dir      string
+	interval time.Duration
+	getConf  func(key string) ([]byte, error)
+	files    []*watchedFile
+}
+
+// NewWatcher watches the files of dir every interval, getConf reads the registry and may be nil.
+func NewWatcher(dir string, interval time.Duration, getConf func(key string) ([]byte, error)) *Watcher {
+	return &Watcher{dir: dir, interval: interval, getConf: getConf}
+}
+
+// Add watches fileName, loaded is the section the file was loaded into at startup. The content applied
+// at startup is read again here, so Add is called right after the config is loaded.
+func (w *Watcher) Add(fileName, envPrefix string, loaded any) error {
+	var reload func(data []byte, envPrefix string) error
+	switch loaded := loaded.(type) {
+	case *Webhooks:
+		reload = reloadFunc(reloadedWebhooks, loaded, (*Webhooks).Validate)
+	case *LocalCache:
+		reload = reloadFunc(reloadedLocalCache, loaded, func(value *LocalCache) error {
+			return value.ValidateReload(loaded)
+		})
+	case *Notification:
+		reload = reloadFunc(reloadedNotification, loaded, func(*Notification) error { return nil })
+	case *Log:
+		reload = reloadFunc(reloadedLog, loaded, func(value *Log) error {
+			if err := value.Validate(); err != nil {
+				return err
+			}
+			// the logger of openimsdk/tools fixes its files when it is built, and rebuilding it races
+			// with the goroutines logging and leaks the open log file
+			other := *value
+			other.RemainLogLevel = loaded.RemainLogLevel
+			if !reflect.DeepEqual(&other, loaded) {
+				log.ZWarn(context.Background(), "only remainLogLevel of log.yml is reloaded, restart to apply the other changes", nil)
+			}
+			return nil
+		})
+	case *Share:
+		reload = reloadFunc(reloadedShare, loaded, func(value *Share) error {
+			if err := value.Validate(); err != nil {
+				return err
+			}
+			other := *value
+			other.IMAdminUserID = loaded.IMAdminUserID
+			if !reflect.DeepEqual(&other, loaded) {
+				log.ZWarn(context.Background(), "only imAdminUserID of share.yml is reloaded, restart to apply the other changes", nil)
+			}
+			return nil
+		})
+	default:
+		return errs.New("config is not reloadable", "fileName", fileName, "type", reflect.TypeOf(loaded).String()).Wrap()
+	}
+	data, err := os.ReadFile(filepath.Join(w.dir, fileName))
+	if err != nil {
+		return errs.WrapMsg(err, "read config file failed", "fileName", fileName)
+	}
+	hash := contentHash(data)
+	w.files = append(w.files, &watchedFile{
+		fileName:    fileName,
+		envPrefix:   envPrefix,
+		reload:      reload,
+		fileHash:    hash,
+		appliedHash: hash,
+	})
+	return nil
+}
+
+// Run checks the files until ctx is done.
+func (w *Watcher) Run(ctx context.Context) {
+	w.check(ctx)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.check(ctx)
+		}
+	}
+}
+
+// check applies the files changed in the directory or in the registry since the last check,
+// when both changed the registry wins.
+func (w *Watcher) check(ctx context.Context) {
+	var changed bool
+	for _, f := range w.files {
+		data, err := os.ReadFile(filepath.Join(w.dir, f.fileName))
+		if err != nil {
+			log.ZWarn(ctx, "read config file failed", err, "fileName", f.fileName)
+		} else if hash := contentHash(data); hash != f.fileHash {
+			f.fileHash = hash
+			changed = w.apply(ctx, f, data, hash, "file") || changed
+		}
+		if w.getConf == nil {
+			continue
+		}
+		// a missing key is not an error, the registry is optional for every file
+		data, err = w.getConf(f.fileName)
+		if err != nil || len(data) == 0 {
+			continue
+		}
+		if hash := contentHash(data); hash != f.registryHash {
+			f.registryHash = hash
+			changed = w.apply(ctx, f, data, hash, "registry") || changed
+		}
+	}
+	if changed || activeVersion.Load() == nil {
+		activeVersion.Store(w.version())
+	}
+}
+
+func (w *Watcher) apply(ctx context.Context, f *watchedFile, data []byte, hash string, source string) bool {
+	if hash == f.appliedHash {
+		return false
+	}
+	if err := f.reload(data, f.envPrefix); err != nil {
+		log.ZWarn(ctx, "invalid config is not reloaded", err, "fileName", f.fileName, "source", source)
+		return false
+	}
+	f.appliedHash = hash
+	log.ZInfo(ctx, "config reloaded", "fileName", f.fileName, "source", source, "hash", hash)
+	return true
+}
+
+// version hashes the applied content of every file, so the processes applying the same files
+// have the same version.
+func (w *Watcher) version() *ConfigVersion {
+	v := &ConfigVersion{Files: make(map[string]string, len(w.files)), ReloadTime: time.Now().UnixMilli()}
+	names := make([]string, 0, len(w.files))
+	for _, f := range w.files {
+		v.Files[f.fileName] = f.appliedHash
+		names = append(names, f.fileName)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name + ":" + v.Files[name] + "\n")
+	}
+	v.Version = contentHash([]byte(b.String()))
+	return v
+}
+
+func contentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
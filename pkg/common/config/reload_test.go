@@ -0,0 +1,120 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatcherReloadWebhooks(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "webhooks.yml")
+	write := func(content string) {
+		assert.Nil(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	write("url: http://127.0.0.1:10006/callbackExample\n")
+	var webhooks Webhooks
+	assert.Nil(t, LoadConfig(path, "IMENV_WEBHOOKS", &webhooks))
+
+	registry := make(map[string][]byte)
+	w := NewWatcher(dir, 0, func(key string) ([]byte, error) {
+		return registry[key], nil
+	})
+	assert.Nil(t, w.Add("webhooks.yml", "IMENV_WEBHOOKS", &webhooks))
+	ctx := context.Background()
+	w.check(ctx)
+	version := ActiveVersion().Version
+	assert.Equal(t, "http://127.0.0.1:10006/callbackExample", webhooks.Current().URL)
+
+	write("url: http://127.0.0.1:10008/callback\nbeforeSendSingleMsg:\n  enable: true\n")
+	w.check(ctx)
+	assert.Equal(t, "http://127.0.0.1:10008/callback", webhooks.Current().URL)
+	assert.True(t, webhooks.Current().BeforeSendSingleMsg.Enable)
+	assert.NotEqual(t, version, ActiveVersion().Version)
+	// the section loaded at startup is left as it is
+	assert.Equal(t, "http://127.0.0.1:10006/callbackExample", webhooks.URL)
+
+	write("url: ftp://127.0.0.1/callback\n")
+	w.check(ctx)
+	assert.Equal(t, "http://127.0.0.1:10008/callback", webhooks.Current().URL)
+
+	registry["webhooks.yml"] = []byte("url: https://example.com/callback\n")
+	w.check(ctx)
+	assert.Equal(t, "https://example.com/callback", webhooks.Current().URL)
+}
+
+func TestWatcherReloadLocalCache(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "local-cache.yml")
+	assert.Nil(t, os.WriteFile(path, []byte("user:\n  topic: DELETE_CACHE_USER\n  slotNum: 100\n  slotSize: 2000\n"), 0644))
+	var localCache LocalCache
+	assert.Nil(t, LoadConfig(path, "IMENV_LOCAL_CACHE", &localCache))
+	var reloaded []*LocalCache
+	localCache.OnReload(func(lc *LocalCache) {
+		reloaded = append(reloaded, lc)
+	})
+	w := NewWatcher(dir, 0, nil)
+	assert.Nil(t, w.Add("local-cache.yml", "IMENV_LOCAL_CACHE", &localCache))
+
+	assert.Nil(t, os.WriteFile(path, []byte("user:\n  topic: DELETE_CACHE_USER\n  slotNum: 100\n  slotSize: 2000\n  successExpire: 30\n"), 0644))
+	w.check(context.Background())
+	assert.Len(t, reloaded, 1)
+	assert.Equal(t, 30, localCache.Current().User.SuccessExpire)
+
+	// the topics are not reloadable
+	assert.Nil(t, os.WriteFile(path, []byte("user:\n  topic: OTHER_TOPIC\n  slotNum: 100\n  slotSize: 2000\n"), 0644))
+	w.check(context.Background())
+	assert.Len(t, reloaded, 1)
+	assert.Equal(t, "DELETE_CACHE_USER", localCache.Current().User.Topic)
+
+	// nothing subscribed to the topic of a cache disabled at startup
+	assert.Nil(t, os.WriteFile(path, []byte("user:\n  topic: DELETE_CACHE_USER\n  slotNum: 100\n  slotSize: 2000\ngroup:\n  topic: DELETE_CACHE_GROUP\n  slotNum: 100\n  slotSize: 2000\n"), 0644))
+	w.check(context.Background())
+	assert.Len(t, reloaded, 1)
+	assert.False(t, localCache.Current().Group.Enable())
+}
+
+func TestWatcherReloadLogLevel(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "log.yml")
+	assert.Nil(t, os.WriteFile(path, []byte("storageLocation: ../logs/\nremainLogLevel: 6\n"), 0644))
+	var logConfig Log
+	assert.Nil(t, LoadConfig(path, "IMENV_LOG", &logConfig))
+	var levels []int
+	logConfig.OnReload(func(l *Log) {
+		levels = append(levels, l.RemainLogLevel)
+	})
+	w := NewWatcher(dir, 0, nil)
+	assert.Nil(t, w.Add("log.yml", "IMENV_LOG", &logConfig))
+
+	assert.Nil(t, os.WriteFile(path, []byte("storageLocation: ../logs/\nremainLogLevel: 3\n"), 0644))
+	w.check(context.Background())
+	assert.Equal(t, []int{3}, levels)
+
+	assert.Nil(t, os.WriteFile(path, []byte("storageLocation: ../logs/\nremainLogLevel: 9\n"), 0644))
+	w.check(context.Background())
+	assert.Equal(t, []int{3}, levels)
+}
+
+func TestWatcherReloadShareAdmins(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "share.yml")
+	assert.Nil(t, os.WriteFile(path, []byte("secret: openIM123\nimAdminUserID: [imAdmin]\n"), 0644))
+	var share Share
+	assert.Nil(t, LoadConfig(path, "IMENV_SHARE", &share))
+	var reloaded [][]string
+	share.OnReload(func(s *Share) {
+		reloaded = append(reloaded, s.IMAdminUserID)
+	})
+	w := NewWatcher(dir, 0, nil)
+	assert.Nil(t, w.Add("share.yml", "IMENV_SHARE", &share))
+
+	assert.Nil(t, os.WriteFile(path, []byte("secret: openIM123\nimAdminUserID: [imAdmin, opsAdmin]\n"), 0644))
+	w.check(context.Background())
+	assert.Equal(t, [][]string{{"imAdmin", "opsAdmin"}}, reloaded)
+	assert.Equal(t, []string{"imAdmin", "opsAdmin"}, share.AdminUserIDs())
+	assert.Equal(t, []string{"imAdmin"}, share.IMAdminUserID)
+}
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	relationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	pbdonotdisturb "github.com/openimsdk/open-im-server/v3/pkg/protocol/donotdisturb"
 	"github.com/openimsdk/protocol/sdkws"
 )
 
@@ -93,3 +94,44 @@ func UserPb2DBMapEx(user *sdkws.UserInfoWithEx) map[string]any {
 
 	return val
 }
+
+func DoNotDisturbDB2Pb(doNotDisturb *relationtb.DoNotDisturbModel) *pbdonotdisturb.DoNotDisturb {
+	if doNotDisturb == nil {
+		return &pbdonotdisturb.DoNotDisturb{}
+	}
+	windows := make([]*pbdonotdisturb.TimeWindow, 0, len(doNotDisturb.Windows))
+	for _, window := range doNotDisturb.Windows {
+		windows = append(windows, &pbdonotdisturb.TimeWindow{
+			Weekday:     window.Weekday,
+			StartMinute: window.StartMinute,
+			EndMinute:   window.EndMinute,
+		})
+	}
+	return &pbdonotdisturb.DoNotDisturb{
+		Enable:                doNotDisturb.Enable,
+		TimeZone:              doNotDisturb.TimeZone,
+		Windows:               windows,
+		AllowAtMention:        doNotDisturb.AllowAtMention,
+		ExceptConversationIDs: doNotDisturb.ExceptConversationIDs,
+		Silent:                doNotDisturb.Silent,
+	}
+}
+
+func DoNotDisturbPb2DB(doNotDisturb *pbdonotdisturb.DoNotDisturb) *relationtb.DoNotDisturbModel {
+	windows := make([]relationtb.DoNotDisturbWindow, 0, len(doNotDisturb.Windows))
+	for _, window := range doNotDisturb.Windows {
+		windows = append(windows, relationtb.DoNotDisturbWindow{
+			Weekday:     window.Weekday,
+			StartMinute: window.StartMinute,
+			EndMinute:   window.EndMinute,
+		})
+	}
+	return &relationtb.DoNotDisturbModel{
+		Enable:                doNotDisturb.Enable,
+		TimeZone:              doNotDisturb.TimeZone,
+		Windows:               windows,
+		AllowAtMention:        doNotDisturb.AllowAtMention,
+		ExceptConversationIDs: doNotDisturb.ExceptConversationIDs,
+		Silent:                doNotDisturb.Silent,
+	}
+}
@@ -35,7 +35,7 @@ func InitLocalCache(localCache *config.LocalCache) {
 		}{
 			{
 				Local: localCache.User,
-				Keys:  []string{cachekey.UserInfoKey, cachekey.UserGlobalRecvMsgOptKey},
+				Keys:  []string{cachekey.UserInfoKey, cachekey.UserGlobalRecvMsgOptKey, cachekey.UserDoNotDisturbKey},
 			},
 			{
 				Local: localCache.Group,
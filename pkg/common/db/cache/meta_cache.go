@@ -239,6 +239,59 @@ func batchGetCache2[T any, K comparable](
 	return res, nil
 }
 
+// batchFetchCache reads the values of keys in one round trip, the keys missing from the cache are loaded by
+// a single call of fn. The keys fn returns no value for are cached as the zero value of T.
+func batchFetchCache[T any, K comparable](
+	ctx context.Context,
+	rcClient *rockscache.Client,
+	expire time.Duration,
+	keys []K,
+	keyFn func(key K) string,
+	fn func(ctx context.Context, keys []K) (map[K]T, error),
+) (map[K]T, error) {
+	res := make(map[K]T, len(keys))
+	if len(keys) == 0 {
+		return res, nil
+	}
+	cacheKeys := make([]string, len(keys))
+	for i, key := range keys {
+		cacheKeys[i] = keyFn(key)
+	}
+	values, err := rcClient.FetchBatch2(ctx, cacheKeys, expire, func(idxs []int) (map[int]string, error) {
+		missing := make([]K, 0, len(idxs))
+		for _, idx := range idxs {
+			missing = append(missing, keys[idx])
+		}
+		loaded, err := fn(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[int]string, len(idxs))
+		for _, idx := range idxs {
+			bs, err := json.Marshal(loaded[keys[idx]])
+			if err != nil {
+				return nil, errs.WrapMsg(err, "marshal failed")
+			}
+			values[idx] = string(bs)
+		}
+		return values, nil
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	for idx, v := range values {
+		if v == "" {
+			continue
+		}
+		var t T
+		if err := json.Unmarshal([]byte(v), &t); err != nil {
+			return nil, errs.WrapMsg(err, "cache json.Unmarshal failed", "key", cacheKeys[idx], "value", v)
+		}
+		res[keys[idx]] = t
+	}
+	return res, nil
+}
+
 // func batchGetCacheMap[T any](
 //	ctx context.Context,
 //	rcClient *rockscache.Client,
@@ -0,0 +1,107 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	msgReactionCountExpireTime = time.Hour * 24
+)
+
+// MsgReactionCache caches the emoji counts of each message, rebuilt from mongo when missing.
+type MsgReactionCache interface {
+	metaCache
+	NewCache() MsgReactionCache
+	// GetReactionCounts returns emoji counts by seq, seqs without reactions are omitted.
+	GetReactionCounts(ctx context.Context, conversationID string, seqs []int64) (map[int64]map[string]int64, error)
+	DelReactionCounts(conversationID string, seqs ...int64) MsgReactionCache
+}
+
+type MsgReactionCacheRedis struct {
+	metaCache
+	expireTime time.Duration
+	rcClient   *rockscache.Client
+	reactionDB relation.MsgReactionModelInterface
+}
+
+func NewMsgReactionCacheRedis(rdb redis.UniversalClient, reactionDB relation.MsgReactionModelInterface, options rockscache.Options) MsgReactionCache {
+	rcClient := rockscache.NewClient(rdb, options)
+	mc := NewMetaCacheRedis(rcClient)
+	mc.SetRawRedisClient(rdb)
+	return &MsgReactionCacheRedis{
+		metaCache:  mc,
+		expireTime: msgReactionCountExpireTime,
+		rcClient:   rcClient,
+		reactionDB: reactionDB,
+	}
+}
+
+func (m *MsgReactionCacheRedis) NewCache() MsgReactionCache {
+	return &MsgReactionCacheRedis{
+		metaCache:  m.Copy(),
+		expireTime: m.expireTime,
+		rcClient:   m.rcClient,
+		reactionDB: m.reactionDB,
+	}
+}
+
+func (m *MsgReactionCacheRedis) getReactionCountKey(conversationID string, seq int64) string {
+	return cachekey.GetMsgReactionCountKey(conversationID, seq)
+}
+
+func (m *MsgReactionCacheRedis) GetReactionCounts(ctx context.Context, conversationID string, seqs []int64) (map[int64]map[string]int64, error) {
+	counts, err := batchFetchCache(ctx, m.rcClient, m.expireTime, datautil.Distinct(seqs), func(seq int64) string {
+		return m.getReactionCountKey(conversationID, seq)
+	}, func(ctx context.Context, seqs []int64) (map[int64]map[string]int64, error) {
+		reactionCounts, err := m.reactionDB.CountBySeqs(ctx, conversationID, seqs)
+		if err != nil {
+			return nil, err
+		}
+		counts := make(map[int64]map[string]int64)
+		for _, count := range reactionCounts {
+			if counts[count.Seq] == nil {
+				counts[count.Seq] = make(map[string]int64)
+			}
+			counts[count.Seq][count.Emoji] = count.Count
+		}
+		return counts, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for seq, seqCounts := range counts {
+		if len(seqCounts) == 0 {
+			delete(counts, seq)
+		}
+	}
+	return counts, nil
+}
+
+func (m *MsgReactionCacheRedis) DelReactionCounts(conversationID string, seqs ...int64) MsgReactionCache {
+	cache := m.NewCache()
+	for _, seq := range seqs {
+		cache.AddKeys(m.getReactionCountKey(conversationID, seq))
+	}
+	return cache
+}
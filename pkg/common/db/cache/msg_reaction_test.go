@@ -0,0 +1,68 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockMsgReactionDB struct {
+	relation.MsgReactionModelInterface
+	counts []*relation.MsgReactionCount
+	calls  [][]int64
+}
+
+func (m *mockMsgReactionDB) CountBySeqs(_ context.Context, _ string, seqs []int64) ([]*relation.MsgReactionCount, error) {
+	m.calls = append(m.calls, seqs)
+	var res []*relation.MsgReactionCount
+	for _, count := range m.counts {
+		for _, seq := range seqs {
+			if count.Seq == seq {
+				res = append(res, count)
+			}
+		}
+	}
+	return res, nil
+}
+
+func TestGetReactionCounts(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{})
+	defer rdb.Close()
+	ctx := context.Background()
+	reactionDB := &mockMsgReactionDB{counts: []*relation.MsgReactionCount{
+		{Seq: 1, Emoji: "👍", Count: 2},
+		{Seq: 1, Emoji: "❤️", Count: 1},
+		{Seq: 3, Emoji: "👍", Count: 1},
+	}}
+	c := NewMsgReactionCacheRedis(rdb, reactionDB, GetDefaultOpt())
+	assert.Nil(t, c.DelReactionCounts("si_a_b", 1, 2, 3).ExecDel(ctx))
+
+	// the missing seqs are counted by one query
+	counts, err := c.GetReactionCounts(ctx, "si_a_b", []int64{1, 2, 3})
+	assert.Nil(t, err)
+	assert.Equal(t, map[int64]map[string]int64{1: {"👍": 2, "❤️": 1}, 3: {"👍": 1}}, counts)
+	assert.Equal(t, [][]int64{{1, 2, 3}}, reactionDB.calls)
+
+	// the seqs without reactions are cached too
+	counts, err = c.GetReactionCounts(ctx, "si_a_b", []int64{1, 2, 3})
+	assert.Nil(t, err)
+	assert.Len(t, counts, 2)
+	assert.Len(t, reactionDB.calls, 1)
+}
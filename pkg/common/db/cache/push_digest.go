@@ -0,0 +1,125 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"hash/crc32"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+// pushDigestShards spreads the windows over several key pairs so they do not all land on one slot in redis cluster.
+const pushDigestShards = 16
+
+// pushDigestKeys returns the keys of a shard, both keys share the same hash tag so the scripts below stay on one slot.
+func pushDigestKeys(shard int) []string {
+	tag := "{OFFLINE_PUSH_DIGEST:" + strconv.Itoa(shard) + "}"
+	return []string{tag + ":DUE", tag + ":COUNT"}
+}
+
+func pushDigestShard(member string) int {
+	return int(crc32.ChecksumIEEE([]byte(member)) % pushDigestShards)
+}
+
+// collapseScript counts one message for every member in ARGV[2:] and opens a window closing at ARGV[1]
+// for members without one, returning those members.
+var collapseScript = redis.NewScript(`
+local opened = {}
+for i = 2, #ARGV do
+	if redis.call('HINCRBY', KEYS[2], ARGV[i], 1) == 1 then
+		redis.call('ZADD', KEYS[1], ARGV[1], ARGV[i])
+		table.insert(opened, ARGV[i])
+	end
+end
+return opened
+`)
+
+// popDigestScript atomically closes up to ARGV[2] windows ending before ARGV[1] and returns [member, count] pairs.
+var popDigestScript = redis.NewScript(`
+local members = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, tonumber(ARGV[2]))
+local res = {}
+for _, member in ipairs(members) do
+	redis.call('ZREM', KEYS[1], member)
+	local count = redis.call('HGET', KEYS[2], member)
+	redis.call('HDEL', KEYS[2], member)
+	if count then
+		table.insert(res, member)
+		table.insert(res, count)
+	end
+end
+return res
+`)
+
+// PushDigestCache counts offline pushes coalesced into a window per member.
+type PushDigestCache interface {
+	// Collapse counts a message for each member and returns the members whose window was opened by it.
+	Collapse(ctx context.Context, members []string, windowEnd time.Time) ([]string, error)
+	// PopDueDigests closes up to count windows ended before now and returns the number of messages counted in each,
+	// fewer than count are returned only when no due window is left.
+	PopDueDigests(ctx context.Context, now time.Time, count int) (map[string]int64, error)
+}
+
+func NewPushDigestCache(rdb redis.UniversalClient) PushDigestCache {
+	return &pushDigestCache{rdb: rdb}
+}
+
+type pushDigestCache struct {
+	rdb redis.UniversalClient
+}
+
+func (c *pushDigestCache) Collapse(ctx context.Context, members []string, windowEnd time.Time) ([]string, error) {
+	if len(members) == 0 {
+		return nil, nil
+	}
+	shards := make(map[int][]any)
+	for _, member := range members {
+		shard := pushDigestShard(member)
+		if _, ok := shards[shard]; !ok {
+			shards[shard] = []any{windowEnd.UnixMilli()}
+		}
+		shards[shard] = append(shards[shard], member)
+	}
+	var opened []string
+	for shard, args := range shards {
+		res, err := collapseScript.Run(ctx, c.rdb, pushDigestKeys(shard), args...).StringSlice()
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		opened = append(opened, res...)
+	}
+	return opened, nil
+}
+
+func (c *pushDigestCache) PopDueDigests(ctx context.Context, now time.Time, count int) (map[string]int64, error) {
+	digests := make(map[string]int64)
+	for shard := 0; shard < pushDigestShards && len(digests) < count; shard++ {
+		res, err := popDigestScript.Run(ctx, c.rdb, pushDigestKeys(shard), now.UnixMilli(), count-len(digests)).StringSlice()
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		for i := 0; i+1 < len(res); i += 2 {
+			n, err := strconv.ParseInt(res[i+1], 10, 64)
+			if err != nil {
+				return nil, errs.WrapMsg(err, "parse push digest count failed", "member", res[i])
+			}
+			digests[res[i]] = n
+		}
+	}
+	return digests, nil
+}
@@ -0,0 +1,76 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPushDigestKeys(t *testing.T) {
+	slots := make(map[string]struct{})
+	for shard := 0; shard < pushDigestShards; shard++ {
+		keys := pushDigestKeys(shard)
+		tag := keys[0][:strings.Index(keys[0], "}")+1]
+		assert.True(t, strings.HasPrefix(keys[1], tag))
+		slots[tag] = struct{}{}
+	}
+	assert.Len(t, slots, pushDigestShards)
+}
+
+func TestPushDigestCollapse(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{})
+	defer rdb.Close()
+	ctx := context.Background()
+	for shard := 0; shard < pushDigestShards; shard++ {
+		assert.Nil(t, rdb.Del(ctx, pushDigestKeys(shard)...).Err())
+	}
+	c := NewPushDigestCache(rdb)
+
+	members := make([]string, 0, 40)
+	for i := 0; i < 40; i++ {
+		members = append(members, fmt.Sprintf("m%d", i))
+	}
+	now := time.Now()
+	opened, err := c.Collapse(ctx, members, now)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, members, opened)
+	opened, err = c.Collapse(ctx, members[:10], now)
+	assert.Nil(t, err)
+	assert.Empty(t, opened)
+
+	digests, err := c.PopDueDigests(ctx, now, 30)
+	assert.Nil(t, err)
+	assert.Len(t, digests, 30)
+	digests2, err := c.PopDueDigests(ctx, now, 30)
+	assert.Nil(t, err)
+	assert.Len(t, digests2, 10)
+	for member, count := range digests2 {
+		digests[member] = count
+	}
+	for i, member := range members {
+		if i < 10 {
+			assert.Equal(t, int64(2), digests[member])
+		} else {
+			assert.Equal(t, int64(1), digests[member])
+		}
+	}
+}
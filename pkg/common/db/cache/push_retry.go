@@ -0,0 +1,172 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+// All keys share the same hash tag so the scripts below stay on one slot in redis cluster.
+const (
+	pushRetryQueue     = "{OFFLINE_PUSH_RETRY}:QUEUE"
+	pushRetryTask      = "{OFFLINE_PUSH_RETRY}:TASK"
+	pushDeadLetterList = "{OFFLINE_PUSH_RETRY}:DEAD"
+	pushDeadLetterTask = "{OFFLINE_PUSH_RETRY}:DEAD_TASK"
+)
+
+// leaseDueScript atomically leases up to ARGV[2] tasks whose score is not after ARGV[1] until ARGV[3] and returns
+// [id, data] pairs, so each task is handed to one push instance at a time. A task stays in the queue until it is acked,
+// the task of an instance that crashed is due again when its lease expires.
+var leaseDueScript = redis.NewScript(`
+local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, tonumber(ARGV[2]))
+local tasks = {}
+for _, id in ipairs(ids) do
+	local task = redis.call('HGET', KEYS[2], id)
+	if task then
+		redis.call('ZADD', KEYS[1], ARGV[3], id)
+		table.insert(tasks, id)
+		table.insert(tasks, task)
+	else
+		redis.call('ZREM', KEYS[1], id)
+	end
+end
+return tasks
+`)
+
+// takeScript atomically removes the given ids from a sorted set and hash and returns [id, data] pairs of those found.
+var takeScript = redis.NewScript(`
+local res = {}
+for _, id in ipairs(ARGV) do
+	local task = redis.call('HGET', KEYS[2], id)
+	redis.call('ZREM', KEYS[1], id)
+	if task then
+		redis.call('HDEL', KEYS[2], id)
+		table.insert(res, id)
+		table.insert(res, task)
+	end
+end
+return res
+`)
+
+// PushRetryCache persists failed offline pushes waiting for retry and those moved to the dead-letter set.
+type PushRetryCache interface {
+	// AddRetryTask adds or replaces the task id, it is due at next.
+	AddRetryTask(ctx context.Context, id string, task string, next time.Time) error
+	// LeaseDueRetryTasks returns the tasks due at now and postpones them to leaseUntil, the tasks pushed must be acked.
+	LeaseDueRetryTasks(ctx context.Context, now time.Time, leaseUntil time.Time, count int) (map[string]string, error)
+	AckRetryTask(ctx context.Context, id string) error
+	// MoveToDeadLetter removes the task id from the retry queue and adds it to the dead-letter set.
+	MoveToDeadLetter(ctx context.Context, id string, task string, failTime time.Time) error
+	GetDeadLetters(ctx context.Context, offset, count int64) (int64, []string, error)
+	TakeDeadLetters(ctx context.Context, ids []string) (map[string]string, error)
+}
+
+func NewPushRetryCache(rdb redis.UniversalClient) PushRetryCache {
+	return &pushRetryCache{rdb: rdb}
+}
+
+type pushRetryCache struct {
+	rdb redis.UniversalClient
+}
+
+func (c *pushRetryCache) AddRetryTask(ctx context.Context, id string, task string, next time.Time) error {
+	pipe := c.rdb.TxPipeline()
+	pipe.HSet(ctx, pushRetryTask, id, task)
+	pipe.ZAdd(ctx, pushRetryQueue, redis.Z{Score: float64(next.UnixMilli()), Member: id})
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *pushRetryCache) LeaseDueRetryTasks(ctx context.Context, now time.Time, leaseUntil time.Time, count int) (map[string]string, error) {
+	res, err := leaseDueScript.Run(ctx, c.rdb, []string{pushRetryQueue, pushRetryTask}, now.UnixMilli(), count, leaseUntil.UnixMilli()).StringSlice()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return pairs(res), nil
+}
+
+func (c *pushRetryCache) AckRetryTask(ctx context.Context, id string) error {
+	pipe := c.rdb.TxPipeline()
+	pipe.ZRem(ctx, pushRetryQueue, id)
+	pipe.HDel(ctx, pushRetryTask, id)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *pushRetryCache) MoveToDeadLetter(ctx context.Context, id string, task string, failTime time.Time) error {
+	pipe := c.rdb.TxPipeline()
+	pipe.ZRem(ctx, pushRetryQueue, id)
+	pipe.HDel(ctx, pushRetryTask, id)
+	pipe.HSet(ctx, pushDeadLetterTask, id, task)
+	pipe.ZAdd(ctx, pushDeadLetterList, redis.Z{Score: float64(failTime.UnixMilli()), Member: id})
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *pushRetryCache) GetDeadLetters(ctx context.Context, offset, count int64) (int64, []string, error) {
+	total, err := c.rdb.ZCard(ctx, pushDeadLetterList).Result()
+	if err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	if count <= 0 || offset >= total {
+		return total, nil, nil
+	}
+	ids, err := c.rdb.ZRevRange(ctx, pushDeadLetterList, offset, offset+count-1).Result()
+	if err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	if len(ids) == 0 {
+		return total, nil, nil
+	}
+	values, err := c.rdb.HMGet(ctx, pushDeadLetterTask, ids...).Result()
+	if err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	tasks := make([]string, 0, len(values))
+	for _, value := range values {
+		if task, ok := value.(string); ok {
+			tasks = append(tasks, task)
+		}
+	}
+	return total, tasks, nil
+}
+
+func (c *pushRetryCache) TakeDeadLetters(ctx context.Context, ids []string) (map[string]string, error) {
+	if len(ids) == 0 {
+		return map[string]string{}, nil
+	}
+	args := make([]any, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	res, err := takeScript.Run(ctx, c.rdb, []string{pushDeadLetterList, pushDeadLetterTask}, args...).StringSlice()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return pairs(res), nil
+}
+
+// pairs turns the [id, data] pairs returned by the scripts into a map.
+func pairs(res []string) map[string]string {
+	tasks := make(map[string]string, len(res)/2)
+	for i := 0; i+1 < len(res); i += 2 {
+		tasks[res[i]] = res[i+1]
+	}
+	return tasks
+}
@@ -0,0 +1,62 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPushRetryLease(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{})
+	defer rdb.Close()
+	ctx := context.Background()
+	assert.Nil(t, rdb.Del(ctx, pushRetryQueue, pushRetryTask, pushDeadLetterList, pushDeadLetterTask).Err())
+	c := NewPushRetryCache(rdb)
+
+	now := time.Now()
+	assert.Nil(t, c.AddRetryTask(ctx, "t1", "task1", now))
+	assert.Nil(t, c.AddRetryTask(ctx, "t2", "task2", now.Add(time.Hour)))
+
+	tasks, err := c.LeaseDueRetryTasks(ctx, now, now.Add(time.Minute), 10)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"t1": "task1"}, tasks)
+
+	// a leased task is not handed out again until its lease expires
+	tasks, err = c.LeaseDueRetryTasks(ctx, now, now.Add(time.Minute), 10)
+	assert.Nil(t, err)
+	assert.Empty(t, tasks)
+	tasks, err = c.LeaseDueRetryTasks(ctx, now.Add(time.Minute), now.Add(time.Minute*2), 10)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"t1": "task1"}, tasks)
+
+	assert.Nil(t, c.AckRetryTask(ctx, "t1"))
+	tasks, err = c.LeaseDueRetryTasks(ctx, now.Add(time.Minute*3), now.Add(time.Minute*4), 10)
+	assert.Nil(t, err)
+	assert.Empty(t, tasks)
+
+	assert.Nil(t, c.MoveToDeadLetter(ctx, "t2", "task2", now))
+	total, deadLetters, err := c.GetDeadLetters(ctx, 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, []string{"task2"}, deadLetters)
+	tasks, err = c.LeaseDueRetryTasks(ctx, now.Add(time.Hour*2), now.Add(time.Hour*3), 10)
+	assert.Nil(t, err)
+	assert.Empty(t, tasks)
+}
@@ -0,0 +1,70 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+// Both keys share the same hash tag so leaseDueScript stays on one slot in redis cluster.
+const (
+	searchBackfillQueue = "{SEARCH_INDEX_BACKFILL}:QUEUE"
+	searchBackfillTask  = "{SEARCH_INDEX_BACKFILL}:TASK"
+)
+
+// SearchBackfillCache persists the messages stored in MongoDB whose search indexing failed, until they are indexed.
+type SearchBackfillCache interface {
+	// AddBackfillTask adds or replaces the task id, it is due at next.
+	AddBackfillTask(ctx context.Context, id string, task string, next time.Time) error
+	// LeaseDueBackfillTasks returns the tasks due at now and postpones them to leaseUntil, the tasks indexed must be acked.
+	LeaseDueBackfillTasks(ctx context.Context, now time.Time, leaseUntil time.Time, count int) (map[string]string, error)
+	AckBackfillTask(ctx context.Context, id string) error
+}
+
+func NewSearchBackfillCache(rdb redis.UniversalClient) SearchBackfillCache {
+	return &searchBackfillCache{rdb: rdb}
+}
+
+type searchBackfillCache struct {
+	rdb redis.UniversalClient
+}
+
+func (c *searchBackfillCache) AddBackfillTask(ctx context.Context, id string, task string, next time.Time) error {
+	pipe := c.rdb.TxPipeline()
+	pipe.HSet(ctx, searchBackfillTask, id, task)
+	pipe.ZAdd(ctx, searchBackfillQueue, redis.Z{Score: float64(next.UnixMilli()), Member: id})
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *searchBackfillCache) LeaseDueBackfillTasks(ctx context.Context, now time.Time, leaseUntil time.Time, count int) (map[string]string, error) {
+	res, err := leaseDueScript.Run(ctx, c.rdb, []string{searchBackfillQueue, searchBackfillTask}, now.UnixMilli(), count, leaseUntil.UnixMilli()).StringSlice()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return pairs(res), nil
+}
+
+func (c *searchBackfillCache) AckBackfillTask(ctx context.Context, id string) error {
+	pipe := c.rdb.TxPipeline()
+	pipe.ZRem(ctx, searchBackfillQueue, id)
+	pipe.HDel(ctx, searchBackfillTask, id)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
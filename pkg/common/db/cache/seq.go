@@ -30,6 +30,8 @@ type SeqCache interface {
 	UserSetHasReadSeqs(ctx context.Context, userID string, hasReadSeqs map[string]int64) error
 	GetHasReadSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error)
 	GetHasReadSeq(ctx context.Context, userID string, conversationID string) (int64, error)
+	// k: user, v: seq, users who have not read anything are omitted
+	GetConversationHasReadSeqs(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error)
 }
 
 func NewSeqCache(rdb redis.UniversalClient) SeqCache {
@@ -180,3 +182,31 @@ func (c *seqCache) GetHasReadSeq(ctx context.Context, userID string, conversatio
 	}
 	return val, nil
 }
+
+func (c *seqCache) GetConversationHasReadSeqs(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error) {
+	if len(userIDs) == 0 {
+		return map[string]int64{}, nil
+	}
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.StringCmd, 0, len(userIDs))
+	for _, userID := range userIDs {
+		cmds = append(cmds, pipe.Get(ctx, c.getHasReadSeqKey(conversationID, userID)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, errs.Wrap(err)
+	}
+	m := make(map[string]int64, len(userIDs))
+	for i, cmd := range cmds {
+		val, err := cmd.Int64()
+		if err != nil {
+			if err == redis.Nil {
+				continue
+			}
+			return nil, errs.Wrap(err)
+		}
+		if val != 0 {
+			m[userIDs[i]] = val
+		}
+	}
+	return m, nil
+}
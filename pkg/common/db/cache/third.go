@@ -12,6 +12,9 @@ type ThirdCache interface {
 	SetFcmToken(ctx context.Context, account string, platformID int, fcmToken string, expireTime int64) (err error)
 	GetFcmToken(ctx context.Context, account string, platformID int) (string, error)
 	DelFcmToken(ctx context.Context, account string, platformID int) error
+	// GetFcmTokenPlatformIDs returns by account the subset of platformIDs the account has a push token registered for,
+	// accounts without any are omitted.
+	GetFcmTokenPlatformIDs(ctx context.Context, accounts []string, platformIDs []int) (map[string][]int, error)
 	IncrUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error)
 	SetUserBadgeUnreadCountSum(ctx context.Context, userID string, value int) error
 	GetUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error)
@@ -45,6 +48,36 @@ func (c *thirdCache) DelFcmToken(ctx context.Context, account string, platformID
 	return errs.Wrap(c.rdb.Del(ctx, FCM_TOKEN+account+":"+strconv.Itoa(platformID)).Err())
 }
 
+// fcmTokenBatchSize bounds the accounts checked in one pipeline.
+const fcmTokenBatchSize = 500
+
+func (c *thirdCache) GetFcmTokenPlatformIDs(ctx context.Context, accounts []string, platformIDs []int) (map[string][]int, error) {
+	exist := make(map[string][]int)
+	if len(platformIDs) == 0 {
+		return exist, nil
+	}
+	for start := 0; start < len(accounts); start += fcmTokenBatchSize {
+		batch := accounts[start:min(start+fcmTokenBatchSize, len(accounts))]
+		pipe := c.rdb.Pipeline()
+		cmds := make([]*redis.IntCmd, 0, len(batch)*len(platformIDs))
+		for _, account := range batch {
+			for _, platformID := range platformIDs {
+				cmds = append(cmds, pipe.Exists(ctx, FCM_TOKEN+account+":"+strconv.Itoa(platformID)))
+			}
+		}
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		for i, cmd := range cmds {
+			if cmd.Val() > 0 {
+				account := batch[i/len(platformIDs)]
+				exist[account] = append(exist[account], platformIDs[i%len(platformIDs)])
+			}
+		}
+	}
+	return exist, nil
+}
+
 func (c *thirdCache) IncrUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error) {
 	seq, err := c.rdb.Incr(ctx, userBadgeUnreadCountSum+userID).Result()
 
@@ -0,0 +1,115 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	threadExpireTime = time.Hour * 12
+)
+
+// ThreadCache caches thread info and the participants thread messages are pushed to.
+type ThreadCache interface {
+	metaCache
+	NewCache() ThreadCache
+	GetThreadInfo(ctx context.Context, threadID string) (*relation.ThreadModel, error)
+	GetThreadsInfo(ctx context.Context, threadIDs []string) ([]*relation.ThreadModel, error)
+	DelThreadsInfo(threadIDs ...string) ThreadCache
+	GetParticipantIDs(ctx context.Context, threadID string) ([]string, error)
+	DelParticipantIDs(threadIDs ...string) ThreadCache
+}
+
+type ThreadCacheRedis struct {
+	metaCache
+	expireTime    time.Duration
+	rcClient      *rockscache.Client
+	threadDB      relation.ThreadModelInterface
+	participantDB relation.ThreadParticipantModelInterface
+}
+
+func NewThreadCacheRedis(rdb redis.UniversalClient, threadDB relation.ThreadModelInterface, participantDB relation.ThreadParticipantModelInterface, options rockscache.Options) ThreadCache {
+	rcClient := rockscache.NewClient(rdb, options)
+	mc := NewMetaCacheRedis(rcClient)
+	mc.SetRawRedisClient(rdb)
+	return &ThreadCacheRedis{
+		metaCache:     mc,
+		expireTime:    threadExpireTime,
+		rcClient:      rcClient,
+		threadDB:      threadDB,
+		participantDB: participantDB,
+	}
+}
+
+func (t *ThreadCacheRedis) NewCache() ThreadCache {
+	return &ThreadCacheRedis{
+		metaCache:     t.Copy(),
+		expireTime:    t.expireTime,
+		rcClient:      t.rcClient,
+		threadDB:      t.threadDB,
+		participantDB: t.participantDB,
+	}
+}
+
+func (t *ThreadCacheRedis) getThreadInfoKey(threadID string) string {
+	return cachekey.GetThreadInfoKey(threadID)
+}
+
+func (t *ThreadCacheRedis) getParticipantIDsKey(threadID string) string {
+	return cachekey.GetThreadParticipantIDsKey(threadID)
+}
+
+func (t *ThreadCacheRedis) GetThreadInfo(ctx context.Context, threadID string) (*relation.ThreadModel, error) {
+	return getCache(ctx, t.rcClient, t.getThreadInfoKey(threadID), t.expireTime, func(ctx context.Context) (*relation.ThreadModel, error) {
+		return t.threadDB.Take(ctx, threadID)
+	})
+}
+
+func (t *ThreadCacheRedis) GetThreadsInfo(ctx context.Context, threadIDs []string) ([]*relation.ThreadModel, error) {
+	return batchGetCache2(ctx, t.rcClient, t.expireTime, threadIDs, func(threadID string) string {
+		return t.getThreadInfoKey(threadID)
+	}, func(ctx context.Context, threadID string) (*relation.ThreadModel, error) {
+		return t.threadDB.Take(ctx, threadID)
+	})
+}
+
+func (t *ThreadCacheRedis) DelThreadsInfo(threadIDs ...string) ThreadCache {
+	cache := t.NewCache()
+	for _, threadID := range threadIDs {
+		cache.AddKeys(t.getThreadInfoKey(threadID))
+	}
+	return cache
+}
+
+func (t *ThreadCacheRedis) GetParticipantIDs(ctx context.Context, threadID string) ([]string, error) {
+	return getCache(ctx, t.rcClient, t.getParticipantIDsKey(threadID), t.expireTime, func(ctx context.Context) ([]string, error) {
+		return t.participantDB.FindUserIDs(ctx, threadID)
+	})
+}
+
+func (t *ThreadCacheRedis) DelParticipantIDs(threadIDs ...string) ThreadCache {
+	cache := t.NewCache()
+	for _, threadID := range threadIDs {
+		cache.AddKeys(t.getParticipantIDsKey(threadID))
+	}
+	return cache
+}
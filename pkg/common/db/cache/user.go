@@ -48,6 +48,8 @@ type UserCache interface {
 	DelUsersInfo(userIDs ...string) UserCache
 	GetUserGlobalRecvMsgOpt(ctx context.Context, userID string) (opt int, err error)
 	DelUsersGlobalRecvMsgOpt(userIDs ...string) UserCache
+	GetUserDoNotDisturb(ctx context.Context, userID string) (*relationtb.DoNotDisturbModel, error)
+	DelUsersDoNotDisturb(userIDs ...string) UserCache
 	GetUserStatus(ctx context.Context, userIDs []string) ([]*user.OnlineStatus, error)
 	SetUserStatus(ctx context.Context, userID string, status, platformID int32) error
 }
@@ -95,6 +97,10 @@ func (u *UserCacheRedis) getUserGlobalRecvMsgOptKey(userID string) string {
 	return cachekey.GetUserGlobalRecvMsgOptKey(userID)
 }
 
+func (u *UserCacheRedis) getUserDoNotDisturbKey(userID string) string {
+	return cachekey.GetUserDoNotDisturbKey(userID)
+}
+
 func (u *UserCacheRedis) GetUserInfo(ctx context.Context, userID string) (userInfo *relationtb.UserModel, err error) {
 	return getCache(ctx, u.rcClient, u.getUserInfoKey(userID), u.expireTime, func(ctx context.Context) (*relationtb.UserModel, error) {
 		return u.userDB.Take(ctx, userID)
@@ -143,6 +149,31 @@ func (u *UserCacheRedis) DelUsersGlobalRecvMsgOpt(userIDs ...string) UserCache {
 	return cache
 }
 
+// GetUserDoNotDisturb returns an empty disabled schedule if the user never set one.
+func (u *UserCacheRedis) GetUserDoNotDisturb(ctx context.Context, userID string) (*relationtb.DoNotDisturbModel, error) {
+	return getCache(ctx, u.rcClient, u.getUserDoNotDisturbKey(userID), u.expireTime, func(ctx context.Context) (*relationtb.DoNotDisturbModel, error) {
+		user, err := u.userDB.Take(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if user.DoNotDisturb == nil {
+			return &relationtb.DoNotDisturbModel{}, nil
+		}
+		return user.DoNotDisturb, nil
+	})
+}
+
+func (u *UserCacheRedis) DelUsersDoNotDisturb(userIDs ...string) UserCache {
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		keys = append(keys, u.getUserDoNotDisturbKey(userID))
+	}
+	cache := u.NewCache()
+	cache.AddKeys(keys...)
+
+	return cache
+}
+
 // GetUserStatus get user status.
 func (u *UserCacheRedis) GetUserStatus(ctx context.Context, userIDs []string) ([]*user.OnlineStatus, error) {
 	userStatus := make([]*user.OnlineStatus, 0, len(userIDs))
@@ -0,0 +1,104 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type ExportJobDatabase interface {
+	CreateExportJob(ctx context.Context, job *relation.ExportJobModel) error
+	TakeExportJob(ctx context.Context, jobID string) (*relation.ExportJobModel, error)
+	PageExportJobs(ctx context.Context, pagination pagination.Pagination) (int64, []*relation.ExportJobModel, error)
+	// TakePendingExportJob claims a job to run, including a running one not kept alive since staleBefore,
+	// nil is returned when none is pending.
+	TakePendingExportJob(ctx context.Context, staleBefore time.Time) (*relation.ExportJobModel, error)
+	// KeepExportJobAlive refreshes the update time of a running job so that it is not claimed again.
+	KeepExportJobAlive(ctx context.Context, jobID string) error
+	SetExportJobDone(ctx context.Context, job *relation.ExportJobModel) error
+	SetExportJobFailed(ctx context.Context, jobID string, errMsg string) error
+	// GetConversationDocIndexRange returns the doc index range of the conversation, max is below min when it has none.
+	GetConversationDocIndexRange(ctx context.Context, conversationID string) (int64, int64, error)
+	// FindConversationDocs returns the msg docs within the index range in seq order,
+	// with only the msgs sent within [startTime, endTime] where 0 is unbounded.
+	FindConversationDocs(ctx context.Context, conversationID string, begin int64, end int64, startTime int64, endTime int64) ([]*relation.MsgDocModel, error)
+}
+
+func NewExportJobDatabase(exportJob relation.ExportJobModelInterface, msgDoc relation.MsgDocModelInterface) ExportJobDatabase {
+	return &exportJobDatabase{exportJob: exportJob, msgDoc: msgDoc}
+}
+
+type exportJobDatabase struct {
+	exportJob relation.ExportJobModelInterface
+	msgDoc    relation.MsgDocModelInterface
+}
+
+func (e *exportJobDatabase) CreateExportJob(ctx context.Context, job *relation.ExportJobModel) error {
+	return e.exportJob.Create(ctx, job)
+}
+
+func (e *exportJobDatabase) TakeExportJob(ctx context.Context, jobID string) (*relation.ExportJobModel, error) {
+	return e.exportJob.Take(ctx, jobID)
+}
+
+func (e *exportJobDatabase) PageExportJobs(ctx context.Context, pagination pagination.Pagination) (int64, []*relation.ExportJobModel, error) {
+	return e.exportJob.Page(ctx, pagination)
+}
+
+func (e *exportJobDatabase) TakePendingExportJob(ctx context.Context, staleBefore time.Time) (*relation.ExportJobModel, error) {
+	job, err := e.exportJob.TakePending(ctx, staleBefore)
+	if err != nil {
+		if relation.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return job, nil
+}
+
+func (e *exportJobDatabase) KeepExportJobAlive(ctx context.Context, jobID string) error {
+	return e.exportJob.Update(ctx, jobID, map[string]any{})
+}
+
+func (e *exportJobDatabase) SetExportJobDone(ctx context.Context, job *relation.ExportJobModel) error {
+	return e.exportJob.Update(ctx, job.JobID, map[string]any{
+		"status":      relation.ExportJobStatusDone,
+		"object_name": job.ObjectName,
+		"size":        job.Size,
+		"msg_num":     job.MsgNum,
+		"file_num":    job.FileNum,
+		"finish_time": time.Now(),
+	})
+}
+
+func (e *exportJobDatabase) SetExportJobFailed(ctx context.Context, jobID string, errMsg string) error {
+	return e.exportJob.Update(ctx, jobID, map[string]any{
+		"status":      relation.ExportJobStatusFailed,
+		"error":       errMsg,
+		"finish_time": time.Now(),
+	})
+}
+
+func (e *exportJobDatabase) GetConversationDocIndexRange(ctx context.Context, conversationID string) (int64, int64, error) {
+	return e.msgDoc.GetDocIndexRange(ctx, conversationID)
+}
+
+func (e *exportJobDatabase) FindConversationDocs(ctx context.Context, conversationID string, begin int64, end int64, startTime int64, endTime int64) ([]*relation.MsgDocModel, error) {
+	return e.msgDoc.FindConversationDocs(ctx, conversationID, begin, end, startTime, endTime)
+}
@@ -0,0 +1,75 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type LegalHoldDatabase interface {
+	CreateLegalHold(ctx context.Context, hold *relation.LegalHoldModel) error
+	TakeLegalHold(ctx context.Context, holdID string) (*relation.LegalHoldModel, error)
+	UpdateLegalHold(ctx context.Context, holdID string, update map[string]any) error
+	DeleteLegalHold(ctx context.Context, holdID string) error
+	FindActiveLegalHolds(ctx context.Context) ([]*relation.LegalHoldModel, error)
+	SearchLegalHolds(ctx context.Context, targetType int32, targetID string, pagination pagination.Pagination) (int64, []*relation.LegalHoldModel, error)
+	CreateLegalHoldAudits(ctx context.Context, audits []*relation.LegalHoldAuditModel) error
+	SearchLegalHoldAudits(ctx context.Context, holdID string, pagination pagination.Pagination) (int64, []*relation.LegalHoldAuditModel, error)
+}
+
+func NewLegalHoldDatabase(hold relation.LegalHoldModelInterface, audit relation.LegalHoldAuditModelInterface) LegalHoldDatabase {
+	return &legalHoldDatabase{hold: hold, audit: audit}
+}
+
+type legalHoldDatabase struct {
+	hold  relation.LegalHoldModelInterface
+	audit relation.LegalHoldAuditModelInterface
+}
+
+func (l *legalHoldDatabase) CreateLegalHold(ctx context.Context, hold *relation.LegalHoldModel) error {
+	return l.hold.Create(ctx, hold)
+}
+
+func (l *legalHoldDatabase) TakeLegalHold(ctx context.Context, holdID string) (*relation.LegalHoldModel, error) {
+	return l.hold.Take(ctx, holdID)
+}
+
+func (l *legalHoldDatabase) UpdateLegalHold(ctx context.Context, holdID string, update map[string]any) error {
+	return l.hold.Update(ctx, holdID, update)
+}
+
+func (l *legalHoldDatabase) DeleteLegalHold(ctx context.Context, holdID string) error {
+	return l.hold.Delete(ctx, holdID)
+}
+
+func (l *legalHoldDatabase) FindActiveLegalHolds(ctx context.Context) ([]*relation.LegalHoldModel, error) {
+	return l.hold.FindActive(ctx, time.Now())
+}
+
+func (l *legalHoldDatabase) SearchLegalHolds(ctx context.Context, targetType int32, targetID string, pagination pagination.Pagination) (int64, []*relation.LegalHoldModel, error) {
+	return l.hold.Search(ctx, targetType, targetID, pagination)
+}
+
+func (l *legalHoldDatabase) CreateLegalHoldAudits(ctx context.Context, audits []*relation.LegalHoldAuditModel) error {
+	return l.audit.Create(ctx, audits)
+}
+
+func (l *legalHoldDatabase) SearchLegalHoldAudits(ctx context.Context, holdID string, pagination pagination.Pagination) (int64, []*relation.LegalHoldAuditModel, error) {
+	return l.audit.Search(ctx, holdID, pagination)
+}
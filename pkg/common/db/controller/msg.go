@@ -26,6 +26,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/protocol/constant"
 	pbmsg "github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/sdkws"
@@ -49,6 +50,11 @@ type CommonMsgDatabase interface {
 	BatchInsertChat2DB(ctx context.Context, conversationID string, msgs []*sdkws.MsgData, currentMaxSeq int64) error
 	// RevokeMsg revokes a message in a conversation.
 	RevokeMsg(ctx context.Context, conversationID string, seq int64, revoke *relation.RevokeModel) error
+	// EditMsg replaces the content of msg if it is unchanged and records the previous version. A msg still only
+	// in redis is written to mongo first, the flush that follows leaves it as it is.
+	EditMsg(ctx context.Context, conversationID string, msg *sdkws.MsgData, newContent string, edit *relation.EditModel, maxEdits int) error
+	// GetMsgEdits returns the previous versions of an edited message, oldest first.
+	GetMsgEdits(ctx context.Context, conversationID string, seq int64) ([]*relation.EditModel, error)
 	// MarkSingleChatMsgsAsRead marks messages as read for a single chat by sequence numbers.
 	MarkSingleChatMsgsAsRead(ctx context.Context, userID string, conversationID string, seqs []int64) error
 	// DeleteMessagesFromCache deletes message caches from Redis by sequence numbers.
@@ -86,6 +92,8 @@ type CommonMsgDatabase interface {
 	SetHasReadSeq(ctx context.Context, userID string, conversationID string, hasReadSeq int64) error
 	GetHasReadSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error)
 	GetHasReadSeq(ctx context.Context, userID string, conversationID string) (int64, error)
+	// GetConversationHasReadSeqs returns the has-read seqs of the users in the conversation, k: user, v: seq.
+	GetConversationHasReadSeqs(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error)
 	UserSetHasReadSeqs(ctx context.Context, userID string, hasReadSeqs map[string]int64) error
 
 	GetMongoMaxAndMinSeq(ctx context.Context, conversationID string) (minSeqMongo, maxSeqMongo int64, err error)
@@ -106,8 +114,13 @@ type CommonMsgDatabase interface {
 	ConvertMsgsDocLen(ctx context.Context, conversationIDs []string)
 
 	// clear msg
-	GetBeforeMsg(ctx context.Context, ts int64, limit int) ([]*relation.MsgDocModel, error)
+	GetBeforeMsg(ctx context.Context, ts int64, skipDocIDPattern string, limit int) ([]*relation.MsgDocModel, error)
 	DeleteDocMsgBefore(ctx context.Context, ts int64, doc *relation.MsgDocModel) ([]int, error)
+	// DeleteConversationMsgsBefore deletes the messages of the conversation sent before ts, only those of contentType unless it is 0.
+	// The min seq is moved forward when all messages are concerned, messages of one content type are cleared in place.
+	// The cached messages are deleted too. The objects the messages refer to are kept: they are stored by content hash,
+	// so the same object backs every message carrying the same file, including forwarded copies in other conversations.
+	DeleteConversationMsgsBefore(ctx context.Context, conversationID string, ts int64, contentType int32) (docNum int, msgNum int, err error)
 }
 
 func NewCommonMsgDatabase(msgDocModel relation.MsgDocModelInterface, msg cache.MsgCache, seq cache.SeqCache, kafkaConf *config.Kafka) (CommonMsgDatabase, error) {
@@ -115,15 +128,15 @@ func NewCommonMsgDatabase(msgDocModel relation.MsgDocModelInterface, msg cache.M
 	if err != nil {
 		return nil, err
 	}
-	producerToRedis, err := kafka.NewKafkaProducer(conf, kafkaConf.Address, kafkaConf.ToRedisTopic)
+	producerToRedis, err := tracing.NewKafkaProducer(conf, kafkaConf.Address, kafkaConf.ToRedisTopic)
 	if err != nil {
 		return nil, err
 	}
-	producerToMongo, err := kafka.NewKafkaProducer(conf, kafkaConf.Address, kafkaConf.ToMongoTopic)
+	producerToMongo, err := tracing.NewKafkaProducer(conf, kafkaConf.Address, kafkaConf.ToMongoTopic)
 	if err != nil {
 		return nil, err
 	}
-	producerToPush, err := kafka.NewKafkaProducer(conf, kafkaConf.Address, kafkaConf.ToPushTopic)
+	producerToPush, err := tracing.NewKafkaProducer(conf, kafkaConf.Address, kafkaConf.ToPushTopic)
 	if err != nil {
 		return nil, err
 	}
@@ -153,10 +166,10 @@ type commonMsgDatabase struct {
 	msgTable         relation.MsgDocModel
 	msg              cache.MsgCache
 	seq              cache.SeqCache
-	producer         *kafka.Producer
-	producerToMongo  *kafka.Producer
-	producerToModify *kafka.Producer
-	producerToPush   *kafka.Producer
+	producer         *tracing.KafkaProducer
+	producerToMongo  *tracing.KafkaProducer
+	producerToModify *tracing.KafkaProducer
+	producerToPush   *tracing.KafkaProducer
 }
 
 func (db *commonMsgDatabase) MsgToMQ(ctx context.Context, key string, msg2mq *sdkws.MsgData) error {
@@ -224,7 +237,8 @@ func (db *commonMsgDatabase) BatchInsertBlock(ctx context.Context, conversationI
 		field := fields[i]
 		switch key {
 		case updateKeyMsg:
-			res, err = db.msgDocDatabase.UpdateMsg(ctx, docID, index, "msg", field)
+			// a msg already written, e.g. edited before it was flushed from redis, is newer than the one flushed
+			return db.msgDocDatabase.SetMsgIfAbsent(ctx, docID, index, field.(*relation.MsgDataModel))
 		case updateKeyRevoke:
 			res, err = db.msgDocDatabase.UpdateMsg(ctx, docID, index, "revoke", field)
 		}
@@ -340,6 +354,33 @@ func (db *commonMsgDatabase) RevokeMsg(ctx context.Context, conversationID strin
 	return db.BatchInsertBlock(ctx, conversationID, []any{revoke}, updateKeyRevoke, seq)
 }
 
+func (db *commonMsgDatabase) EditMsg(ctx context.Context, conversationID string, msg *sdkws.MsgData, newContent string, edit *relation.EditModel, maxEdits int) error {
+	docID := db.msgTable.GetDocID(conversationID, msg.Seq)
+	index := db.msgTable.GetMsgIndex(msg.Seq)
+	res, err := db.msgDocDatabase.EditMsgContent(ctx, docID, index, string(msg.Content), newContent, edit, maxEdits)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		// the msg may not be flushed from redis yet, write it as read and edit it again
+		if err := db.BatchInsertChat2DB(ctx, conversationID, []*sdkws.MsgData{msg}, msg.Seq); err != nil {
+			return err
+		}
+		res, err = db.msgDocDatabase.EditMsgContent(ctx, docID, index, string(msg.Content), newContent, edit, maxEdits)
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount == 0 {
+			return errs.ErrRecordNotFound.WrapMsg("msg not found or modified concurrently", "conversationID", conversationID, "seq", msg.Seq)
+		}
+	}
+	return db.msg.DeleteMessages(ctx, conversationID, []int64{msg.Seq})
+}
+
+func (db *commonMsgDatabase) GetMsgEdits(ctx context.Context, conversationID string, seq int64) ([]*relation.EditModel, error) {
+	return db.msgDocDatabase.GetMsgEdits(ctx, db.msgTable.GetDocID(conversationID, seq), db.msgTable.GetMsgIndex(seq))
+}
+
 func (db *commonMsgDatabase) MarkSingleChatMsgsAsRead(ctx context.Context, userID string, conversationID string, totalSeqs []int64) error {
 	for docID, seqs := range db.msgTable.GetDocIDSeqsMap(conversationID, totalSeqs) {
 		var indexes []int64
@@ -955,6 +996,10 @@ func (db *commonMsgDatabase) GetHasReadSeq(ctx context.Context, userID string, c
 	return db.seq.GetHasReadSeq(ctx, userID, conversationID)
 }
 
+func (db *commonMsgDatabase) GetConversationHasReadSeqs(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error) {
+	return db.seq.GetConversationHasReadSeqs(ctx, conversationID, userIDs)
+}
+
 func (db *commonMsgDatabase) SetSendMsgStatus(ctx context.Context, id string, status int32) error {
 	return db.msg.SetSendMsgStatus(ctx, id, status)
 }
@@ -1054,8 +1099,8 @@ func (db *commonMsgDatabase) ConvertMsgsDocLen(ctx context.Context, conversation
 	db.msgDocDatabase.ConvertMsgsDocLen(ctx, conversationIDs)
 }
 
-func (db *commonMsgDatabase) GetBeforeMsg(ctx context.Context, ts int64, limit int) ([]*relation.MsgDocModel, error) {
-	return db.msgDocDatabase.GetBeforeMsg(ctx, ts, limit)
+func (db *commonMsgDatabase) GetBeforeMsg(ctx context.Context, ts int64, skipDocIDPattern string, limit int) ([]*relation.MsgDocModel, error) {
+	return db.msgDocDatabase.GetBeforeMsg(ctx, ts, skipDocIDPattern, limit)
 }
 
 func (db *commonMsgDatabase) DeleteDocMsgBefore(ctx context.Context, ts int64, doc *relation.MsgDocModel) ([]int, error) {
@@ -1084,6 +1129,52 @@ func (db *commonMsgDatabase) DeleteDocMsgBefore(ctx context.Context, ts int64, d
 	}
 }
 
+func (db *commonMsgDatabase) DeleteConversationMsgsBefore(ctx context.Context, conversationID string, ts int64, contentType int32) (int, int, error) {
+	var docNum, msgNum int
+	for {
+		docs, err := db.msgDocDatabase.GetConversationBeforeMsg(ctx, conversationID, ts, contentType, 100)
+		if err != nil {
+			return docNum, msgNum, err
+		}
+		if len(docs) == 0 {
+			return docNum, msgNum, nil
+		}
+		for _, doc := range docs {
+			var index []int
+			if contentType == 0 {
+				index, err = db.DeleteDocMsgBefore(ctx, ts, doc)
+			} else {
+				index, err = db.deleteDocContentTypeMsgBefore(ctx, ts, contentType, doc)
+			}
+			if err != nil {
+				return docNum, msgNum, err
+			}
+			if len(index) == 0 {
+				return docNum, msgNum, errs.ErrInternalServer.WrapMsg("delete doc msg failed", "docID", doc.DocID)
+			}
+			seqs := datautil.Slice(index, func(i int) int64 { return doc.Msg[i].Msg.Seq })
+			if err := db.msg.DeleteMessages(ctx, conversationID, seqs); err != nil {
+				return docNum, msgNum, err
+			}
+			docNum++
+			msgNum += len(index)
+		}
+	}
+}
+
+func (db *commonMsgDatabase) deleteDocContentTypeMsgBefore(ctx context.Context, ts int64, contentType int32, doc *relation.MsgDocModel) ([]int, error) {
+	var index []int
+	for i, message := range doc.Msg {
+		if message.Msg != nil && message.Msg.ContentType == contentType && message.Msg.SendTime < ts {
+			index = append(index, i)
+		}
+	}
+	if len(index) == 0 {
+		return index, nil
+	}
+	return index, db.msgDocDatabase.DeleteMsgsInOneDocByIndex(ctx, doc.DocID, index)
+}
+
 //func (db *commonMsgDatabase) ClearMsg(ctx context.Context, ts int64) (err error) {
 //	var (
 //		docNum int
@@ -1109,12 +1200,11 @@ func (db *commonMsgDatabase) DeleteDocMsgBefore(ctx context.Context, ts int64, d
 //	}
 //}
 
+// setMinSeq moves the min seq of the conversation, which bounds the msgs every member pulls, forward to seq.
+// A conversation without a min seq starts at 0.
 func (db *commonMsgDatabase) setMinSeq(ctx context.Context, conversationID string, seq int64) error {
 	dbSeq, err := db.seq.GetMinSeq(ctx, conversationID)
-	if err != nil {
-		if errors.Is(errs.Unwrap(err), redis.Nil) {
-			return nil
-		}
+	if err != nil && !errors.Is(errs.Unwrap(err), redis.Nil) {
 		return err
 	}
 	if dbSeq >= seq {
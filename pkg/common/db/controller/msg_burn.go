@@ -0,0 +1,68 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+type MsgBurnDatabase interface {
+	// AddBurnMsgs records that userID has read the msgs in (startSeq, endSeq], they are burnt at burnTime.
+	AddBurnMsgs(ctx context.Context, conversationID string, userID string, startSeq, endSeq int64, burnTime time.Time) error
+	FindDueBurnMsgs(ctx context.Context, now time.Time, limit int) ([]*relation.MsgBurnModel, error)
+	DeleteBurnMsgs(ctx context.Context, burn *relation.MsgBurnModel) error
+	// DelayBurnMsgs postpones a range that failed to burn to burnTime, so it no longer blocks the ranges behind it.
+	DelayBurnMsgs(ctx context.Context, burn *relation.MsgBurnModel, burnTime time.Time) error
+	// HoldBurnMsgs postpones a range frozen by a legal hold to burnTime, it is burnt once the hold is released.
+	HoldBurnMsgs(ctx context.Context, burn *relation.MsgBurnModel, burnTime time.Time) error
+}
+
+func NewMsgBurnDatabase(msgBurn relation.MsgBurnModelInterface) MsgBurnDatabase {
+	return &msgBurnDatabase{msgBurn: msgBurn}
+}
+
+type msgBurnDatabase struct {
+	msgBurn relation.MsgBurnModelInterface
+}
+
+func (m *msgBurnDatabase) AddBurnMsgs(ctx context.Context, conversationID string, userID string, startSeq, endSeq int64, burnTime time.Time) error {
+	return m.msgBurn.Create(ctx, &relation.MsgBurnModel{
+		ConversationID: conversationID,
+		UserID:         userID,
+		StartSeq:       startSeq,
+		EndSeq:         endSeq,
+		BurnTime:       burnTime,
+		CreateTime:     time.Now(),
+	})
+}
+
+func (m *msgBurnDatabase) FindDueBurnMsgs(ctx context.Context, now time.Time, limit int) ([]*relation.MsgBurnModel, error) {
+	return m.msgBurn.FindDue(ctx, now, limit)
+}
+
+func (m *msgBurnDatabase) DeleteBurnMsgs(ctx context.Context, burn *relation.MsgBurnModel) error {
+	return m.msgBurn.Delete(ctx, burn.ConversationID, burn.UserID, burn.EndSeq)
+}
+
+func (m *msgBurnDatabase) DelayBurnMsgs(ctx context.Context, burn *relation.MsgBurnModel, burnTime time.Time) error {
+	return m.msgBurn.Delay(ctx, burn.ConversationID, burn.UserID, burn.EndSeq, burnTime)
+}
+
+func (m *msgBurnDatabase) HoldBurnMsgs(ctx context.Context, burn *relation.MsgBurnModel, burnTime time.Time) error {
+	return m.msgBurn.Postpone(ctx, burn.ConversationID, burn.UserID, burn.EndSeq, burnTime)
+}
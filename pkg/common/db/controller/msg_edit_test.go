@@ -0,0 +1,98 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// mockMsgDoc keeps the msg docs in memory.
+type mockMsgDoc struct {
+	relation.MsgDocModelInterface
+	docs map[string]*relation.MsgDocModel
+}
+
+func (m *mockMsgDoc) Create(ctx context.Context, model *relation.MsgDocModel) error {
+	if _, ok := m.docs[model.DocID]; ok {
+		return mongo.WriteException{WriteErrors: []mongo.WriteError{{Code: 11000}}}
+	}
+	m.docs[model.DocID] = model
+	return nil
+}
+
+func (m *mockMsgDoc) SetMsgIfAbsent(ctx context.Context, docID string, index int64, msg *relation.MsgDataModel) (bool, error) {
+	doc, ok := m.docs[docID]
+	if !ok {
+		return false, nil
+	}
+	if doc.Msg[index].Msg == nil {
+		doc.Msg[index].Msg = msg
+	}
+	return true, nil
+}
+
+func (m *mockMsgDoc) EditMsgContent(ctx context.Context, docID string, index int64, oldContent, newContent string, edit *relation.EditModel, maxEdits int) (*mongo.UpdateResult, error) {
+	doc, ok := m.docs[docID]
+	if !ok || doc.Msg[index].Msg == nil || doc.Msg[index].Msg.Content != oldContent {
+		return &mongo.UpdateResult{}, nil
+	}
+	doc.Msg[index].Msg.Content = newContent
+	doc.Msg[index].Edits = append(doc.Msg[index].Edits, edit)
+	return &mongo.UpdateResult{MatchedCount: 1, ModifiedCount: 1}, nil
+}
+
+type mockMsgCache struct {
+	cache.MsgCache
+	deleted []int64
+}
+
+func (m *mockMsgCache) DeleteMessages(ctx context.Context, conversationID string, seqs []int64) error {
+	m.deleted = append(m.deleted, seqs...)
+	return nil
+}
+
+func TestEditMsgNotFlushed(t *testing.T) {
+	ctx := context.Background()
+	docs := &mockMsgDoc{docs: make(map[string]*relation.MsgDocModel)}
+	msgCache := &mockMsgCache{}
+	db := &commonMsgDatabase{msgDocDatabase: docs, msg: msgCache}
+	const conversationID = "si_u1_u2"
+	msg := &sdkws.MsgData{SendID: "u1", RecvID: "u2", Seq: 5, Content: []byte("hello")}
+
+	// the msg is still only in redis
+	err := db.EditMsg(ctx, conversationID, msg, "hello world", &relation.EditModel{Content: "hello", UserID: "u1"}, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{5}, msgCache.deleted)
+	info := docs.docs[db.msgTable.GetDocID(conversationID, 5)].Msg[db.msgTable.GetMsgIndex(5)]
+	assert.Equal(t, "hello world", info.Msg.Content)
+	assert.Len(t, info.Edits, 1)
+
+	// the flush that follows keeps the edited msg
+	assert.NoError(t, db.BatchInsertChat2DB(ctx, conversationID, []*sdkws.MsgData{msg, {Seq: 6, Content: []byte("next")}}, 6))
+	assert.Equal(t, "hello world", info.Msg.Content)
+	assert.Equal(t, "next", docs.docs[db.msgTable.GetDocID(conversationID, 6)].Msg[db.msgTable.GetMsgIndex(6)].Msg.Content)
+
+	// the content read before a concurrent edit no longer matches
+	err = db.EditMsg(ctx, conversationID, msg, "hi", &relation.EditModel{Content: "hello", UserID: "u1"}, 20)
+	assert.Error(t, err)
+	assert.Equal(t, "hello world", info.Msg.Content)
+}
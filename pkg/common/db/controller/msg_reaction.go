@@ -0,0 +1,88 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type MsgReactionDatabase interface {
+	// AddReaction returns false if the user already reacted to the message with the emoji, and errs.ErrArgs
+	// if the user already reacted to it with maxPerUser emojis.
+	AddReaction(ctx context.Context, conversationID string, seq int64, emoji string, userID string, maxPerUser int) (bool, error)
+	// RemoveReaction returns false if the user did not react to the message with the emoji.
+	RemoveReaction(ctx context.Context, conversationID string, seq int64, emoji string, userID string) (bool, error)
+	// GetReactionCounts returns emoji counts by seq, seqs without reactions are omitted.
+	GetReactionCounts(ctx context.Context, conversationID string, seqs []int64) (map[int64]map[string]int64, error)
+	// GetUserEmojis returns the emojis the user reacted with by seq.
+	GetUserEmojis(ctx context.Context, conversationID string, seqs []int64, userID string) (map[int64][]string, error)
+	FindReactionUserIDs(ctx context.Context, conversationID string, seq int64, emoji string, pagination pagination.Pagination) (int64, []string, error)
+}
+
+func NewMsgReactionDatabase(reaction relation.MsgReactionModelInterface, cache cache.MsgReactionCache) MsgReactionDatabase {
+	return &msgReactionDatabase{reaction: reaction, cache: cache}
+}
+
+type msgReactionDatabase struct {
+	reaction relation.MsgReactionModelInterface
+	cache    cache.MsgReactionCache
+}
+
+func (m *msgReactionDatabase) AddReaction(ctx context.Context, conversationID string, seq int64, emoji string, userID string, maxPerUser int) (bool, error) {
+	added, err := m.reaction.Create(ctx, &relation.MsgReactionModel{
+		ConversationID: conversationID,
+		Seq:            seq,
+		Emoji:          emoji,
+		UserID:         userID,
+		CreateTime:     time.Now(),
+	}, maxPerUser)
+	if err != nil || !added {
+		return added, err
+	}
+	return true, m.cache.DelReactionCounts(conversationID, seq).ExecDel(ctx)
+}
+
+func (m *msgReactionDatabase) RemoveReaction(ctx context.Context, conversationID string, seq int64, emoji string, userID string) (bool, error) {
+	removed, err := m.reaction.Delete(ctx, conversationID, seq, emoji, userID)
+	if err != nil || !removed {
+		return removed, err
+	}
+	return true, m.cache.DelReactionCounts(conversationID, seq).ExecDel(ctx)
+}
+
+func (m *msgReactionDatabase) GetReactionCounts(ctx context.Context, conversationID string, seqs []int64) (map[int64]map[string]int64, error) {
+	return m.cache.GetReactionCounts(ctx, conversationID, seqs)
+}
+
+func (m *msgReactionDatabase) GetUserEmojis(ctx context.Context, conversationID string, seqs []int64, userID string) (map[int64][]string, error) {
+	reactions, err := m.reaction.FindUserEmojis(ctx, conversationID, seqs, userID)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64][]string)
+	for _, reaction := range reactions {
+		res[reaction.Seq] = append(res[reaction.Seq], reaction.Emoji)
+	}
+	return res, nil
+}
+
+func (m *msgReactionDatabase) FindReactionUserIDs(ctx context.Context, conversationID string, seq int64, emoji string, pagination pagination.Pagination) (int64, []string, error) {
+	return m.reaction.FindUserIDs(ctx, conversationID, seq, emoji, pagination)
+}
@@ -0,0 +1,145 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockRetentionMsgDoc hands out the docs once and records what is deleted from them.
+type mockRetentionMsgDoc struct {
+	relation.MsgDocModelInterface
+	docs         []*relation.MsgDocModel
+	deletedDocs  []string
+	clearedIndex map[string][]int
+}
+
+func (m *mockRetentionMsgDoc) GetConversationBeforeMsg(ctx context.Context, conversationID string, ts int64, contentType int32, limit int) ([]*relation.MsgDocModel, error) {
+	docs := m.docs
+	m.docs = nil
+	return docs, nil
+}
+
+func (m *mockRetentionMsgDoc) DeleteMsgsInOneDocByIndex(ctx context.Context, docID string, indexes []int) error {
+	m.clearedIndex[docID] = append(m.clearedIndex[docID], indexes...)
+	return nil
+}
+
+func (m *mockRetentionMsgDoc) DeleteMsgByIndex(ctx context.Context, docID string, index []int) error {
+	m.clearedIndex[docID] = append(m.clearedIndex[docID], index...)
+	return nil
+}
+
+func (m *mockRetentionMsgDoc) DeleteDoc(ctx context.Context, docID string) error {
+	m.deletedDocs = append(m.deletedDocs, docID)
+	return nil
+}
+
+type mockRetentionSeq struct {
+	cache.SeqCache
+	minSeq int64
+}
+
+func (m *mockRetentionSeq) GetMinSeq(ctx context.Context, conversationID string) (int64, error) {
+	if m.minSeq == 0 {
+		return 0, errs.Wrap(redis.Nil)
+	}
+	return m.minSeq, nil
+}
+
+func (m *mockRetentionSeq) SetMinSeq(ctx context.Context, conversationID string, minSeq int64) error {
+	m.minSeq = minSeq
+	return nil
+}
+
+func retentionDoc(docID string, firstSeq int64, msgs ...*relation.MsgDataModel) *relation.MsgDocModel {
+	doc := &relation.MsgDocModel{DocID: docID}
+	for i, msg := range msgs {
+		if msg != nil {
+			msg.Seq = firstSeq + int64(i)
+		}
+		doc.Msg = append(doc.Msg, &relation.MsgInfoModel{Msg: msg})
+	}
+	return doc
+}
+
+func TestDeleteConversationMsgsBefore(t *testing.T) {
+	ctx := context.Background()
+	const conversationID = "sg_group1"
+
+	// messages of one content type are cleared in place and removed from the cache
+	docs := &mockRetentionMsgDoc{clearedIndex: make(map[string][]int)}
+	docs.docs = []*relation.MsgDocModel{retentionDoc(conversationID+":0", 1,
+		&relation.MsgDataModel{ContentType: constant.Picture, SendTime: 100},
+		&relation.MsgDataModel{ContentType: constant.Text, SendTime: 100},
+		nil,
+		&relation.MsgDataModel{ContentType: constant.Picture, SendTime: 100},
+		&relation.MsgDataModel{ContentType: constant.Picture, SendTime: 300},
+	)}
+	msgCache := &mockMsgCache{}
+	seq := &mockRetentionSeq{minSeq: 1}
+	db := &commonMsgDatabase{msgDocDatabase: docs, msg: msgCache, seq: seq}
+	docNum, msgNum, err := db.DeleteConversationMsgsBefore(ctx, conversationID, 200, constant.Picture)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, docNum)
+	assert.Equal(t, 2, msgNum)
+	assert.Equal(t, []int{0, 3}, docs.clearedIndex[conversationID+":0"])
+	assert.Equal(t, []int64{1, 4}, msgCache.deleted)
+	assert.Equal(t, int64(1), seq.minSeq)
+
+	// all messages move the min seq forward
+	docs = &mockRetentionMsgDoc{clearedIndex: make(map[string][]int)}
+	docs.docs = []*relation.MsgDocModel{
+		retentionDoc(conversationID+":0", 1,
+			&relation.MsgDataModel{SendTime: 100},
+			&relation.MsgDataModel{SendTime: 100},
+		),
+		retentionDoc(conversationID+":1", 3,
+			&relation.MsgDataModel{SendTime: 100},
+			&relation.MsgDataModel{SendTime: 300},
+		),
+	}
+	msgCache = &mockMsgCache{}
+	db = &commonMsgDatabase{msgDocDatabase: docs, msg: msgCache, seq: seq}
+	docNum, msgNum, err = db.DeleteConversationMsgsBefore(ctx, conversationID, 200, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, docNum)
+	assert.Equal(t, 3, msgNum)
+	assert.Equal(t, []string{conversationID + ":0"}, docs.deletedDocs)
+	assert.Equal(t, []int{0}, docs.clearedIndex[conversationID+":1"])
+	assert.Equal(t, []int64{1, 2, 3}, msgCache.deleted)
+	assert.Equal(t, int64(4), seq.minSeq)
+
+	// a conversation whose min seq was never set starts at 0 and is moved forward too
+	docs = &mockRetentionMsgDoc{clearedIndex: make(map[string][]int)}
+	docs.docs = []*relation.MsgDocModel{retentionDoc(conversationID+":0", 1,
+		&relation.MsgDataModel{SendTime: 100},
+		&relation.MsgDataModel{SendTime: 300},
+	)}
+	seq = &mockRetentionSeq{}
+	db = &commonMsgDatabase{msgDocDatabase: docs, msg: &mockMsgCache{}, seq: seq}
+	_, msgNum, err = db.DeleteConversationMsgsBefore(ctx, conversationID, 200, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, msgNum)
+	assert.Equal(t, int64(2), seq.minSeq)
+}
@@ -0,0 +1,59 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type RetentionDatabase interface {
+	SetRetentionPolicy(ctx context.Context, policy *relation.RetentionPolicyModel) error
+	DeleteRetentionPolicy(ctx context.Context, conversationID string) error
+	FindRetentionPolicies(ctx context.Context, conversationIDs []string) ([]*relation.RetentionPolicyModel, error)
+	PageRetentionPolicies(ctx context.Context, pagination pagination.Pagination) (int64, []*relation.RetentionPolicyModel, error)
+	SetRetentionApplyResult(ctx context.Context, conversationID string, applyTime time.Time, purgedMsgNum int64) error
+}
+
+func NewRetentionDatabase(retention relation.RetentionPolicyModelInterface) RetentionDatabase {
+	return &retentionDatabase{retention: retention}
+}
+
+type retentionDatabase struct {
+	retention relation.RetentionPolicyModelInterface
+}
+
+func (r *retentionDatabase) SetRetentionPolicy(ctx context.Context, policy *relation.RetentionPolicyModel) error {
+	return r.retention.Upsert(ctx, policy)
+}
+
+func (r *retentionDatabase) DeleteRetentionPolicy(ctx context.Context, conversationID string) error {
+	return r.retention.Delete(ctx, conversationID)
+}
+
+func (r *retentionDatabase) FindRetentionPolicies(ctx context.Context, conversationIDs []string) ([]*relation.RetentionPolicyModel, error) {
+	return r.retention.Find(ctx, conversationIDs)
+}
+
+func (r *retentionDatabase) PageRetentionPolicies(ctx context.Context, pagination pagination.Pagination) (int64, []*relation.RetentionPolicyModel, error) {
+	return r.retention.Page(ctx, pagination)
+}
+
+func (r *retentionDatabase) SetRetentionApplyResult(ctx context.Context, conversationID string, applyTime time.Time, purgedMsgNum int64) error {
+	return r.retention.SetApplyResult(ctx, conversationID, applyTime, purgedMsgNum)
+}
@@ -16,11 +16,17 @@ package controller
 
 import (
 	"context"
+	"io"
+	"net/http"
+	"net/url"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/s3"
 	"github.com/openimsdk/tools/s3/cont"
 	"github.com/redis/go-redis/v9"
@@ -34,6 +40,12 @@ type S3Database interface {
 	CompleteMultipartUpload(ctx context.Context, uploadID string, parts []string) (*cont.UploadResult, error)
 	AccessURL(ctx context.Context, name string, expire time.Duration, opt *s3.AccessURLOption) (time.Time, string, error)
 	SetObject(ctx context.Context, info *relation.ObjectModel) error
+	TakeObject(ctx context.Context, name string) (*relation.ObjectModel, error)
+	// PutObject uploads info.Size bytes of body to the key of info through presigned urls and registers the object,
+	// bodies larger than one part are uploaded in parts so that the size is not capped by a single put.
+	PutObject(ctx context.Context, info *relation.ObjectModel, body io.Reader) error
+	// OpenObject returns the content of the object, the caller closes it.
+	OpenObject(ctx context.Context, name string) (io.ReadCloser, error)
 	StatObject(ctx context.Context, name string) (*s3.ObjectInfo, error)
 	FormData(ctx context.Context, name string, size int64, contentType string, duration time.Duration) (*s3.FormData, error)
 }
@@ -41,6 +53,7 @@ type S3Database interface {
 func NewS3Database(rdb redis.UniversalClient, s3 s3.Interface, obj relation.ObjectInfoModelInterface) S3Database {
 	return &s3Database{
 		s3:    cont.New(cache.NewS3Cache(rdb, s3), s3),
+		impl:  s3,
 		cache: cache.NewObjectCacheRedis(rdb, obj),
 		db:    obj,
 	}
@@ -48,6 +61,7 @@ func NewS3Database(rdb redis.UniversalClient, s3 s3.Interface, obj relation.Obje
 
 type s3Database struct {
 	s3    *cont.Controller
+	impl  s3.Interface
 	cache cache.ObjectCache
 	db    relation.ObjectInfoModelInterface
 }
@@ -80,6 +94,146 @@ func (s *s3Database) SetObject(ctx context.Context, info *relation.ObjectModel)
 	return s.cache.DelObjectName(info.Engine, info.Name).ExecDel(ctx)
 }
 
+func (s *s3Database) TakeObject(ctx context.Context, name string) (*relation.ObjectModel, error) {
+	return s.cache.GetName(ctx, s.s3.Engine(), name)
+}
+
+func (s *s3Database) PutObject(ctx context.Context, info *relation.ObjectModel, body io.Reader) error {
+	partSize, err := s.impl.PartSize(ctx, info.Size)
+	if err != nil {
+		return err
+	}
+	if info.Size <= partSize {
+		err = s.putObject(ctx, info, body)
+	} else {
+		err = s.putMultipartObject(ctx, info, body, partSize)
+	}
+	if err != nil {
+		return err
+	}
+	return s.SetObject(ctx, info)
+}
+
+func (s *s3Database) putObject(ctx context.Context, info *relation.ObjectModel, body io.Reader) error {
+	rawURL, err := s.impl.PresignedPutObject(ctx, info.Key, time.Hour)
+	if err != nil {
+		return err
+	}
+	header := make(http.Header)
+	if info.ContentType != "" {
+		header.Set("Content-Type", info.ContentType)
+	}
+	_, err = putURL(ctx, rawURL, header, body, info.Size)
+	return err
+}
+
+func (s *s3Database) putMultipartObject(ctx context.Context, info *relation.ObjectModel, body io.Reader, partSize int64) error {
+	upload, err := s.impl.InitiateMultipartUpload(ctx, info.Key)
+	if err != nil {
+		return err
+	}
+	partNum := int((info.Size + partSize - 1) / partSize)
+	parts := make([]s3.Part, 0, partNum)
+	for partNumber := 1; partNumber <= partNum; partNumber++ {
+		size := min(partSize, info.Size-int64(partNumber-1)*partSize)
+		etag, err := s.putPart(ctx, upload.UploadID, info.Key, partNumber, io.LimitReader(body, size), size)
+		if err != nil {
+			s.abortMultipartUpload(ctx, upload.UploadID, info.Key)
+			return err
+		}
+		parts = append(parts, s3.Part{PartNumber: partNumber, ETag: etag})
+	}
+	if _, err := s.impl.CompleteMultipartUpload(ctx, upload.UploadID, info.Key, parts); err != nil {
+		s.abortMultipartUpload(ctx, upload.UploadID, info.Key)
+		return err
+	}
+	return nil
+}
+
+// putPart uploads one part through the signed url the same way the clients do and returns its etag.
+func (s *s3Database) putPart(ctx context.Context, uploadID string, key string, partNumber int, body io.Reader, size int64) (string, error) {
+	sign, err := s.impl.AuthSign(ctx, uploadID, key, time.Hour, []int{partNumber})
+	if err != nil {
+		return "", err
+	}
+	if len(sign.Parts) != 1 {
+		return "", errs.New("auth sign parts mismatch", "key", key, "partNumber", partNumber).Wrap()
+	}
+	part := sign.Parts[0]
+	rawURL := part.URL
+	if rawURL == "" {
+		rawURL = sign.URL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	query := u.Query()
+	header := make(http.Header)
+	for _, vs := range []url.Values{sign.Query, part.Query} {
+		for k, v := range vs {
+			query[k] = v
+		}
+	}
+	for _, hs := range []http.Header{sign.Header, part.Header} {
+		for k, v := range hs {
+			header[k] = v
+		}
+	}
+	u.RawQuery = query.Encode()
+	respHeader, err := putURL(ctx, u.String(), header, body, size)
+	if err != nil {
+		return "", err
+	}
+	return strings.Trim(respHeader.Get("ETag"), `"`), nil
+}
+
+func (s *s3Database) abortMultipartUpload(ctx context.Context, uploadID string, key string) {
+	if err := s.impl.AbortMultipartUpload(ctx, uploadID, key); err != nil {
+		log.ZWarn(ctx, "abort multipart upload failed", err, "uploadID", uploadID, "key", key)
+	}
+}
+
+func putURL(ctx context.Context, rawURL string, header http.Header, body io.Reader, size int64) (http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, rawURL, body)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	req.ContentLength = size
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "put object failed", "url", req.URL.Path)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return nil, errs.New("put object failed", "url", req.URL.Path, "status", resp.Status).Wrap()
+	}
+	return resp.Header, nil
+}
+
+func (s *s3Database) OpenObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	_, rawURL, err := s.AccessURL(ctx, name, time.Hour, nil)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "get object failed", "name", name)
+	}
+	if resp.StatusCode/100 != 2 {
+		resp.Body.Close()
+		return nil, errs.New("get object failed", "name", name, "status", resp.Status).Wrap()
+	}
+	return resp.Body, nil
+}
+
 func (s *s3Database) AccessURL(ctx context.Context, name string, expire time.Duration, opt *s3.AccessURLOption) (time.Time, string, error) {
 	obj, err := s.cache.GetName(ctx, s.s3.Engine(), name)
 	if err != nil {
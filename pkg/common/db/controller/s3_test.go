@@ -0,0 +1,170 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/s3"
+	"github.com/openimsdk/tools/s3/cont"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockS3 stores the objects put through its signed urls in memory.
+type mockS3 struct {
+	s3.Interface
+	server   *httptest.Server
+	partSize int64
+	mu       sync.Mutex
+	objects  map[string][]byte
+	parts    map[string]map[int][]byte
+	aborted  []string
+}
+
+func newMockS3(partSize int64) *mockS3 {
+	m := &mockS3{partSize: partSize, objects: make(map[string][]byte), parts: make(map[string]map[int][]byte)}
+	m.server = httptest.NewServer(http.HandlerFunc(m.handle))
+	return m
+}
+
+func (m *mockS3) handle(w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil || int64(len(data)) != r.ContentLength {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := r.URL.Path[1:]
+	uploadID := r.URL.Query().Get("uploadId")
+	if uploadID == "" {
+		m.objects[key] = data
+		return
+	}
+	partNumber, _ := strconv.Atoi(r.URL.Query().Get("partNumber"))
+	m.parts[uploadID][partNumber] = data
+	sum := md5.Sum(data)
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+}
+
+func (m *mockS3) Engine() string {
+	return "mock"
+}
+
+func (m *mockS3) PartSize(ctx context.Context, size int64) (int64, error) {
+	return m.partSize, nil
+}
+
+func (m *mockS3) PresignedPutObject(ctx context.Context, name string, expire time.Duration) (string, error) {
+	return m.server.URL + "/" + name, nil
+}
+
+func (m *mockS3) InitiateMultipartUpload(ctx context.Context, name string) (*s3.InitiateMultipartUploadResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	uploadID := "upload" + strconv.Itoa(len(m.parts))
+	m.parts[uploadID] = make(map[int][]byte)
+	return &s3.InitiateMultipartUploadResult{Key: name, UploadID: uploadID}, nil
+}
+
+func (m *mockS3) AuthSign(ctx context.Context, uploadID string, name string, expire time.Duration, partNumbers []int) (*s3.AuthSignResult, error) {
+	res := &s3.AuthSignResult{URL: m.server.URL + "/" + name, Query: url.Values{"uploadId": {uploadID}}}
+	for _, partNumber := range partNumbers {
+		res.Parts = append(res.Parts, s3.SignPart{PartNumber: partNumber, Query: url.Values{"partNumber": {strconv.Itoa(partNumber)}}})
+	}
+	return res, nil
+}
+
+func (m *mockS3) CompleteMultipartUpload(ctx context.Context, uploadID string, name string, parts []s3.Part) (*s3.CompleteMultipartUploadResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var buf bytes.Buffer
+	for i, part := range parts {
+		data := m.parts[uploadID][part.PartNumber]
+		sum := md5.Sum(data)
+		if part.PartNumber != i+1 || part.ETag != hex.EncodeToString(sum[:]) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		buf.Write(data)
+	}
+	m.objects[name] = buf.Bytes()
+	return &s3.CompleteMultipartUploadResult{Key: name}, nil
+}
+
+func (m *mockS3) AbortMultipartUpload(ctx context.Context, uploadID string, name string) error {
+	m.aborted = append(m.aborted, uploadID)
+	return nil
+}
+
+type mockObjectInfo struct {
+	relation.ObjectInfoModelInterface
+	objects map[string]*relation.ObjectModel
+}
+
+func (m *mockObjectInfo) SetObject(ctx context.Context, obj *relation.ObjectModel) error {
+	m.objects[obj.Name] = obj
+	return nil
+}
+
+type mockObjectCache struct {
+	cache.ObjectCache
+}
+
+func (m *mockObjectCache) DelObjectName(engine string, names ...string) cache.ObjectCache {
+	return m
+}
+
+func (m *mockObjectCache) ExecDel(ctx context.Context, distinct ...bool) error {
+	return nil
+}
+
+func TestPutObject(t *testing.T) {
+	impl := newMockS3(10)
+	defer impl.server.Close()
+	objects := &mockObjectInfo{objects: make(map[string]*relation.ObjectModel)}
+	db := &s3Database{s3: cont.New(nil, impl), impl: impl, cache: &mockObjectCache{}, db: objects}
+	ctx := context.Background()
+
+	for _, size := range []int{0, 7, 10, 11, 35} {
+		data := bytes.Repeat([]byte("0123456789abcdef"), 3)[:size]
+		name := "export/" + strconv.Itoa(size) + ".zip"
+		info := &relation.ObjectModel{Name: name, Key: "openim/" + name, Size: int64(size)}
+		assert.NoError(t, db.PutObject(ctx, info, bytes.NewReader(data)), size)
+		assert.Equal(t, data, impl.objects[info.Key], size)
+		assert.Equal(t, "mock", objects.objects[name].Engine)
+	}
+	// 0, 7 and 10 bytes fit in one part
+	assert.Len(t, impl.parts, 2)
+	assert.Len(t, impl.parts["upload1"], 4)
+
+	// a short body fails the part upload and the upload is aborted
+	info := &relation.ObjectModel{Name: "short", Key: "openim/short", Size: 25}
+	assert.Error(t, db.PutObject(ctx, info, bytes.NewReader(make([]byte, 15))))
+	assert.Equal(t, []string{"upload2"}, impl.aborted)
+	assert.NotContains(t, objects.objects, "short")
+}
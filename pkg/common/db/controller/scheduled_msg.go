@@ -0,0 +1,80 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type ScheduledMsgDatabase interface {
+	CreateScheduledMsg(ctx context.Context, msg *relation.ScheduledMsgModel) error
+	TakeScheduledMsg(ctx context.Context, scheduleID string) (*relation.ScheduledMsgModel, error)
+	FindUserScheduledMsgs(ctx context.Context, userID string, status *int32, pagination pagination.Pagination) (int64, []*relation.ScheduledMsgModel, error)
+	// CancelScheduledMsg returns false if the message is no longer pending.
+	CancelScheduledMsg(ctx context.Context, scheduleID string) (bool, error)
+	// RescheduleMsg returns false if the message is no longer pending.
+	RescheduleMsg(ctx context.Context, scheduleID string, sendTime time.Time) (bool, error)
+	// TakeDueScheduledMsg claims a message to send, including one left dispatching since staleBefore,
+	// nil is returned when none is due.
+	TakeDueScheduledMsg(ctx context.Context, now time.Time, staleBefore time.Time) (*relation.ScheduledMsgModel, error)
+	SetScheduledMsgResult(ctx context.Context, scheduleID string, status int32, errMsg string) error
+}
+
+func NewScheduledMsgDatabase(scheduledMsg relation.ScheduledMsgModelInterface) ScheduledMsgDatabase {
+	return &scheduledMsgDatabase{scheduledMsg: scheduledMsg}
+}
+
+type scheduledMsgDatabase struct {
+	scheduledMsg relation.ScheduledMsgModelInterface
+}
+
+func (s *scheduledMsgDatabase) CreateScheduledMsg(ctx context.Context, msg *relation.ScheduledMsgModel) error {
+	return s.scheduledMsg.Create(ctx, msg)
+}
+
+func (s *scheduledMsgDatabase) TakeScheduledMsg(ctx context.Context, scheduleID string) (*relation.ScheduledMsgModel, error) {
+	return s.scheduledMsg.Take(ctx, scheduleID)
+}
+
+func (s *scheduledMsgDatabase) FindUserScheduledMsgs(ctx context.Context, userID string, status *int32, pagination pagination.Pagination) (int64, []*relation.ScheduledMsgModel, error) {
+	return s.scheduledMsg.FindByUser(ctx, userID, status, pagination)
+}
+
+func (s *scheduledMsgDatabase) CancelScheduledMsg(ctx context.Context, scheduleID string) (bool, error) {
+	return s.scheduledMsg.UpdatePending(ctx, scheduleID, map[string]any{"status": relation.ScheduledMsgStatusCanceled})
+}
+
+func (s *scheduledMsgDatabase) RescheduleMsg(ctx context.Context, scheduleID string, sendTime time.Time) (bool, error) {
+	return s.scheduledMsg.UpdatePending(ctx, scheduleID, map[string]any{"send_time": sendTime})
+}
+
+func (s *scheduledMsgDatabase) TakeDueScheduledMsg(ctx context.Context, now time.Time, staleBefore time.Time) (*relation.ScheduledMsgModel, error) {
+	msg, err := s.scheduledMsg.TakeDue(ctx, now, staleBefore)
+	if err != nil {
+		if relation.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (s *scheduledMsgDatabase) SetScheduledMsgResult(ctx context.Context, scheduleID string, status int32, errMsg string) error {
+	return s.scheduledMsg.SetResult(ctx, scheduleID, status, errMsg)
+}
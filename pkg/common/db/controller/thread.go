@@ -0,0 +1,124 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+type ThreadDatabase interface {
+	// CreateThread creates the thread if absent and adds the participants, the existing thread is returned otherwise.
+	CreateThread(ctx context.Context, thread *relation.ThreadModel, participantIDs []string) (*relation.ThreadModel, error)
+	TakeThread(ctx context.Context, threadID string) (*relation.ThreadModel, error)
+	FindThreads(ctx context.Context, threadIDs []string) ([]*relation.ThreadModel, error)
+	FindThreadsByRootSeqs(ctx context.Context, conversationID string, rootSeqs []int64) ([]*relation.ThreadModel, error)
+	// AddReplies updates the reply count and last reply of the thread and makes the senders participants,
+	// msgs at or below the last reply seq are already counted and skipped.
+	AddReplies(ctx context.Context, threadID string, msgs []*sdkws.MsgData) error
+	GetParticipantIDs(ctx context.Context, threadID string) ([]string, error)
+	FindUserThreadIDs(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []string, error)
+}
+
+func NewThreadDatabase(thread relation.ThreadModelInterface, participant relation.ThreadParticipantModelInterface, cache cache.ThreadCache) ThreadDatabase {
+	return &threadDatabase{thread: thread, participant: participant, cache: cache}
+}
+
+type threadDatabase struct {
+	thread      relation.ThreadModelInterface
+	participant relation.ThreadParticipantModelInterface
+	cache       cache.ThreadCache
+}
+
+func (t *threadDatabase) CreateThread(ctx context.Context, thread *relation.ThreadModel, participantIDs []string) (*relation.ThreadModel, error) {
+	created, err := t.thread.Create(ctx, thread)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		if thread, err = t.thread.Take(ctx, thread.ThreadID); err != nil {
+			return nil, err
+		}
+	}
+	if err := t.participant.Upsert(ctx, thread.ThreadID, datautil.Distinct(participantIDs), time.Now()); err != nil {
+		return nil, err
+	}
+	if err := t.cache.DelThreadsInfo(thread.ThreadID).DelParticipantIDs(thread.ThreadID).ExecDel(ctx); err != nil {
+		return nil, err
+	}
+	return thread, nil
+}
+
+func (t *threadDatabase) TakeThread(ctx context.Context, threadID string) (*relation.ThreadModel, error) {
+	return t.cache.GetThreadInfo(ctx, threadID)
+}
+
+func (t *threadDatabase) FindThreads(ctx context.Context, threadIDs []string) ([]*relation.ThreadModel, error) {
+	return t.cache.GetThreadsInfo(ctx, threadIDs)
+}
+
+func (t *threadDatabase) FindThreadsByRootSeqs(ctx context.Context, conversationID string, rootSeqs []int64) ([]*relation.ThreadModel, error) {
+	if len(rootSeqs) == 0 {
+		return nil, nil
+	}
+	return t.thread.FindByRootSeqs(ctx, conversationID, rootSeqs)
+}
+
+func (t *threadDatabase) AddReplies(ctx context.Context, threadID string, msgs []*sdkws.MsgData) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	thread, err := t.thread.Take(ctx, threadID)
+	if err != nil {
+		return err
+	}
+	if thread.LastReply != nil {
+		msgs = datautil.Filter(msgs, func(msg *sdkws.MsgData) (*sdkws.MsgData, bool) { return msg, msg.Seq > thread.LastReply.Seq })
+		if len(msgs) == 0 {
+			return nil
+		}
+	}
+	last := msgs[len(msgs)-1]
+	lastReply := &relation.ThreadReplyModel{
+		Seq:         last.Seq,
+		SendID:      last.SendID,
+		ClientMsgID: last.ClientMsgID,
+		ContentType: last.ContentType,
+		Content:     string(last.Content),
+		SendTime:    last.SendTime,
+	}
+	if err := t.thread.AddReplies(ctx, threadID, msgs[0].Seq, int64(len(msgs)), lastReply); err != nil {
+		return err
+	}
+	senderIDs := datautil.Distinct(datautil.Slice(msgs, func(msg *sdkws.MsgData) string { return msg.SendID }))
+	if err := t.participant.Upsert(ctx, threadID, senderIDs, time.Now()); err != nil {
+		return err
+	}
+	return t.cache.DelThreadsInfo(threadID).DelParticipantIDs(threadID).ExecDel(ctx)
+}
+
+func (t *threadDatabase) GetParticipantIDs(ctx context.Context, threadID string) ([]string, error) {
+	return t.cache.GetParticipantIDs(ctx, threadID)
+}
+
+func (t *threadDatabase) FindUserThreadIDs(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []string, error) {
+	return t.participant.FindThreadIDs(ctx, userID, pagination)
+}
@@ -0,0 +1,142 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockThread keeps the threads in memory and applies the same last reply seq guard as mongo.
+type mockThread struct {
+	relation.ThreadModelInterface
+	threads map[string]*relation.ThreadModel
+}
+
+func (m *mockThread) Create(ctx context.Context, thread *relation.ThreadModel) (bool, error) {
+	if _, ok := m.threads[thread.ThreadID]; ok {
+		return false, nil
+	}
+	m.threads[thread.ThreadID] = thread
+	return true, nil
+}
+
+func (m *mockThread) Take(ctx context.Context, threadID string) (*relation.ThreadModel, error) {
+	thread, ok := m.threads[threadID]
+	if !ok {
+		return nil, errs.ErrRecordNotFound.WrapMsg("thread not found")
+	}
+	res := *thread
+	return &res, nil
+}
+
+func (m *mockThread) AddReplies(ctx context.Context, threadID string, firstSeq int64, count int64, lastReply *relation.ThreadReplyModel) error {
+	thread, ok := m.threads[threadID]
+	if !ok || (thread.LastReply != nil && thread.LastReply.Seq >= firstSeq) {
+		return nil
+	}
+	thread.ReplyCount += count
+	thread.LastReply = lastReply
+	return nil
+}
+
+type mockThreadParticipant struct {
+	relation.ThreadParticipantModelInterface
+	userIDs map[string][]string
+}
+
+func (m *mockThreadParticipant) Upsert(ctx context.Context, threadID string, userIDs []string, activeTime time.Time) error {
+	for _, userID := range userIDs {
+		if !datautil.Contain(userID, m.userIDs[threadID]...) {
+			m.userIDs[threadID] = append(m.userIDs[threadID], userID)
+		}
+	}
+	return nil
+}
+
+type mockThreadCache struct {
+	cache.ThreadCache
+	deleted []string
+}
+
+func (m *mockThreadCache) DelThreadsInfo(threadIDs ...string) cache.ThreadCache {
+	m.deleted = append(m.deleted, threadIDs...)
+	return m
+}
+
+func (m *mockThreadCache) DelParticipantIDs(threadIDs ...string) cache.ThreadCache {
+	return m
+}
+
+func (m *mockThreadCache) ExecDel(ctx context.Context, distinct ...bool) error {
+	return nil
+}
+
+func newMockThreadDatabase() (*threadDatabase, *mockThread, *mockThreadParticipant) {
+	thread := &mockThread{threads: make(map[string]*relation.ThreadModel)}
+	participant := &mockThreadParticipant{userIDs: make(map[string][]string)}
+	return &threadDatabase{thread: thread, participant: participant, cache: &mockThreadCache{}}, thread, participant
+}
+
+func TestCreateThread(t *testing.T) {
+	ctx := context.Background()
+	db, _, participant := newMockThreadDatabase()
+
+	thread, err := db.CreateThread(ctx, &relation.ThreadModel{ThreadID: "th_g1_root", CreatorUserID: "u1"}, []string{"u1", "u2", "u1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "u1", thread.CreatorUserID)
+	assert.Equal(t, []string{"u1", "u2"}, participant.userIDs["th_g1_root"])
+
+	// creating it again returns the existing thread and only adds the new participants
+	thread, err = db.CreateThread(ctx, &relation.ThreadModel{ThreadID: "th_g1_root", CreatorUserID: "u3"}, []string{"u3", "u2"})
+	assert.NoError(t, err)
+	assert.Equal(t, "u1", thread.CreatorUserID)
+	assert.Equal(t, []string{"u1", "u2", "u3"}, participant.userIDs["th_g1_root"])
+}
+
+func TestAddReplies(t *testing.T) {
+	ctx := context.Background()
+	db, threads, participant := newMockThreadDatabase()
+	const threadID = "th_g1_root"
+	_, err := db.CreateThread(ctx, &relation.ThreadModel{ThreadID: threadID}, []string{"u1"})
+	assert.NoError(t, err)
+
+	batch := []*sdkws.MsgData{{Seq: 1, SendID: "u2"}, {Seq: 2, SendID: "u3"}, {Seq: 3, SendID: "u2", Content: []byte("last")}}
+	assert.NoError(t, db.AddReplies(ctx, threadID, batch))
+	assert.Equal(t, int64(3), threads.threads[threadID].ReplyCount)
+	assert.Equal(t, int64(3), threads.threads[threadID].LastReply.Seq)
+	assert.Equal(t, "last", threads.threads[threadID].LastReply.Content)
+	assert.Equal(t, []string{"u1", "u2", "u3"}, participant.userIDs[threadID])
+
+	// a redelivered batch is not counted again
+	assert.NoError(t, db.AddReplies(ctx, threadID, batch))
+	assert.Equal(t, int64(3), threads.threads[threadID].ReplyCount)
+
+	// a batch overlapping the counted replies only counts the new ones
+	assert.NoError(t, db.AddReplies(ctx, threadID, []*sdkws.MsgData{{Seq: 3, SendID: "u2"}, {Seq: 4, SendID: "u4"}}))
+	assert.Equal(t, int64(4), threads.threads[threadID].ReplyCount)
+	assert.Equal(t, int64(4), threads.threads[threadID].LastReply.Seq)
+	assert.Equal(t, []string{"u1", "u2", "u3", "u4"}, participant.userIDs[threadID])
+
+	assert.Error(t, db.AddReplies(ctx, "th_g1_unknown", batch))
+}
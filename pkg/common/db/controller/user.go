@@ -41,6 +41,10 @@ type UserDatabase interface {
 	Create(ctx context.Context, users []*relation.UserModel) (err error)
 	// UpdateByMap update (zero value) external guarantee userID exists
 	UpdateByMap(ctx context.Context, userID string, args map[string]any) (err error)
+	// SetUserDoNotDisturb replace the do not disturb schedule of the user
+	SetUserDoNotDisturb(ctx context.Context, userID string, doNotDisturb *relation.DoNotDisturbModel) (err error)
+	// GetUserDoNotDisturb Get the do not disturb schedule of the user, disabled if never set
+	GetUserDoNotDisturb(ctx context.Context, userID string) (*relation.DoNotDisturbModel, error)
 	// FindUser
 	PageFindUser(ctx context.Context, level1 int64, level2 int64, pagination pagination.Pagination) (count int64, users []*relation.UserModel, err error)
 	// FindUser with keyword
@@ -165,6 +169,19 @@ func (u *userDatabase) UpdateByMap(ctx context.Context, userID string, args map[
 	})
 }
 
+func (u *userDatabase) SetUserDoNotDisturb(ctx context.Context, userID string, doNotDisturb *relation.DoNotDisturbModel) (err error) {
+	return u.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := u.userDB.SetDoNotDisturb(ctx, userID, doNotDisturb); err != nil {
+			return err
+		}
+		return u.cache.DelUsersInfo(userID).DelUsersDoNotDisturb(userID).ExecDel(ctx)
+	})
+}
+
+func (u *userDatabase) GetUserDoNotDisturb(ctx context.Context, userID string) (*relation.DoNotDisturbModel, error) {
+	return u.cache.GetUserDoNotDisturb(ctx, userID)
+}
+
 // Page Gets, returns no error if not found.
 func (u *userDatabase) Page(ctx context.Context, pagination pagination.Pagination) (count int64, users []*relation.UserModel, err error) {
 	return u.userDB.Page(ctx, pagination)
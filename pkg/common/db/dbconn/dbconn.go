@@ -0,0 +1,81 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbconn
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/redisutil"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+// The clients are shared by the services started in the same process with the same config,
+// so openim-allinone keeps one Mongo and one Redis pool instead of one per service.
+var (
+	mongoLock    sync.Mutex
+	mongoClients = make(map[string]*mongoutil.Client)
+	redisLock    sync.Mutex
+	redisClients = make(map[string]redis.UniversalClient)
+)
+
+func configKey(config any) (string, error) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", errs.WrapMsg(err, "marshal db config failed")
+	}
+	return string(data), nil
+}
+
+// NewMongoDB returns the Mongo client of the config, it is created on the first call.
+func NewMongoDB(ctx context.Context, config *mongoutil.Config) (*mongoutil.Client, error) {
+	key, err := configKey(config)
+	if err != nil {
+		return nil, err
+	}
+	mongoLock.Lock()
+	defer mongoLock.Unlock()
+	if cli, ok := mongoClients[key]; ok {
+		return cli, nil
+	}
+	cli, err := mongoutil.NewMongoDB(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	mongoClients[key] = cli
+	return cli, nil
+}
+
+// NewRedisClient returns the Redis client of the config, it is created on the first call.
+func NewRedisClient(ctx context.Context, config *redisutil.Config) (redis.UniversalClient, error) {
+	key, err := configKey(config)
+	if err != nil {
+		return nil, err
+	}
+	redisLock.Lock()
+	defer redisLock.Unlock()
+	if rdb, ok := redisClients[key]; ok {
+		return rdb, nil
+	}
+	rdb, err := redisutil.NewRedisClient(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	redisClients[key] = rdb
+	return rdb, nil
+}
@@ -151,14 +151,15 @@ func (c *ConversationMgo) GetConversationsByConversationID(ctx context.Context,
 func (c *ConversationMgo) GetConversationIDsNeedDestruct(ctx context.Context) ([]*relation.ConversationModel, error) {
 	// "is_msg_destruct = 1 && msg_destruct_time != 0 && (UNIX_TIMESTAMP(NOW()) > (msg_destruct_time + UNIX_TIMESTAMP(latest_msg_destruct_time)) || latest_msg_destruct_time is NULL)"
 	return mongoutil.Find[*relation.ConversationModel](ctx, c.coll, bson.M{
-		"is_msg_destruct":   1,
+		"is_msg_destruct":   true,
 		"msg_destruct_time": bson.M{"$ne": 0},
 		"$or": []bson.M{
 			{
+				// msg_destruct_time is in seconds while date arithmetic is in milliseconds
 				"$expr": bson.M{
 					"$gt": []any{
 						time.Now(),
-						bson.M{"$add": []any{"$msg_destruct_time", "$latest_msg_destruct_time"}},
+						bson.M{"$add": []any{"$latest_msg_destruct_time", bson.M{"$multiply": []any{"$msg_destruct_time", 1000}}}},
 					},
 				},
 			},
@@ -0,0 +1,80 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewExportJobMongo(db *mongo.Database) (relation.ExportJobModelInterface, error) {
+	coll := db.Collection("export_job")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "job_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "create_time", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "update_time", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ExportJobMgo{coll: coll}, nil
+}
+
+type ExportJobMgo struct {
+	coll *mongo.Collection
+}
+
+func (e *ExportJobMgo) Create(ctx context.Context, job *relation.ExportJobModel) error {
+	return mongoutil.InsertMany(ctx, e.coll, []*relation.ExportJobModel{job})
+}
+
+func (e *ExportJobMgo) Take(ctx context.Context, jobID string) (*relation.ExportJobModel, error) {
+	return mongoutil.FindOne[*relation.ExportJobModel](ctx, e.coll, bson.M{"job_id": jobID})
+}
+
+func (e *ExportJobMgo) Page(ctx context.Context, pagination pagination.Pagination) (int64, []*relation.ExportJobModel, error) {
+	opt := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*relation.ExportJobModel](ctx, e.coll, bson.M{}, pagination, opt)
+}
+
+func (e *ExportJobMgo) TakePending(ctx context.Context, staleBefore time.Time) (*relation.ExportJobModel, error) {
+	filter := bson.M{"$or": bson.A{
+		bson.M{"status": relation.ExportJobStatusPending},
+		bson.M{"status": relation.ExportJobStatusRunning, "update_time": bson.M{"$lte": staleBefore}},
+	}}
+	update := bson.M{"$set": bson.M{"status": relation.ExportJobStatusRunning, "update_time": time.Now()}}
+	opt := options.FindOneAndUpdate().SetSort(bson.D{{Key: "create_time", Value: 1}}).SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*relation.ExportJobModel](ctx, e.coll, filter, update, opt)
+}
+
+func (e *ExportJobMgo) Update(ctx context.Context, jobID string, update map[string]any) error {
+	update["update_time"] = time.Now()
+	return mongoutil.UpdateOne(ctx, e.coll, bson.M{"job_id": jobID}, bson.M{"$set": update}, true)
+}
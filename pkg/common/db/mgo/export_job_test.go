@@ -0,0 +1,98 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestExportJobTakePendingReclaim(t *testing.T) {
+	db := testMongoDB(t)
+	ctx := context.Background()
+	_, err := db.Collection("export_job").DeleteMany(ctx, bson.M{})
+	assert.NoError(t, err)
+	e, err := NewExportJobMongo(db)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	assert.NoError(t, e.Create(ctx, &relation.ExportJobModel{JobID: "job1", CreateTime: now, UpdateTime: now}))
+	job, err := e.TakePending(ctx, now.Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, "job1", job.JobID)
+	assert.Equal(t, int32(relation.ExportJobStatusRunning), job.Status)
+
+	// the job is kept alive by its runner
+	_, err = e.TakePending(ctx, time.Now().Add(-time.Minute))
+	assert.True(t, relation.IsNotFound(err))
+
+	// the runner crashed, the job is claimed again once it is stale
+	job, err = e.TakePending(ctx, time.Now().Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, "job1", job.JobID)
+
+	assert.NoError(t, e.Update(ctx, "job1", map[string]any{"status": relation.ExportJobStatusDone}))
+	_, err = e.TakePending(ctx, time.Now().Add(time.Minute))
+	assert.True(t, relation.IsNotFound(err))
+}
+
+func TestFindConversationDocs(t *testing.T) {
+	db := testMongoDB(t)
+	ctx := context.Background()
+	const conversationID = "si_export_test"
+	_, err := db.Collection("msg").DeleteMany(ctx, bson.M{"doc_id": bson.M{"$regex": "^" + conversationID + ":"}})
+	assert.NoError(t, err)
+	m, err := NewMsgMongo(db)
+	assert.NoError(t, err)
+
+	begin, end, err := m.GetDocIndexRange(ctx, conversationID)
+	assert.NoError(t, err)
+	assert.Less(t, end, begin)
+
+	// docs 2, 9 and 10 exist, 10 sorts before 2 and 9 as a string
+	for _, index := range []int64{2, 9, 10} {
+		doc := &relation.MsgDocModel{DocID: relation.MsgDocModel{}.GetDocIDByIndex(conversationID, index)}
+		doc.Msg = []*relation.MsgInfoModel{
+			{Msg: &relation.MsgDataModel{Seq: index*100 + 1, SendTime: index * 1000}},
+			{Msg: &relation.MsgDataModel{Seq: index*100 + 2, SendTime: index*1000 + 500}},
+			{},
+		}
+		assert.NoError(t, m.Create(ctx, doc))
+	}
+	begin, end, err = m.GetDocIndexRange(ctx, conversationID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), begin)
+	assert.Equal(t, int64(10), end)
+
+	docs, err := m.FindConversationDocs(ctx, conversationID, 2, 10, 0, 0)
+	assert.NoError(t, err)
+	if assert.Len(t, docs, 3) {
+		assert.Equal(t, conversationID+":9", docs[1].DocID)
+		assert.Len(t, docs[0].Msg, 2)
+	}
+
+	docs, err = m.FindConversationDocs(ctx, conversationID, 0, 9, 3000, 9000)
+	assert.NoError(t, err)
+	if assert.Len(t, docs, 1) {
+		assert.Equal(t, conversationID+":9", docs[0].DocID)
+		assert.Len(t, docs[0].Msg, 1)
+		assert.Equal(t, int64(901), docs[0].Msg[0].Msg.Seq)
+	}
+}
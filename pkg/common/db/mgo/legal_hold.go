@@ -0,0 +1,123 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewLegalHoldMongo(db *mongo.Database) (relation.LegalHoldModelInterface, error) {
+	coll := db.Collection("legal_hold")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "hold_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "target_type", Value: 1}, {Key: "target_id", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &LegalHoldMgo{coll: coll}, nil
+}
+
+type LegalHoldMgo struct {
+	coll *mongo.Collection
+}
+
+func (l *LegalHoldMgo) Create(ctx context.Context, hold *relation.LegalHoldModel) error {
+	return mongoutil.InsertMany(ctx, l.coll, []*relation.LegalHoldModel{hold})
+}
+
+func (l *LegalHoldMgo) Take(ctx context.Context, holdID string) (*relation.LegalHoldModel, error) {
+	return mongoutil.FindOne[*relation.LegalHoldModel](ctx, l.coll, bson.M{"hold_id": holdID})
+}
+
+func (l *LegalHoldMgo) Update(ctx context.Context, holdID string, update map[string]any) error {
+	if len(update) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, l.coll, bson.M{"hold_id": holdID}, bson.M{"$set": update}, true)
+}
+
+func (l *LegalHoldMgo) Delete(ctx context.Context, holdID string) error {
+	return mongoutil.DeleteOne(ctx, l.coll, bson.M{"hold_id": holdID})
+}
+
+func (l *LegalHoldMgo) FindActive(ctx context.Context, now time.Time) ([]*relation.LegalHoldModel, error) {
+	filter := bson.M{
+		"$or": []bson.M{
+			{"expire_time": time.Time{}},
+			{"expire_time": bson.M{"$gt": now}},
+		},
+	}
+	return mongoutil.Find[*relation.LegalHoldModel](ctx, l.coll, filter)
+}
+
+func (l *LegalHoldMgo) Search(ctx context.Context, targetType int32, targetID string, pagination pagination.Pagination) (int64, []*relation.LegalHoldModel, error) {
+	filter := bson.M{}
+	if targetType != 0 {
+		filter["target_type"] = targetType
+	}
+	if targetID != "" {
+		filter["target_id"] = targetID
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*relation.LegalHoldModel](ctx, l.coll, filter, pagination, opt)
+}
+
+func NewLegalHoldAuditMongo(db *mongo.Database) (relation.LegalHoldAuditModelInterface, error) {
+	coll := db.Collection("legal_hold_audit")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "create_time", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "hold_ids", Value: 1}, {Key: "create_time", Value: -1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &LegalHoldAuditMgo{coll: coll}, nil
+}
+
+type LegalHoldAuditMgo struct {
+	coll *mongo.Collection
+}
+
+func (l *LegalHoldAuditMgo) Create(ctx context.Context, audits []*relation.LegalHoldAuditModel) error {
+	return mongoutil.InsertMany(ctx, l.coll, audits)
+}
+
+func (l *LegalHoldAuditMgo) Search(ctx context.Context, holdID string, pagination pagination.Pagination) (int64, []*relation.LegalHoldAuditModel, error) {
+	filter := bson.M{}
+	if holdID != "" {
+		filter["hold_ids"] = holdID
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*relation.LegalHoldAuditModel](ctx, l.coll, filter, pagination, opt)
+}
@@ -3,6 +3,7 @@ package mgo
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
@@ -82,6 +83,48 @@ func (m *MsgMgo) UpdateMsgContent(ctx context.Context, docID string, index int64
 	return mongoutil.UpdateOne(ctx, m.coll, filter, update, false)
 }
 
+func (m *MsgMgo) SetMsgIfAbsent(ctx context.Context, docID string, index int64, msg *relation.MsgDataModel) (bool, error) {
+	field := fmt.Sprintf("msgs.%d.msg", index)
+	res, err := mongoutil.UpdateOneResult(ctx, m.coll, bson.M{"doc_id": docID, field: nil}, bson.M{"$set": bson.M{field: msg}})
+	if err != nil {
+		return false, err
+	}
+	if res.MatchedCount > 0 {
+		return true, nil
+	}
+	return m.IsExistDocID(ctx, docID)
+}
+
+// EditMsgContent replaces the content of a message that is still oldContent and not revoked,
+// keeping at most maxEdits previous versions.
+func (m *MsgMgo) EditMsgContent(ctx context.Context, docID string, index int64, oldContent, newContent string, edit *relation.EditModel, maxEdits int) (*mongo.UpdateResult, error) {
+	filter := bson.M{
+		"doc_id": docID,
+		fmt.Sprintf("msgs.%d.msg.content", index): oldContent,
+		fmt.Sprintf("msgs.%d.revoke", index):      nil,
+	}
+	update := bson.M{
+		"$set": bson.M{fmt.Sprintf("msgs.%d.msg.content", index): newContent},
+		"$push": bson.M{fmt.Sprintf("msgs.%d.edits", index): bson.M{
+			"$each":  []*relation.EditModel{edit},
+			"$slice": -maxEdits,
+		}},
+	}
+	return mongoutil.UpdateOneResult(ctx, m.coll, filter, update)
+}
+
+func (m *MsgMgo) GetMsgEdits(ctx context.Context, docID string, index int64) ([]*relation.EditModel, error) {
+	opt := options.FindOne().SetProjection(bson.M{"_id": 0, "msgs": bson.M{"$slice": []int64{index, 1}}})
+	doc, err := mongoutil.FindOne[*relation.MsgDocModel](ctx, m.coll, bson.M{"doc_id": docID}, opt)
+	if err != nil {
+		return nil, err
+	}
+	if len(doc.Msg) == 0 || doc.Msg[0] == nil {
+		return nil, nil
+	}
+	return doc.Msg[0].Edits, nil
+}
+
 func (m *MsgMgo) IsExistDocID(ctx context.Context, docID string) (bool, error) {
 	return mongoutil.Exist(ctx, m.coll, bson.M{"doc_id": docID})
 }
@@ -129,6 +172,7 @@ func (m *MsgMgo) GetMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID strin
 		}}},
 		bson.D{{Key: "$project", Value: bson.D{
 			{Key: "msgs.del_list", Value: 0},
+			{Key: "msgs.edits", Value: 0},
 		}}},
 	}
 	msgDocModel, err := mongoutil.Aggregate[*relation.MsgDocModel](ctx, m.coll, pipeline)
@@ -919,14 +963,18 @@ func (m *MsgMgo) ConvertMsgsDocLen(ctx context.Context, conversationIDs []string
 	}
 }
 
-func (m *MsgMgo) GetBeforeMsg(ctx context.Context, ts int64, limit int) ([]*relation.MsgDocModel, error) {
+func (m *MsgMgo) GetBeforeMsg(ctx context.Context, ts int64, skipDocIDPattern string, limit int) ([]*relation.MsgDocModel, error) {
+	match := bson.M{
+		"msgs.msg.send_time": bson.M{
+			"$lt": ts,
+		},
+	}
+	if skipDocIDPattern != "" {
+		match["doc_id"] = bson.M{"$not": primitive.Regex{Pattern: skipDocIDPattern}}
+	}
 	return mongoutil.Aggregate[*relation.MsgDocModel](ctx, m.coll, []bson.M{
 		{
-			"$match": bson.M{
-				"msgs.msg.send_time": bson.M{
-					"$lt": ts,
-				},
-			},
+			"$match": match,
 		},
 		{
 			"$project": bson.M{
@@ -942,16 +990,127 @@ func (m *MsgMgo) GetBeforeMsg(ctx context.Context, ts int64, limit int) ([]*rela
 	})
 }
 
+func (m *MsgMgo) GetConversationBeforeMsg(ctx context.Context, conversationID string, ts int64, contentType int32, limit int) ([]*relation.MsgDocModel, error) {
+	elem := bson.M{"msg.send_time": bson.M{"$lt": ts}}
+	if contentType != 0 {
+		elem["msg.content_type"] = contentType
+	}
+	return mongoutil.Aggregate[*relation.MsgDocModel](ctx, m.coll, []bson.M{
+		{
+			"$match": bson.M{
+				"doc_id": bson.M{"$regex": "^" + regexp.QuoteMeta(conversationID) + ":"},
+				"msgs":   bson.M{"$elemMatch": elem},
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id":                   0,
+				"doc_id":                1,
+				"msgs.msg.send_time":    1,
+				"msgs.msg.seq":          1,
+				"msgs.msg.content_type": 1,
+			},
+		},
+		{
+			"$limit": limit,
+		},
+	})
+}
+
+func (m *MsgMgo) GetDocIndexRange(ctx context.Context, conversationID string) (int64, int64, error) {
+	type indexRange struct {
+		Min int64 `bson:"min"`
+		Max int64 `bson:"max"`
+	}
+	res, err := mongoutil.Aggregate[*indexRange](ctx, m.coll, []bson.M{
+		{
+			"$match": bson.M{
+				"doc_id": bson.M{"$regex": "^" + regexp.QuoteMeta(conversationID) + ":"},
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id": 0,
+				"doc_index": bson.M{
+					"$toLong": bson.M{"$arrayElemAt": bson.A{bson.M{"$split": bson.A{"$doc_id", ":"}}, -1}},
+				},
+			},
+		},
+		{
+			"$group": bson.M{"_id": nil, "min": bson.M{"$min": "$doc_index"}, "max": bson.M{"$max": "$doc_index"}},
+		},
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(res) == 0 {
+		return 0, -1, nil
+	}
+	return res[0].Min, res[0].Max, nil
+}
+
+func (m *MsgMgo) FindConversationDocs(ctx context.Context, conversationID string, begin int64, end int64, startTime int64, endTime int64) ([]*relation.MsgDocModel, error) {
+	if end < begin {
+		return nil, nil
+	}
+	docIDs := make([]string, 0, end-begin+1)
+	for index := begin; index <= end; index++ {
+		docIDs = append(docIDs, relation.MsgDocModel{}.GetDocIDByIndex(conversationID, index))
+	}
+	sendTime := bson.M{}
+	cond := bson.A{bson.M{"$ne": bson.A{"$$msg.msg", nil}}}
+	if startTime > 0 {
+		sendTime["$gte"] = startTime
+		cond = append(cond, bson.M{"$gte": bson.A{"$$msg.msg.send_time", startTime}})
+	}
+	if endTime > 0 {
+		sendTime["$lte"] = endTime
+		cond = append(cond, bson.M{"$lte": bson.A{"$$msg.msg.send_time", endTime}})
+	}
+	match := bson.M{"doc_id": bson.M{"$in": docIDs}}
+	if len(sendTime) > 0 {
+		match["msgs"] = bson.M{"$elemMatch": bson.M{"msg.send_time": sendTime}}
+	}
+	return mongoutil.Aggregate[*relation.MsgDocModel](ctx, m.coll, []bson.M{
+		{
+			"$match": match,
+		},
+		{
+			"$project": bson.M{
+				"_id":    0,
+				"doc_id": 1,
+				"msgs": bson.M{
+					"$filter": bson.M{"input": "$msgs", "as": "msg", "cond": bson.M{"$and": cond}},
+				},
+				"doc_index": bson.M{
+					"$toLong": bson.M{"$arrayElemAt": bson.A{bson.M{"$split": bson.A{"$doc_id", ":"}}, -1}},
+				},
+			},
+		},
+		{
+			"$sort": bson.M{"doc_index": 1},
+		},
+		{
+			"$project": bson.M{"doc_index": 0},
+		},
+	})
+}
+
 func (m *MsgMgo) DeleteMsgByIndex(ctx context.Context, docID string, index []int) error {
 	if len(index) == 0 {
 		return nil
 	}
+	return mongoutil.UpdateOne(ctx, m.coll, bson.M{"doc_id": docID}, deleteMsgByIndexUpdate(index), true)
+}
+
+// deleteMsgByIndexUpdate empties the msgs at the given positions of the doc.
+func deleteMsgByIndexUpdate(index []int) bson.M {
 	model := &relation.MsgInfoModel{DelList: []string{}}
-	set := make(map[string]any)
-	for i := range index {
+	set := make(bson.M, len(index))
+	for _, i := range index {
 		set[fmt.Sprintf("msgs.%d", i)] = model
 	}
-	return mongoutil.UpdateOne(ctx, m.coll, bson.M{"doc_id": docID}, bson.M{"$set": set}, true)
+	return bson.M{"$set": set}
 }
 
 //func (m *MsgMgo) ClearMsg(ctx context.Context, t time.Time) (int64, error) {
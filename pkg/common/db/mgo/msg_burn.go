@@ -0,0 +1,73 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewMsgBurnMongo(db *mongo.Database) (relation.MsgBurnModelInterface, error) {
+	coll := db.Collection("msg_burn")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "conversation_id", Value: 1}, {Key: "user_id", Value: 1}, {Key: "end_seq", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "burn_time", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &MsgBurnMgo{coll: coll}, nil
+}
+
+type MsgBurnMgo struct {
+	coll *mongo.Collection
+}
+
+func (m *MsgBurnMgo) Create(ctx context.Context, burn *relation.MsgBurnModel) error {
+	filter := bson.M{"conversation_id": burn.ConversationID, "user_id": burn.UserID, "end_seq": burn.EndSeq}
+	return mongoutil.UpdateOne(ctx, m.coll, filter, bson.M{"$setOnInsert": burn}, false, options.Update().SetUpsert(true))
+}
+
+func (m *MsgBurnMgo) FindDue(ctx context.Context, now time.Time, limit int) ([]*relation.MsgBurnModel, error) {
+	opt := options.Find().SetSort(bson.D{{Key: "burn_time", Value: 1}}).SetLimit(int64(limit))
+	return mongoutil.Find[*relation.MsgBurnModel](ctx, m.coll, bson.M{"burn_time": bson.M{"$lte": now}}, opt)
+}
+
+func (m *MsgBurnMgo) Delete(ctx context.Context, conversationID string, userID string, endSeq int64) error {
+	return mongoutil.DeleteOne(ctx, m.coll, bson.M{"conversation_id": conversationID, "user_id": userID, "end_seq": endSeq})
+}
+
+func (m *MsgBurnMgo) Delay(ctx context.Context, conversationID string, userID string, endSeq int64, burnTime time.Time) error {
+	filter := bson.M{"conversation_id": conversationID, "user_id": userID, "end_seq": endSeq}
+	update := bson.M{"$set": bson.M{"burn_time": burnTime}, "$inc": bson.M{"attempts": 1}}
+	return mongoutil.UpdateOne(ctx, m.coll, filter, update, false)
+}
+
+func (m *MsgBurnMgo) Postpone(ctx context.Context, conversationID string, userID string, endSeq int64, burnTime time.Time) error {
+	filter := bson.M{"conversation_id": conversationID, "user_id": userID, "end_seq": endSeq}
+	return mongoutil.UpdateOne(ctx, m.coll, filter, bson.M{"$set": bson.M{"burn_time": burnTime}}, false)
+}
@@ -0,0 +1,148 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewMsgReactionMongo(db *mongo.Database) (relation.MsgReactionModelInterface, error) {
+	coll := db.Collection("msg_reaction")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "conversation_id", Value: 1},
+			{Key: "seq", Value: 1},
+			{Key: "emoji", Value: 1},
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	_, err = coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "conversation_id", Value: 1},
+			{Key: "seq", Value: 1},
+			{Key: "user_id", Value: 1},
+			{Key: "slot", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &MsgReactionMgo{coll: coll}, nil
+}
+
+// createReactionRetries bounds the reads of the free slots, each retry follows a concurrent add to the
+// same message by the same user.
+const createReactionRetries = 5
+
+type MsgReactionMgo struct {
+	coll *mongo.Collection
+}
+
+func (m *MsgReactionMgo) filter(conversationID string, seq int64, emoji string, userID string) bson.M {
+	return bson.M{"conversation_id": conversationID, "seq": seq, "emoji": emoji, "user_id": userID}
+}
+
+// Create inserts the reaction into the first slot the user has free below maxPerUser. The unique index on
+// the slots lets only one of the concurrent adds take a slot, the others read the free slots again.
+func (m *MsgReactionMgo) Create(ctx context.Context, reaction *relation.MsgReactionModel, maxPerUser int) (bool, error) {
+	for i := 0; i < createReactionRetries; i++ {
+		reactions, err := m.FindUserEmojis(ctx, reaction.ConversationID, []int64{reaction.Seq}, reaction.UserID)
+		if err != nil {
+			return false, err
+		}
+		slots := make(map[int]struct{}, len(reactions))
+		for _, r := range reactions {
+			if r.Emoji == reaction.Emoji {
+				return false, nil
+			}
+			slots[r.Slot] = struct{}{}
+		}
+		slot := -1
+		for s := 0; s < maxPerUser; s++ {
+			if _, ok := slots[s]; !ok {
+				slot = s
+				break
+			}
+		}
+		if slot < 0 {
+			return false, errs.ErrArgs.WrapMsg("too many reactions to the msg", "maxPerUser", maxPerUser)
+		}
+		reaction.Slot = slot
+		if _, err := m.coll.InsertOne(ctx, reaction); err == nil {
+			return true, nil
+		} else if !mongo.IsDuplicateKeyError(err) {
+			return false, errs.WrapMsg(err, "mongo insert reaction")
+		}
+	}
+	return false, errs.New("add reaction conflicted", "conversationID", reaction.ConversationID, "seq", reaction.Seq, "userID", reaction.UserID).Wrap()
+}
+
+func (m *MsgReactionMgo) Delete(ctx context.Context, conversationID string, seq int64, emoji string, userID string) (bool, error) {
+	res, err := m.coll.DeleteOne(ctx, m.filter(conversationID, seq, emoji, userID))
+	if err != nil {
+		return false, errs.WrapMsg(err, "mongo delete reaction")
+	}
+	return res.DeletedCount > 0, nil
+}
+
+func (m *MsgReactionMgo) CountBySeqs(ctx context.Context, conversationID string, seqs []int64) ([]*relation.MsgReactionCount, error) {
+	if len(seqs) == 0 {
+		return nil, nil
+	}
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.M{"conversation_id": conversationID, "seq": bson.M{"$in": seqs}}}},
+		bson.D{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"seq": "$seq", "emoji": "$emoji"},
+			"count": bson.M{"$sum": 1},
+		}}},
+		bson.D{{Key: "$project", Value: bson.M{"_id": 0, "seq": "$_id.seq", "emoji": "$_id.emoji", "count": 1}}},
+	}
+	return mongoutil.Aggregate[*relation.MsgReactionCount](ctx, m.coll, pipeline)
+}
+
+func (m *MsgReactionMgo) FindUserEmojis(ctx context.Context, conversationID string, seqs []int64, userID string) ([]*relation.MsgReactionModel, error) {
+	if len(seqs) == 0 {
+		return nil, nil
+	}
+	filter := bson.M{"conversation_id": conversationID, "seq": bson.M{"$in": seqs}, "user_id": userID}
+	return mongoutil.Find[*relation.MsgReactionModel](ctx, m.coll, filter)
+}
+
+func (m *MsgReactionMgo) FindUserIDs(ctx context.Context, conversationID string, seq int64, emoji string, pagination pagination.Pagination) (int64, []string, error) {
+	filter := bson.M{"conversation_id": conversationID, "seq": seq, "emoji": emoji}
+	opt := options.Find().SetSort(bson.D{{Key: "create_time", Value: 1}}).SetProjection(bson.M{"_id": 0, "user_id": 1})
+	total, reactions, err := mongoutil.FindPage[*relation.MsgReactionModel](ctx, m.coll, filter, pagination, opt)
+	if err != nil {
+		return 0, nil, err
+	}
+	userIDs := make([]string, 0, len(reactions))
+	for _, reaction := range reactions {
+		userIDs = append(userIDs, reaction.UserID)
+	}
+	return total, userIDs, nil
+}
@@ -0,0 +1,77 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/errs"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestMsgReactionCreateLimit(t *testing.T) {
+	db := testMongoDB(t)
+	ctx := context.Background()
+	_, err := db.Collection("msg_reaction").DeleteMany(ctx, bson.M{})
+	assert.Nil(t, err)
+	m, err := NewMsgReactionMongo(db)
+	assert.Nil(t, err)
+
+	const maxPerUser = 3
+	var (
+		wg    sync.WaitGroup
+		lock  sync.Mutex
+		added int
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ok, err := m.Create(ctx, &relation.MsgReactionModel{ConversationID: "si_a_b", Seq: 1, Emoji: strconv.Itoa(i), UserID: "a", CreateTime: time.Now()}, maxPerUser)
+			if err != nil {
+				assert.ErrorIs(t, err, errs.ErrArgs)
+				return
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			if ok {
+				added++
+			}
+		}(i)
+	}
+	wg.Wait()
+	// the concurrent adds do not go past the limit
+	assert.Equal(t, maxPerUser, added)
+	reactions, err := m.FindUserEmojis(ctx, "si_a_b", []int64{1}, "a")
+	assert.Nil(t, err)
+	assert.Len(t, reactions, maxPerUser)
+
+	ok, err := m.Create(ctx, &relation.MsgReactionModel{ConversationID: "si_a_b", Seq: 1, Emoji: reactions[0].Emoji, UserID: "a"}, maxPerUser)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	// a removed reaction frees its slot
+	_, err = m.Delete(ctx, "si_a_b", 1, reactions[0].Emoji, "a")
+	assert.Nil(t, err)
+	ok, err = m.Create(ctx, &relation.MsgReactionModel{ConversationID: "si_a_b", Seq: 1, Emoji: "new", UserID: "a"}, maxPerUser)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,61 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestDeleteMsgByIndexUpdate(t *testing.T) {
+	set := deleteMsgByIndexUpdate([]int{3, 7, 99})["$set"].(bson.M)
+	assert.Len(t, set, 3)
+	for _, key := range []string{"msgs.3", "msgs.7", "msgs.99"} {
+		assert.Contains(t, set, key)
+	}
+	// the positions in the slice are not the msg indexes
+	assert.NotContains(t, set, "msgs.0")
+}
+
+func TestDeleteMsgByIndex(t *testing.T) {
+	db := testMongoDB(t)
+	ctx := context.Background()
+	const docID = "si_delete_index_test:0"
+	_, err := db.Collection("msg").DeleteMany(ctx, bson.M{"doc_id": docID})
+	assert.NoError(t, err)
+	m, err := NewMsgMongo(db)
+	assert.NoError(t, err)
+
+	doc := &relation.MsgDocModel{DocID: docID}
+	for seq := int64(1); seq <= 5; seq++ {
+		doc.Msg = append(doc.Msg, &relation.MsgInfoModel{Msg: &relation.MsgDataModel{Seq: seq}})
+	}
+	assert.NoError(t, m.Create(ctx, doc))
+	assert.NoError(t, m.DeleteMsgByIndex(ctx, docID, []int{2, 4}))
+
+	doc, err = m.FindOneByDocID(ctx, docID)
+	assert.NoError(t, err)
+	for i, msg := range doc.Msg {
+		if i == 2 || i == 4 {
+			assert.Nil(t, msg.Msg, i)
+		} else {
+			assert.Equal(t, int64(i+1), msg.Msg.Seq, i)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewRetentionPolicyMongo(db *mongo.Database) (relation.RetentionPolicyModelInterface, error) {
+	coll := db.Collection("retention_policy")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "conversation_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &RetentionPolicyMgo{coll: coll}, nil
+}
+
+type RetentionPolicyMgo struct {
+	coll *mongo.Collection
+}
+
+func (r *RetentionPolicyMgo) Upsert(ctx context.Context, policy *relation.RetentionPolicyModel) error {
+	update := bson.M{
+		"$set": bson.M{
+			"group_id":         policy.GroupID,
+			"rules":            policy.Rules,
+			"operator_user_id": policy.OperatorUserID,
+			"update_time":      policy.UpdateTime,
+		},
+		"$setOnInsert": bson.M{"create_time": policy.CreateTime},
+	}
+	return mongoutil.UpdateOne(ctx, r.coll, bson.M{"conversation_id": policy.ConversationID}, update, false, options.Update().SetUpsert(true))
+}
+
+func (r *RetentionPolicyMgo) Delete(ctx context.Context, conversationID string) error {
+	return mongoutil.DeleteOne(ctx, r.coll, bson.M{"conversation_id": conversationID})
+}
+
+func (r *RetentionPolicyMgo) Find(ctx context.Context, conversationIDs []string) ([]*relation.RetentionPolicyModel, error) {
+	return mongoutil.Find[*relation.RetentionPolicyModel](ctx, r.coll, bson.M{"conversation_id": bson.M{"$in": conversationIDs}})
+}
+
+func (r *RetentionPolicyMgo) Page(ctx context.Context, pagination pagination.Pagination) (int64, []*relation.RetentionPolicyModel, error) {
+	opt := options.Find().SetSort(bson.D{{Key: "conversation_id", Value: 1}})
+	return mongoutil.FindPage[*relation.RetentionPolicyModel](ctx, r.coll, bson.M{}, pagination, opt)
+}
+
+func (r *RetentionPolicyMgo) SetApplyResult(ctx context.Context, conversationID string, applyTime time.Time, purgedMsgNum int64) error {
+	update := bson.M{
+		"$set": bson.M{"last_apply_time": applyTime, "last_purged_msg_num": purgedMsgNum},
+		"$inc": bson.M{"total_purged_msg_num": purgedMsgNum},
+	}
+	return mongoutil.UpdateOne(ctx, r.coll, bson.M{"conversation_id": conversationID}, update, false)
+}
@@ -0,0 +1,98 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewScheduledMsgMongo(db *mongo.Database) (relation.ScheduledMsgModelInterface, error) {
+	coll := db.Collection("scheduled_msg")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "schedule_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "send_time", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "claim_time", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "send_time", Value: -1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ScheduledMsgMgo{coll: coll}, nil
+}
+
+type ScheduledMsgMgo struct {
+	coll *mongo.Collection
+}
+
+func (s *ScheduledMsgMgo) Create(ctx context.Context, msg *relation.ScheduledMsgModel) error {
+	return mongoutil.InsertMany(ctx, s.coll, []*relation.ScheduledMsgModel{msg})
+}
+
+func (s *ScheduledMsgMgo) Take(ctx context.Context, scheduleID string) (*relation.ScheduledMsgModel, error) {
+	return mongoutil.FindOne[*relation.ScheduledMsgModel](ctx, s.coll, bson.M{"schedule_id": scheduleID})
+}
+
+func (s *ScheduledMsgMgo) FindByUser(ctx context.Context, userID string, status *int32, pagination pagination.Pagination) (int64, []*relation.ScheduledMsgModel, error) {
+	filter := bson.M{"user_id": userID}
+	if status != nil {
+		filter["status"] = *status
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "send_time", Value: -1}})
+	return mongoutil.FindPage[*relation.ScheduledMsgModel](ctx, s.coll, filter, pagination, opt)
+}
+
+func (s *ScheduledMsgMgo) UpdatePending(ctx context.Context, scheduleID string, update map[string]any) (bool, error) {
+	update["update_time"] = time.Now()
+	filter := bson.M{"schedule_id": scheduleID, "status": relation.ScheduledMsgStatusPending}
+	res, err := mongoutil.UpdateOneResult(ctx, s.coll, filter, bson.M{"$set": update})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (s *ScheduledMsgMgo) TakeDue(ctx context.Context, now time.Time, staleBefore time.Time) (*relation.ScheduledMsgModel, error) {
+	filter := bson.M{"$or": bson.A{
+		bson.M{"status": relation.ScheduledMsgStatusPending, "send_time": bson.M{"$lte": now}},
+		// claim_time is missing on messages claimed before it was recorded, $not matches them too.
+		bson.M{"status": relation.ScheduledMsgStatusDispatching, "claim_time": bson.M{"$not": bson.M{"$gt": staleBefore}}},
+	}}
+	update := bson.M{"$set": bson.M{"status": relation.ScheduledMsgStatusDispatching, "claim_time": now, "update_time": now}}
+	opt := options.FindOneAndUpdate().SetSort(bson.D{{Key: "send_time", Value: 1}}).SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*relation.ScheduledMsgModel](ctx, s.coll, filter, update, opt)
+}
+
+func (s *ScheduledMsgMgo) SetResult(ctx context.Context, scheduleID string, status int32, errMsg string) error {
+	update := bson.M{"$set": bson.M{"status": status, "error": errMsg, "update_time": time.Now()}}
+	return mongoutil.UpdateOne(ctx, s.coll, bson.M{"schedule_id": scheduleID}, update, false)
+}
@@ -0,0 +1,98 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	testMongoOnce sync.Once
+	testMongo     *mongo.Database
+	testMongoErr  error
+)
+
+// testMongoDB connects to a local mongo once and skips the test when there is none.
+func testMongoDB(t *testing.T) *mongo.Database {
+	testMongoOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+		defer cancel()
+		cli, err := mongo.Connect(ctx, options.Client().ApplyURI("mongodb://127.0.0.1:27017"))
+		if err == nil {
+			err = cli.Ping(ctx, nil)
+		}
+		if err != nil {
+			testMongoErr = err
+			return
+		}
+		testMongo = cli.Database("openim_test")
+	})
+	if testMongoErr != nil {
+		t.Skip("mongo is not available:", testMongoErr)
+	}
+	return testMongo
+}
+
+func TestScheduledMsgTakeDueReclaim(t *testing.T) {
+	db := testMongoDB(t)
+	ctx := context.Background()
+	_, err := db.Collection("scheduled_msg").DeleteMany(ctx, bson.M{})
+	assert.NoError(t, err)
+	s, err := NewScheduledMsgMongo(db)
+	assert.NoError(t, err)
+
+	now := time.Now().Truncate(time.Millisecond)
+	assert.NoError(t, s.Create(ctx, &relation.ScheduledMsgModel{ScheduleID: "due", SendTime: now.Add(-time.Minute)}))
+	assert.NoError(t, s.Create(ctx, &relation.ScheduledMsgModel{ScheduleID: "later", SendTime: now.Add(time.Hour)}))
+
+	msg, err := s.TakeDue(ctx, now, now.Add(-time.Minute*5))
+	assert.NoError(t, err)
+	assert.Equal(t, "due", msg.ScheduleID)
+	assert.Equal(t, int32(relation.ScheduledMsgStatusDispatching), msg.Status)
+	assert.True(t, msg.ClaimTime.Equal(now))
+
+	// the claim is still fresh, nothing else is due
+	_, err = s.TakeDue(ctx, now.Add(time.Minute), now.Add(-time.Minute*4))
+	assert.True(t, relation.IsNotFound(err))
+
+	// the dispatcher crashed, the claim is taken over once it is stale
+	later := now.Add(time.Minute * 6)
+	msg, err = s.TakeDue(ctx, later, later.Add(-time.Minute*5))
+	assert.NoError(t, err)
+	assert.Equal(t, "due", msg.ScheduleID)
+	assert.True(t, msg.ClaimTime.Equal(later))
+
+	// a message dispatched once sent is never claimed again
+	assert.NoError(t, s.SetResult(ctx, "due", relation.ScheduledMsgStatusSent, ""))
+	_, err = s.TakeDue(ctx, later.Add(time.Hour), later.Add(time.Hour))
+	assert.True(t, relation.IsNotFound(err))
+
+	// messages claimed without a claim time are reclaimed as well
+	_, err = db.Collection("scheduled_msg").UpdateOne(ctx, bson.M{"schedule_id": "later"},
+		bson.M{"$set": bson.M{"status": relation.ScheduledMsgStatusDispatching}, "$unset": bson.M{"claim_time": ""}})
+	assert.NoError(t, err)
+	msg, err = s.TakeDue(ctx, now, now.Add(-time.Minute*5))
+	assert.NoError(t, err)
+	assert.Equal(t, "later", msg.ScheduleID)
+}
@@ -0,0 +1,133 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewThreadMongo(db *mongo.Database) (relation.ThreadModelInterface, error) {
+	coll := db.Collection("thread")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "thread_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "conversation_id", Value: 1}, {Key: "root_seq", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ThreadMgo{coll: coll}, nil
+}
+
+type ThreadMgo struct {
+	coll *mongo.Collection
+}
+
+func (t *ThreadMgo) Create(ctx context.Context, thread *relation.ThreadModel) (bool, error) {
+	res, err := t.coll.UpdateOne(ctx, bson.M{"thread_id": thread.ThreadID}, bson.M{"$setOnInsert": thread}, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, errs.WrapMsg(err, "mongo upsert thread")
+	}
+	return res.UpsertedCount > 0, nil
+}
+
+func (t *ThreadMgo) Take(ctx context.Context, threadID string) (*relation.ThreadModel, error) {
+	return mongoutil.FindOne[*relation.ThreadModel](ctx, t.coll, bson.M{"thread_id": threadID})
+}
+
+func (t *ThreadMgo) FindByRootSeqs(ctx context.Context, conversationID string, rootSeqs []int64) ([]*relation.ThreadModel, error) {
+	return mongoutil.Find[*relation.ThreadModel](ctx, t.coll, bson.M{"conversation_id": conversationID, "root_seq": bson.M{"$in": rootSeqs}})
+}
+
+func (t *ThreadMgo) AddReplies(ctx context.Context, threadID string, firstSeq int64, count int64, lastReply *relation.ThreadReplyModel) error {
+	filter := bson.M{
+		"thread_id": threadID,
+		"$or": bson.A{
+			bson.M{"last_reply": nil},
+			bson.M{"last_reply.seq": bson.M{"$lt": firstSeq}},
+		},
+	}
+	update := bson.M{
+		"$inc": bson.M{"reply_count": count},
+		"$set": bson.M{"last_reply": lastReply, "update_time": time.Now()},
+	}
+	return mongoutil.UpdateOne(ctx, t.coll, filter, update, false)
+}
+
+func NewThreadParticipantMongo(db *mongo.Database) (relation.ThreadParticipantModelInterface, error) {
+	coll := db.Collection("thread_participant")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "thread_id", Value: 1}, {Key: "user_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "active_time", Value: -1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ThreadParticipantMgo{coll: coll}, nil
+}
+
+type ThreadParticipantMgo struct {
+	coll *mongo.Collection
+}
+
+func (t *ThreadParticipantMgo) Upsert(ctx context.Context, threadID string, userIDs []string, activeTime time.Time) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(userIDs))
+	for _, userID := range userIDs {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"thread_id": threadID, "user_id": userID}).
+			SetUpdate(bson.M{
+				"$set":         bson.M{"active_time": activeTime},
+				"$setOnInsert": bson.M{"join_time": activeTime},
+			}).
+			SetUpsert(true))
+	}
+	if _, err := t.coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		return errs.WrapMsg(err, "mongo bulk upsert thread participants")
+	}
+	return nil
+}
+
+func (t *ThreadParticipantMgo) FindUserIDs(ctx context.Context, threadID string) ([]string, error) {
+	return mongoutil.Find[string](ctx, t.coll, bson.M{"thread_id": threadID}, options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1}))
+}
+
+func (t *ThreadParticipantMgo) FindThreadIDs(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []string, error) {
+	opt := options.Find().SetSort(bson.D{{Key: "active_time", Value: -1}}).SetProjection(bson.M{"_id": 0, "thread_id": 1})
+	return mongoutil.FindPage[string](ctx, t.coll, bson.M{"user_id": userID}, pagination, opt)
+}
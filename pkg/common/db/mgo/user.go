@@ -136,6 +136,10 @@ func (u *UserMgo) GetUserGlobalRecvMsgOpt(ctx context.Context, userID string) (o
 	return mongoutil.FindOne[int](ctx, u.coll, bson.M{"user_id": userID}, options.FindOne().SetProjection(bson.M{"_id": 0, "global_recv_msg_opt": 1}))
 }
 
+func (u *UserMgo) SetDoNotDisturb(ctx context.Context, userID string, doNotDisturb *relation.DoNotDisturbModel) error {
+	return mongoutil.UpdateOne(ctx, u.coll, bson.M{"user_id": userID}, bson.M{"$set": bson.M{"do_not_disturb": doNotDisturb}}, true)
+}
+
 func (u *UserMgo) CountTotal(ctx context.Context, before *time.Time) (count int64, err error) {
 	if before == nil {
 		return mongoutil.Count(ctx, u.coll, bson.M{})
@@ -0,0 +1,209 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bleve is the embedded search index, stored on local disk.
+package bleve
+
+import (
+	"context"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/highlight/highlighter/html"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/search"
+	"github.com/openimsdk/tools/errs"
+)
+
+const defaultCount = 20
+
+// sortBy orders hits newest first, the document id breaks ties so cursors are stable.
+var sortBy = []string{"-" + search.FieldSendTime, "-_id"}
+
+type Index struct {
+	index bleve.Index
+}
+
+// NewIndex opens the index at the configured path, creating it when it does not exist yet.
+// A bleve index can only be opened by one process at a time.
+func NewIndex(conf *config.Search) (*Index, error) {
+	path := conf.Bleve.Path
+	if path == "" {
+		return nil, errs.New("bleve path is empty")
+	}
+	index, err := bleve.Open(path)
+	if err == bleve.ErrorIndexPathDoesNotExist {
+		index, err = bleve.New(path, newMapping())
+	}
+	if err != nil {
+		return nil, errs.WrapMsg(err, "open bleve index failed", "path", path)
+	}
+	return &Index{index: index}, nil
+}
+
+// newMapping analyzes message text with the cjk analyzer, which splits Chinese, Japanese and Korean text into
+// bigrams and everything else on unicode word boundaries, so both kinds of text can be searched without spaces.
+func newMapping() mapping.IndexMapping {
+	keywordField := bleve.NewKeywordFieldMapping()
+	keywordField.Store = false
+	numericField := bleve.NewNumericFieldMapping()
+	numericField.Store = false
+	contentField := bleve.NewTextFieldMapping()
+	contentField.Analyzer = cjk.AnalyzerName
+	contentField.IncludeTermVectors = true
+
+	doc := bleve.NewDocumentStaticMapping()
+	for _, field := range []string{search.FieldConversationID, search.FieldServerMsgID, search.FieldClientMsgID,
+		search.FieldSendID, search.FieldRecvID, search.FieldGroupID} {
+		doc.AddFieldMappingsAt(field, keywordField)
+	}
+	for _, field := range []string{search.FieldSeq, search.FieldSessionType, search.FieldContentType, search.FieldSendTime} {
+		doc.AddFieldMappingsAt(field, numericField)
+	}
+	doc.AddFieldMappingsAt(search.FieldContent, contentField)
+
+	indexMapping := bleve.NewIndexMapping()
+	indexMapping.DefaultMapping = doc
+	indexMapping.DefaultAnalyzer = keyword.Name
+	return indexMapping
+}
+
+func (i *Index) Index(_ context.Context, docs []*search.MsgDoc) error {
+	batch := i.index.NewBatch()
+	for _, doc := range docs {
+		if err := batch.Index(doc.ID(), doc); err != nil {
+			return errs.WrapMsg(err, "bleve batch index failed", "id", doc.ID())
+		}
+	}
+	return errs.Wrap(i.index.Batch(batch))
+}
+
+func (i *Index) Search(ctx context.Context, q *search.Query) (*search.Result, error) {
+	count := q.Count
+	if count <= 0 {
+		count = defaultCount
+	}
+	// One more hit than asked tells whether there is a next page.
+	req := bleve.NewSearchRequestOptions(buildQuery(q), count+1, 0, false)
+	req.SortBy(sortBy)
+	if q.Cursor != "" {
+		var after []string
+		if err := search.DecodeCursor(q.Cursor, &after); err != nil {
+			return nil, err
+		}
+		req.SearchAfter = after
+	} else if q.Offset > 0 {
+		req.From = q.Offset
+	}
+	if q.Keyword != "" {
+		req.Highlight = bleve.NewHighlightWithStyle(html.Name)
+		req.Highlight.AddField(search.FieldContent)
+	}
+	res, err := i.index.SearchInContext(ctx, req)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "bleve search failed")
+	}
+	result := &search.Result{Total: int64(res.Total)}
+	hits := res.Hits
+	if len(hits) > count {
+		hits = hits[:count]
+		if result.NextCursor, err = search.EncodeCursor(hits[len(hits)-1].Sort); err != nil {
+			return nil, err
+		}
+	}
+	for _, hit := range hits {
+		conversationID, seq, ok := search.ParseDocID(hit.ID)
+		if !ok {
+			continue
+		}
+		result.Hits = append(result.Hits, &search.Hit{
+			ConversationID: conversationID,
+			Seq:            seq,
+			Fragments:      hit.Fragments[search.FieldContent],
+		})
+	}
+	return result, nil
+}
+
+func (i *Index) Close() error {
+	return errs.Wrap(i.index.Close())
+}
+
+func buildQuery(q *search.Query) query.Query {
+	var conjuncts []query.Query
+	if q.Keyword != "" {
+		match := bleve.NewMatchQuery(q.Keyword)
+		match.SetField(search.FieldContent)
+		match.SetOperator(query.MatchQueryOperatorAnd)
+		conjuncts = append(conjuncts, match)
+	}
+	if len(q.ConversationIDs) > 0 {
+		disjuncts := make([]query.Query, 0, len(q.ConversationIDs))
+		for _, conversationID := range q.ConversationIDs {
+			disjuncts = append(disjuncts, termQuery(search.FieldConversationID, conversationID))
+		}
+		conjuncts = append(conjuncts, bleve.NewDisjunctionQuery(disjuncts...))
+	}
+	if q.SendID != "" {
+		conjuncts = append(conjuncts, termQuery(search.FieldSendID, q.SendID))
+	}
+	if q.RecvID != "" {
+		conjuncts = append(conjuncts, termQuery(search.FieldRecvID, q.RecvID))
+	}
+	if q.SessionType != 0 {
+		conjuncts = append(conjuncts, equalQuery(search.FieldSessionType, q.SessionType))
+	}
+	if len(q.ContentTypes) > 0 {
+		disjuncts := make([]query.Query, 0, len(q.ContentTypes))
+		for _, contentType := range q.ContentTypes {
+			disjuncts = append(disjuncts, equalQuery(search.FieldContentType, contentType))
+		}
+		conjuncts = append(conjuncts, bleve.NewDisjunctionQuery(disjuncts...))
+	}
+	if q.StartTime > 0 || q.EndTime > 0 {
+		var start, end *float64
+		if q.StartTime > 0 {
+			v := float64(q.StartTime)
+			start = &v
+		}
+		if q.EndTime > 0 {
+			v := float64(q.EndTime)
+			end = &v
+		}
+		inclusive, exclusive := true, false
+		timeRange := bleve.NewNumericRangeInclusiveQuery(start, end, &inclusive, &exclusive)
+		timeRange.SetField(search.FieldSendTime)
+		conjuncts = append(conjuncts, timeRange)
+	}
+	if len(conjuncts) == 0 {
+		return bleve.NewMatchAllQuery()
+	}
+	return bleve.NewConjunctionQuery(conjuncts...)
+}
+
+func termQuery(field, term string) query.Query {
+	q := bleve.NewTermQuery(term)
+	q.SetField(field)
+	return q
+}
+
+func equalQuery(field string, value int32) query.Query {
+	v, inclusive := float64(value), true
+	q := bleve.NewNumericRangeInclusiveQuery(&v, &v, &inclusive, &inclusive)
+	q.SetField(field)
+	return q
+}
@@ -0,0 +1,75 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bleve
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/search"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexSearch(t *testing.T) {
+	var conf config.Search
+	conf.Bleve.Path = t.TempDir() + "/msg.bleve"
+	index, err := NewIndex(&conf)
+	assert.NoError(t, err)
+	defer index.Close()
+
+	ctx := context.Background()
+	docs := []*search.MsgDoc{
+		{ConversationID: "si_a_b", Seq: 1, SendID: "a", ContentType: constant.Text, SendTime: 1000, Content: "明天下午开会讨论项目进度"},
+		{ConversationID: "si_a_b", Seq: 2, SendID: "b", ContentType: constant.Text, SendTime: 2000, Content: "OK, see you at the meeting"},
+		{ConversationID: "sg_g1", Seq: 1, SendID: "a", ContentType: constant.Text, SendTime: 3000, Content: "项目进度已经更新"},
+		{ConversationID: "sg_g1", Seq: 2, SendID: "b", ContentType: constant.Picture, SendTime: 4000},
+	}
+	assert.NoError(t, index.Index(ctx, docs))
+
+	res, err := index.Search(ctx, &search.Query{Keyword: "项目进度"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), res.Total)
+	assert.Equal(t, "sg_g1", res.Hits[0].ConversationID)
+	assert.Equal(t, "si_a_b", res.Hits[1].ConversationID)
+	assert.True(t, strings.Contains(res.Hits[0].Fragments[0], "<mark>"))
+
+	res, err = index.Search(ctx, &search.Query{Keyword: "Meeting", ConversationIDs: []string{"si_a_b"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), res.Total)
+	assert.Equal(t, int64(2), res.Hits[0].Seq)
+
+	res, err = index.Search(ctx, &search.Query{SendID: "b", ContentTypes: []int32{constant.Picture}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), res.Total)
+
+	// Page through everything two hits at a time.
+	var seen []int64
+	q := &search.Query{StartTime: 1000, EndTime: 4001, Count: 2}
+	for {
+		res, err = index.Search(ctx, q)
+		assert.NoError(t, err)
+		for _, hit := range res.Hits {
+			seen = append(seen, hit.Seq)
+		}
+		if res.NextCursor == "" {
+			break
+		}
+		q.Cursor = res.NextCursor
+	}
+	assert.Equal(t, []int64{2, 1, 2, 1}, seen)
+}
@@ -0,0 +1,317 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package opensearch stores the search index in an OpenSearch or Elasticsearch cluster through its REST api.
+package opensearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/search"
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	defaultIndex   = "openim_msg"
+	defaultTimeout = 10
+	defaultCount   = 20
+)
+
+// indexBody creates the index. The built-in cjk analyzer splits Chinese, Japanese and Korean text into bigrams
+// like the bleve index does.
+const indexBody = `{
+  "mappings": {
+    "dynamic": false,
+    "properties": {
+      "conversation_id": {"type": "keyword"},
+      "seq": {"type": "long"},
+      "server_msg_id": {"type": "keyword"},
+      "client_msg_id": {"type": "keyword"},
+      "send_id": {"type": "keyword"},
+      "recv_id": {"type": "keyword"},
+      "group_id": {"type": "keyword"},
+      "session_type": {"type": "integer"},
+      "content_type": {"type": "integer"},
+      "send_time": {"type": "long"},
+      "content": {"type": "text", "analyzer": "cjk"}
+    }
+  }
+}`
+
+// sortBy orders hits newest first; _id cannot be sorted on in recent versions, conversation and seq break ties.
+var sortBy = []any{
+	map[string]string{search.FieldSendTime: "desc"},
+	map[string]string{search.FieldConversationID: "desc"},
+	map[string]string{search.FieldSeq: "desc"},
+}
+
+type Index struct {
+	conf       *config.Search
+	index      string
+	httpClient *http.Client
+	next       atomic.Uint32
+}
+
+// NewIndex connects to the cluster and creates the index when it does not exist.
+func NewIndex(ctx context.Context, conf *config.Search) (*Index, error) {
+	if len(conf.OpenSearch.Address) == 0 {
+		return nil, errs.New("opensearch address is empty")
+	}
+	timeout := conf.OpenSearch.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	index := conf.OpenSearch.Index
+	if index == "" {
+		index = defaultIndex
+	}
+	i := &Index{
+		conf:       conf,
+		index:      index,
+		httpClient: &http.Client{Timeout: time.Duration(timeout) * time.Second},
+	}
+	status, _, err := i.do(ctx, http.MethodHead, "/"+index, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		status, body, err := i.do(ctx, http.MethodPut, "/"+index, "application/json", []byte(indexBody))
+		if err != nil {
+			return nil, err
+		}
+		// Another instance may have created it in the meantime.
+		if status != http.StatusOK && !bytes.Contains(body, []byte("resource_already_exists_exception")) {
+			return nil, errs.New("create opensearch index failed", "index", index, "status", status, "body", string(body))
+		}
+	}
+	return i, nil
+}
+
+// do sends a request to the next address, moving on to the following ones while they cannot be reached.
+func (i *Index) do(ctx context.Context, method, path, contentType string, body []byte) (int, []byte, error) {
+	addresses := i.conf.OpenSearch.Address
+	start := int(i.next.Add(1))
+	var lastErr error
+	for n := 0; n < len(addresses); n++ {
+		address := strings.TrimSuffix(addresses[(start+n)%len(addresses)], "/")
+		req, err := http.NewRequestWithContext(ctx, method, address+path, bytes.NewReader(body))
+		if err != nil {
+			return 0, nil, errs.Wrap(err)
+		}
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		if i.conf.OpenSearch.Username != "" {
+			req.SetBasicAuth(i.conf.OpenSearch.Username, i.conf.OpenSearch.Password)
+		}
+		resp, err := i.httpClient.Do(req)
+		if err != nil {
+			lastErr = errs.WrapMsg(err, "opensearch request failed", "address", address, "path", path)
+			continue
+		}
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			lastErr = errs.WrapMsg(err, "read opensearch response failed", "address", address, "path", path)
+			continue
+		}
+		return resp.StatusCode, data, nil
+	}
+	return 0, nil, lastErr
+}
+
+func (i *Index) Index(ctx context.Context, docs []*search.MsgDoc) error {
+	if len(docs) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, doc := range docs {
+		action := map[string]any{"index": map[string]string{"_index": i.index, "_id": doc.ID()}}
+		if err := enc.Encode(action); err != nil {
+			return errs.Wrap(err)
+		}
+		if err := enc.Encode(doc); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	status, body, err := i.do(ctx, http.MethodPost, "/_bulk", "application/x-ndjson", buf.Bytes())
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return errs.New("opensearch bulk index failed", "status", status, "body", string(body))
+	}
+	var resp struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			ID    string          `json:"_id"`
+			Error json.RawMessage `json:"error"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return errs.WrapMsg(err, "decode opensearch bulk response failed")
+	}
+	if resp.Errors {
+		for _, item := range resp.Items {
+			for _, result := range item {
+				if len(result.Error) > 0 {
+					return errs.New("opensearch bulk index failed", "id", result.ID, "error", string(result.Error))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (i *Index) Search(ctx context.Context, q *search.Query) (*search.Result, error) {
+	count := q.Count
+	if count <= 0 {
+		count = defaultCount
+	}
+	// One more hit than asked tells whether there is a next page.
+	req := map[string]any{
+		"size":             count + 1,
+		"track_total_hits": true,
+		"_source":          false,
+		"query":            buildQuery(q),
+		"sort":             sortBy,
+	}
+	if q.Cursor != "" {
+		var after []any
+		if err := search.DecodeCursor(q.Cursor, &after); err != nil {
+			return nil, err
+		}
+		req["search_after"] = after
+	} else if q.Offset > 0 {
+		req["from"] = q.Offset
+	}
+	if q.Keyword != "" {
+		req["highlight"] = map[string]any{
+			"pre_tags":  []string{"<mark>"},
+			"post_tags": []string{"</mark>"},
+			"fields":    map[string]any{search.FieldContent: map[string]any{}},
+		}
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	status, body, err := i.do(ctx, http.MethodPost, "/"+i.index+"/_search", "application/json", data)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, errs.New("opensearch search failed", "status", status, "body", string(body))
+	}
+	var resp struct {
+		Hits struct {
+			Total struct {
+				Value int64 `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				ID        string              `json:"_id"`
+				Sort      []any               `json:"sort"`
+				Highlight map[string][]string `json:"highlight"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	// Keeps long sort values such as send_time exact in the cursor.
+	dec.UseNumber()
+	if err := dec.Decode(&resp); err != nil {
+		return nil, errs.WrapMsg(err, "decode opensearch search response failed")
+	}
+	result := &search.Result{Total: resp.Hits.Total.Value}
+	hits := resp.Hits.Hits
+	if len(hits) > count {
+		hits = hits[:count]
+		if result.NextCursor, err = search.EncodeCursor(hits[len(hits)-1].Sort); err != nil {
+			return nil, err
+		}
+	}
+	for _, hit := range hits {
+		conversationID, seq, ok := search.ParseDocID(hit.ID)
+		if !ok {
+			continue
+		}
+		result.Hits = append(result.Hits, &search.Hit{
+			ConversationID: conversationID,
+			Seq:            seq,
+			Fragments:      hit.Highlight[search.FieldContent],
+		})
+	}
+	return result, nil
+}
+
+func (i *Index) Close() error {
+	i.httpClient.CloseIdleConnections()
+	return nil
+}
+
+func buildQuery(q *search.Query) map[string]any {
+	var (
+		must   []any
+		filter []any
+	)
+	if q.Keyword != "" {
+		must = append(must, map[string]any{
+			"match": map[string]any{search.FieldContent: map[string]any{"query": q.Keyword, "operator": "and"}},
+		})
+	}
+	if len(q.ConversationIDs) > 0 {
+		filter = append(filter, map[string]any{"terms": map[string]any{search.FieldConversationID: q.ConversationIDs}})
+	}
+	if q.SendID != "" {
+		filter = append(filter, map[string]any{"term": map[string]any{search.FieldSendID: q.SendID}})
+	}
+	if q.RecvID != "" {
+		filter = append(filter, map[string]any{"term": map[string]any{search.FieldRecvID: q.RecvID}})
+	}
+	if q.SessionType != 0 {
+		filter = append(filter, map[string]any{"term": map[string]any{search.FieldSessionType: q.SessionType}})
+	}
+	if len(q.ContentTypes) > 0 {
+		filter = append(filter, map[string]any{"terms": map[string]any{search.FieldContentType: q.ContentTypes}})
+	}
+	if q.StartTime > 0 || q.EndTime > 0 {
+		timeRange := make(map[string]any)
+		if q.StartTime > 0 {
+			timeRange["gte"] = q.StartTime
+		}
+		if q.EndTime > 0 {
+			timeRange["lt"] = q.EndTime
+		}
+		filter = append(filter, map[string]any{"range": map[string]any{search.FieldSendTime: timeRange}})
+	}
+	if len(must) == 0 && len(filter) == 0 {
+		return map[string]any{"match_all": map[string]any{}}
+	}
+	boolQuery := make(map[string]any)
+	if len(must) > 0 {
+		boolQuery["must"] = must
+	}
+	if len(filter) > 0 {
+		boolQuery["filter"] = filter
+	}
+	return map[string]any{"bool": boolQuery}
+}
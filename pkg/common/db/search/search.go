@@ -0,0 +1,190 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package search defines the full-text message index fed by openim-msgtransfer and queried by /msg/search_msg.
+package search
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	BleveIndex      = "bleve"
+	OpenSearchIndex = "opensearch"
+)
+
+// Fields of an indexed message, shared by the implementations.
+const (
+	FieldConversationID = "conversation_id"
+	FieldSeq            = "seq"
+	FieldServerMsgID    = "server_msg_id"
+	FieldClientMsgID    = "client_msg_id"
+	FieldSendID         = "send_id"
+	FieldRecvID         = "recv_id"
+	FieldGroupID        = "group_id"
+	FieldSessionType    = "session_type"
+	FieldContentType    = "content_type"
+	FieldSendTime       = "send_time"
+	FieldContent        = "content"
+)
+
+// SearchIndex is a full-text index over stored messages. Hits only carry the message position, the messages
+// themselves are read back from MongoDB so deletes, revokes and edits are always reflected.
+type SearchIndex interface {
+	// Index adds or replaces the given messages.
+	Index(ctx context.Context, docs []*MsgDoc) error
+	// Search returns the messages matching q, newest first.
+	Search(ctx context.Context, q *Query) (*Result, error)
+	Close() error
+}
+
+// MsgDoc is one message as stored in the index.
+type MsgDoc struct {
+	ConversationID string `json:"conversation_id"`
+	Seq            int64  `json:"seq"`
+	ServerMsgID    string `json:"server_msg_id"`
+	ClientMsgID    string `json:"client_msg_id"`
+	SendID         string `json:"send_id"`
+	RecvID         string `json:"recv_id"`
+	GroupID        string `json:"group_id"`
+	SessionType    int32  `json:"session_type"`
+	ContentType    int32  `json:"content_type"`
+	SendTime       int64  `json:"send_time"`
+	Content        string `json:"content"`
+}
+
+// ID is the document id, unique per conversation and seq so re-indexing a message replaces it.
+func (d *MsgDoc) ID() string {
+	return d.ConversationID + ":" + strconv.FormatInt(d.Seq, 10)
+}
+
+// ParseDocID splits a document id made by MsgDoc.ID.
+func ParseDocID(id string) (conversationID string, seq int64, ok bool) {
+	i := strings.LastIndexByte(id, ':')
+	if i < 0 {
+		return "", 0, false
+	}
+	seq, err := strconv.ParseInt(id[i+1:], 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return id[:i], seq, true
+}
+
+// Query filters are combined with AND; zero values are ignored.
+type Query struct {
+	// Keyword is tokenized by the index analyzer and matched against the message text.
+	Keyword         string
+	ConversationIDs []string
+	SendID          string
+	RecvID          string
+	SessionType     int32
+	ContentTypes    []int32
+	// StartTime and EndTime are milliseconds, EndTime is exclusive.
+	StartTime int64
+	EndTime   int64
+	// Cursor is the NextCursor of the previous page, empty for the first page.
+	Cursor string
+	// Offset skips hits for callers paging by number, it is ignored when Cursor is set.
+	Offset int
+	Count  int
+}
+
+type Hit struct {
+	ConversationID string
+	Seq            int64
+	// Fragments are parts of the message text with the matched terms wrapped in <mark></mark>.
+	Fragments []string
+}
+
+type Result struct {
+	Total int64
+	Hits  []*Hit
+	// NextCursor is empty on the last page.
+	NextCursor string
+}
+
+// textFields is the json field holding the searchable text of each message content type.
+var textFields = map[int32]string{
+	constant.Text:         "content",
+	constant.AtText:       "text",
+	constant.Quote:        "text",
+	constant.AdvancedText: "text",
+	constant.File:         "fileName",
+	constant.Location:     "description",
+	constant.Custom:       "description",
+	constant.Merger:       "title",
+	constant.Card:         "nickname",
+}
+
+var mediaContentTypes = map[int32]struct{}{
+	constant.Picture: {},
+	constant.Voice:   {},
+	constant.Video:   {},
+}
+
+// NewMsgDoc converts a stored message, it returns nil for messages that are not searchable such as notifications.
+func NewMsgDoc(conversationID string, msg *sdkws.MsgData) *MsgDoc {
+	doc := &MsgDoc{
+		ConversationID: conversationID,
+		Seq:            msg.Seq,
+		ServerMsgID:    msg.ServerMsgID,
+		ClientMsgID:    msg.ClientMsgID,
+		SendID:         msg.SendID,
+		RecvID:         msg.RecvID,
+		GroupID:        msg.GroupID,
+		SessionType:    msg.SessionType,
+		ContentType:    msg.ContentType,
+		SendTime:       msg.SendTime,
+	}
+	if field, ok := textFields[msg.ContentType]; ok {
+		var content map[string]any
+		if err := json.Unmarshal(msg.Content, &content); err == nil {
+			doc.Content, _ = content[field].(string)
+		}
+		return doc
+	}
+	if _, ok := mediaContentTypes[msg.ContentType]; ok {
+		return doc
+	}
+	return nil
+}
+
+// EncodeCursor and DecodeCursor turn the sort values of the last hit of a page into an opaque cursor.
+func EncodeCursor(sortValues any) (string, error) {
+	data, err := json.Marshal(sortValues)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func DecodeCursor(cursor string, sortValues any) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return errs.ErrArgs.WrapMsg("invalid cursor")
+	}
+	if err := json.Unmarshal(data, sortValues); err != nil {
+		return errs.ErrArgs.WrapMsg("invalid cursor")
+	}
+	return nil
+}
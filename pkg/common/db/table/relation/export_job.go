@@ -0,0 +1,67 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+const (
+	ExportTargetConversation = 1
+	ExportTargetUser         = 2
+	ExportTargetGroup        = 3
+)
+
+const (
+	ExportJobStatusPending = 0
+	ExportJobStatusRunning = 1
+	ExportJobStatusDone    = 2
+	ExportJobStatusFailed  = 3
+)
+
+// ExportJobModel is an asynchronous export of chat history into a zip archive stored as an object.
+type ExportJobModel struct {
+	JobID      string `bson:"job_id"`
+	TargetType int32  `bson:"target_type"`
+	TargetID   string `bson:"target_id"`
+	// StartTime and EndTime bound the send time of the exported messages in unix milliseconds, 0 is unbounded.
+	StartTime      int64  `bson:"start_time"`
+	EndTime        int64  `bson:"end_time"`
+	OperatorUserID string `bson:"operator_user_id"`
+	Status         int32  `bson:"status"`
+	Error          string `bson:"error"`
+	// ObjectName is the name of the archive in the object storage once the job is done.
+	ObjectName string    `bson:"object_name"`
+	Size       int64     `bson:"size"`
+	MsgNum     int64     `bson:"msg_num"`
+	FileNum    int64     `bson:"file_num"`
+	CreateTime time.Time `bson:"create_time"`
+	// UpdateTime is refreshed periodically while the job is running.
+	UpdateTime time.Time `bson:"update_time"`
+	FinishTime time.Time `bson:"finish_time"`
+}
+
+type ExportJobModelInterface interface {
+	Create(ctx context.Context, job *ExportJobModel) error
+	Take(ctx context.Context, jobID string) (*ExportJobModel, error)
+	Page(ctx context.Context, pagination pagination.Pagination) (int64, []*ExportJobModel, error)
+	// TakePending claims the oldest pending job by marking it running, a running job not updated since staleBefore
+	// was left behind by a crashed process and is claimed again. The error satisfies IsNotFound when there is none.
+	TakePending(ctx context.Context, staleBefore time.Time) (*ExportJobModel, error)
+	Update(ctx context.Context, jobID string, update map[string]any) error
+}
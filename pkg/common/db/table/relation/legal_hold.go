@@ -0,0 +1,73 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+const (
+	LegalHoldTargetUser         = 1
+	LegalHoldTargetGroup        = 2
+	LegalHoldTargetConversation = 3
+)
+
+// LegalHoldModel freezes the data of a user, a group or a conversation until it is released or expires.
+type LegalHoldModel struct {
+	HoldID         string `bson:"hold_id"`
+	TargetType     int32  `bson:"target_type"`
+	TargetID       string `bson:"target_id"`
+	Reason         string `bson:"reason"`
+	OperatorUserID string `bson:"operator_user_id"`
+	// ExpireTime is zero when the hold lasts until it is released.
+	ExpireTime time.Time `bson:"expire_time"`
+	CreateTime time.Time `bson:"create_time"`
+	UpdateTime time.Time `bson:"update_time"`
+}
+
+func (l *LegalHoldModel) IsActive(now time.Time) bool {
+	return l.ExpireTime.IsZero() || l.ExpireTime.After(now)
+}
+
+// LegalHoldAuditModel records a deletion blocked by legal holds.
+type LegalHoldAuditModel struct {
+	HoldIDs        []string  `bson:"hold_ids"`
+	Operation      string    `bson:"operation"`
+	TargetID       string    `bson:"target_id"`
+	OperatorUserID string    `bson:"operator_user_id"`
+	OperationID    string    `bson:"operation_id"`
+	Detail         string    `bson:"detail"`
+	CreateTime     time.Time `bson:"create_time"`
+}
+
+type LegalHoldModelInterface interface {
+	Create(ctx context.Context, hold *LegalHoldModel) error
+	Take(ctx context.Context, holdID string) (*LegalHoldModel, error)
+	Update(ctx context.Context, holdID string, update map[string]any) error
+	Delete(ctx context.Context, holdID string) error
+	// FindActive returns the holds which are not expired at now.
+	FindActive(ctx context.Context, now time.Time) ([]*LegalHoldModel, error)
+	// Search pages the holds, targetType 0 and empty targetID match all.
+	Search(ctx context.Context, targetType int32, targetID string, pagination pagination.Pagination) (int64, []*LegalHoldModel, error)
+}
+
+type LegalHoldAuditModelInterface interface {
+	Create(ctx context.Context, audits []*LegalHoldAuditModel) error
+	// Search pages the audits newest first, an empty holdID matches all.
+	Search(ctx context.Context, holdID string, pagination pagination.Pagination) (int64, []*LegalHoldAuditModel, error)
+}
@@ -47,6 +47,13 @@ type RevokeModel struct {
 	Time     int64  `bson:"time"`
 }
 
+// EditModel is a previous content of an edited message.
+type EditModel struct {
+	Content string `bson:"content"`
+	UserID  string `bson:"user_id"`
+	Time    int64  `bson:"time"`
+}
+
 type OfflinePushModel struct {
 	Title         string `bson:"title"`
 	Desc          string `bson:"desc"`
@@ -85,6 +92,7 @@ type MsgInfoModel struct {
 	Revoke  *RevokeModel  `bson:"revoke"`
 	DelList []string      `bson:"del_list"`
 	IsRead  bool          `bson:"is_read"`
+	Edits   []*EditModel  `bson:"edits,omitempty"`
 }
 
 type UserCount struct {
@@ -101,8 +109,12 @@ type MsgDocModelInterface interface {
 	PushMsgsToDoc(ctx context.Context, docID string, msgsToMongo []MsgInfoModel) error
 	Create(ctx context.Context, model *MsgDocModel) error
 	UpdateMsg(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error)
+	// SetMsgIfAbsent sets the msg at index unless the doc already has it and reports whether the doc exists.
+	SetMsgIfAbsent(ctx context.Context, docID string, index int64, msg *MsgDataModel) (bool, error)
 	PushUnique(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error)
 	UpdateMsgContent(ctx context.Context, docID string, index int64, msg []byte) error
+	EditMsgContent(ctx context.Context, docID string, index int64, oldContent, newContent string, edit *EditModel, maxEdits int) (*mongo.UpdateResult, error)
+	GetMsgEdits(ctx context.Context, docID string, index int64) ([]*EditModel, error)
 	IsExistDocID(ctx context.Context, docID string) (bool, error)
 	FindOneByDocID(ctx context.Context, docID string) (*MsgDocModel, error)
 	GetMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID string, seqs []int64) ([]*MsgInfoModel, error)
@@ -119,7 +131,17 @@ type MsgDocModelInterface interface {
 
 	DeleteDoc(ctx context.Context, docID string) error
 	DeleteMsgByIndex(ctx context.Context, docID string, index []int) error
-	GetBeforeMsg(ctx context.Context, ts int64, limit int) ([]*MsgDocModel, error)
+	// GetBeforeMsg returns the docs with messages sent before ts, except those whose docID matches the regular
+	// expression skipDocIDPattern, an empty pattern skips nothing.
+	GetBeforeMsg(ctx context.Context, ts int64, skipDocIDPattern string, limit int) ([]*MsgDocModel, error)
+	// GetConversationBeforeMsg returns the docs of the conversation with messages sent before ts,
+	// only messages of contentType are considered unless it is 0.
+	GetConversationBeforeMsg(ctx context.Context, conversationID string, ts int64, contentType int32, limit int) ([]*MsgDocModel, error)
+	// GetDocIndexRange returns the smallest and largest doc index of the conversation, max is below min when it has none.
+	GetDocIndexRange(ctx context.Context, conversationID string) (int64, int64, error)
+	// FindConversationDocs returns the docs of the conversation whose index is within [begin, end] in seq order.
+	// Only the msgs sent within [startTime, endTime] are kept in msgs, 0 is unbounded, and docs without any are left out.
+	FindConversationDocs(ctx context.Context, conversationID string, begin int64, end int64, startTime int64, endTime int64) ([]*MsgDocModel, error)
 
 	//ClearMsg(ctx context.Context, t time.Time) (int64, error)
 }
@@ -163,6 +185,11 @@ func (MsgDocModel) GetMsgIndex(seq int64) int64 {
 	return (seq - 1) % singleGocMsgNum
 }
 
+// GetDocIDByIndex returns the docID of the index-th doc of the conversation.
+func (m MsgDocModel) GetDocIDByIndex(conversationID string, index int64) string {
+	return m.indexGen(conversationID, index)
+}
+
 func (MsgDocModel) indexGen(conversationID string, seqSuffix int64) string {
 	return conversationID + ":" + strconv.FormatInt(seqSuffix, 10)
 }
@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+)
+
+// MsgBurnModel is a range of burn after reading msgs read by UserID, the msgs in (StartSeq, EndSeq]
+// sent by others are physically deleted at BurnTime.
+type MsgBurnModel struct {
+	ConversationID string    `bson:"conversation_id"`
+	UserID         string    `bson:"user_id"`
+	StartSeq       int64     `bson:"start_seq"`
+	EndSeq         int64     `bson:"end_seq"`
+	BurnTime       time.Time `bson:"burn_time"`
+	CreateTime     time.Time `bson:"create_time"`
+	// Attempts counts the failed burns, each failure postpones BurnTime further.
+	Attempts int32 `bson:"attempts"`
+}
+
+type MsgBurnModelInterface interface {
+	Create(ctx context.Context, burn *MsgBurnModel) error
+	// FindDue returns the earliest ranges whose burn time has come.
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*MsgBurnModel, error)
+	Delete(ctx context.Context, conversationID string, userID string, endSeq int64) error
+	// Delay moves the range to burnTime and counts a failed attempt.
+	Delay(ctx context.Context, conversationID string, userID string, endSeq int64, burnTime time.Time) error
+	// Postpone moves the range to burnTime without counting an attempt.
+	Postpone(ctx context.Context, conversationID string, userID string, endSeq int64, burnTime time.Time) error
+}
@@ -0,0 +1,52 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// MsgReactionModel is one emoji reaction of a user to a message.
+type MsgReactionModel struct {
+	ConversationID string `bson:"conversation_id"`
+	Seq            int64  `bson:"seq"`
+	Emoji          string `bson:"emoji"`
+	UserID         string `bson:"user_id"`
+	// Slot numbers the reactions of the user to the message from 0, it is unique per user and message,
+	// so the slots below the limit bound the number of reactions.
+	Slot       int       `bson:"slot"`
+	CreateTime time.Time `bson:"create_time"`
+}
+
+// MsgReactionCount is the number of users reacting to a message with an emoji.
+type MsgReactionCount struct {
+	Seq   int64  `bson:"seq"`
+	Emoji string `bson:"emoji"`
+	Count int64  `bson:"count"`
+}
+
+type MsgReactionModelInterface interface {
+	// Create returns false if the user already reacted with the emoji, and errs.ErrArgs if the user
+	// already reacted to the message with maxPerUser emojis.
+	Create(ctx context.Context, reaction *MsgReactionModel, maxPerUser int) (bool, error)
+	// Delete returns false if the user did not react with the emoji.
+	Delete(ctx context.Context, conversationID string, seq int64, emoji string, userID string) (bool, error)
+	CountBySeqs(ctx context.Context, conversationID string, seqs []int64) ([]*MsgReactionCount, error)
+	FindUserEmojis(ctx context.Context, conversationID string, seqs []int64, userID string) ([]*MsgReactionModel, error)
+	FindUserIDs(ctx context.Context, conversationID string, seq int64, emoji string, pagination pagination.Pagination) (int64, []string, error)
+}
@@ -0,0 +1,50 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// RetentionPolicyModel overrides the global chat records retention for a conversation.
+type RetentionPolicyModel struct {
+	ConversationID string                `bson:"conversation_id"`
+	GroupID        string                `bson:"group_id"`
+	Rules          []*RetentionRuleModel `bson:"rules"`
+	OperatorUserID string                `bson:"operator_user_id"`
+	CreateTime     time.Time             `bson:"create_time"`
+	UpdateTime     time.Time             `bson:"update_time"`
+	// result of the latest enforcement
+	LastApplyTime     time.Time `bson:"last_apply_time"`
+	LastPurgedMsgNum  int64     `bson:"last_purged_msg_num"`
+	TotalPurgedMsgNum int64     `bson:"total_purged_msg_num"`
+}
+
+// RetentionRuleModel keeps messages of ContentType for RetainDays, ContentType 0 applies to all messages.
+type RetentionRuleModel struct {
+	ContentType int32 `bson:"content_type"`
+	RetainDays  int32 `bson:"retain_days"`
+}
+
+type RetentionPolicyModelInterface interface {
+	Upsert(ctx context.Context, policy *RetentionPolicyModel) error
+	Delete(ctx context.Context, conversationID string) error
+	Find(ctx context.Context, conversationIDs []string) ([]*RetentionPolicyModel, error)
+	Page(ctx context.Context, pagination pagination.Pagination) (int64, []*RetentionPolicyModel, error)
+	SetApplyResult(ctx context.Context, conversationID string, applyTime time.Time, purgedMsgNum int64) error
+}
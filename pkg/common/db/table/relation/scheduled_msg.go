@@ -0,0 +1,62 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+const (
+	ScheduledMsgStatusPending     = 0
+	ScheduledMsgStatusDispatching = 1
+	ScheduledMsgStatusSent        = 2
+	ScheduledMsgStatusFailed      = 3
+	ScheduledMsgStatusCanceled    = 4
+)
+
+// ScheduledMsgModel is a message waiting to be sent through SendMsg at SendTime.
+type ScheduledMsgModel struct {
+	ScheduleID  string `bson:"schedule_id"`
+	UserID      string `bson:"user_id"`
+	SendID      string `bson:"send_id"`
+	RecvID      string `bson:"recv_id"`
+	GroupID     string `bson:"group_id"`
+	SessionType int32  `bson:"session_type"`
+	// MsgData is the protobuf encoded sdkws.MsgData.
+	MsgData  []byte    `bson:"msg_data"`
+	SendTime time.Time `bson:"send_time"`
+	Status   int32     `bson:"status"`
+	// ClaimTime is when the message was last marked dispatching, a dispatcher that crashed leaves it behind.
+	ClaimTime  time.Time `bson:"claim_time"`
+	Error      string    `bson:"error"`
+	CreateTime time.Time `bson:"create_time"`
+	UpdateTime time.Time `bson:"update_time"`
+}
+
+type ScheduledMsgModelInterface interface {
+	Create(ctx context.Context, msg *ScheduledMsgModel) error
+	Take(ctx context.Context, scheduleID string) (*ScheduledMsgModel, error)
+	// FindByUser returns the messages scheduled by the user, all statuses when status is nil.
+	FindByUser(ctx context.Context, userID string, status *int32, pagination pagination.Pagination) (int64, []*ScheduledMsgModel, error)
+	// UpdatePending updates a message still waiting to be sent, it returns false otherwise.
+	UpdatePending(ctx context.Context, scheduleID string, update map[string]any) (bool, error)
+	// TakeDue claims the earliest pending message whose send time has come by marking it dispatching,
+	// messages left dispatching since staleBefore are claimed again. The error satisfies IsNotFound when there is none.
+	TakeDue(ctx context.Context, now time.Time, staleBefore time.Time) (*ScheduledMsgModel, error)
+	SetResult(ctx context.Context, scheduleID string, status int32, errMsg string) error
+}
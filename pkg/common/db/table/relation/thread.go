@@ -0,0 +1,73 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// ThreadModel is a thread of replies started from a group message,
+// the replies are stored in their own conversation whose ID is ThreadID.
+type ThreadModel struct {
+	ThreadID        string            `bson:"thread_id"`
+	ConversationID  string            `bson:"conversation_id"`
+	GroupID         string            `bson:"group_id"`
+	RootSeq         int64             `bson:"root_seq"`
+	RootClientMsgID string            `bson:"root_client_msg_id"`
+	CreatorUserID   string            `bson:"creator_user_id"`
+	ReplyCount      int64             `bson:"reply_count"`
+	LastReply       *ThreadReplyModel `bson:"last_reply"`
+	CreateTime      time.Time         `bson:"create_time"`
+	UpdateTime      time.Time         `bson:"update_time"`
+}
+
+// ThreadReplyModel is the summary of the latest reply shown on the root message.
+type ThreadReplyModel struct {
+	Seq         int64  `bson:"seq"`
+	SendID      string `bson:"send_id"`
+	ClientMsgID string `bson:"client_msg_id"`
+	ContentType int32  `bson:"content_type"`
+	Content     string `bson:"content"`
+	SendTime    int64  `bson:"send_time"`
+}
+
+// ThreadParticipantModel records a user taking part in a thread, thread messages are pushed to participants only.
+type ThreadParticipantModel struct {
+	ThreadID   string    `bson:"thread_id"`
+	UserID     string    `bson:"user_id"`
+	JoinTime   time.Time `bson:"join_time"`
+	ActiveTime time.Time `bson:"active_time"`
+}
+
+type ThreadModelInterface interface {
+	// Create returns false if the thread already exists.
+	Create(ctx context.Context, thread *ThreadModel) (bool, error)
+	Take(ctx context.Context, threadID string) (*ThreadModel, error)
+	FindByRootSeqs(ctx context.Context, conversationID string, rootSeqs []int64) ([]*ThreadModel, error)
+	// AddReplies adds count replies starting from firstSeq, it is skipped if the last reply seq stored is not below firstSeq,
+	// so that a redelivered batch is not counted twice.
+	AddReplies(ctx context.Context, threadID string, firstSeq int64, count int64, lastReply *ThreadReplyModel) error
+}
+
+type ThreadParticipantModelInterface interface {
+	// Upsert adds the users to the thread if absent and refreshes their active time.
+	Upsert(ctx context.Context, threadID string, userIDs []string, activeTime time.Time) error
+	FindUserIDs(ctx context.Context, threadID string) ([]string, error)
+	// FindThreadIDs returns the threads the user participates in, most recently active first.
+	FindThreadIDs(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []string, error)
+}
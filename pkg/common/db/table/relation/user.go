@@ -23,13 +23,31 @@ import (
 )
 
 type UserModel struct {
-	UserID           string    `bson:"user_id"`
-	Nickname         string    `bson:"nickname"`
-	FaceURL          string    `bson:"face_url"`
-	Ex               string    `bson:"ex"`
-	AppMangerLevel   int32     `bson:"app_manger_level"`
-	GlobalRecvMsgOpt int32     `bson:"global_recv_msg_opt"`
-	CreateTime       time.Time `bson:"create_time"`
+	UserID           string             `bson:"user_id"`
+	Nickname         string             `bson:"nickname"`
+	FaceURL          string             `bson:"face_url"`
+	Ex               string             `bson:"ex"`
+	AppMangerLevel   int32              `bson:"app_manger_level"`
+	GlobalRecvMsgOpt int32              `bson:"global_recv_msg_opt"`
+	DoNotDisturb     *DoNotDisturbModel `bson:"do_not_disturb,omitempty"`
+	CreateTime       time.Time          `bson:"create_time"`
+}
+
+// DoNotDisturbModel is a weekly quiet-hours schedule during which offline pushes are suppressed or silenced.
+type DoNotDisturbModel struct {
+	Enable                bool                 `bson:"enable"`
+	TimeZone              string               `bson:"time_zone"`
+	Windows               []DoNotDisturbWindow `bson:"windows"`
+	AllowAtMention        bool                 `bson:"allow_at_mention"`
+	ExceptConversationIDs []string             `bson:"except_conversation_ids"`
+	Silent                bool                 `bson:"silent"`
+}
+
+// DoNotDisturbWindow minutes are counted from 00:00 of Weekday, EndMinute <= StartMinute ends on the next day.
+type DoNotDisturbWindow struct {
+	Weekday     int32 `bson:"weekday"`
+	StartMinute int32 `bson:"start_minute"`
+	EndMinute   int32 `bson:"end_minute"`
 }
 
 func (u *UserModel) GetNickname() string {
@@ -61,6 +79,7 @@ type UserModelInterface interface {
 	Exist(ctx context.Context, userID string) (exist bool, err error)
 	GetAllUserID(ctx context.Context, pagination pagination.Pagination) (count int64, userIDs []string, err error)
 	GetUserGlobalRecvMsgOpt(ctx context.Context, userID string) (opt int, err error)
+	SetDoNotDisturb(ctx context.Context, userID string, doNotDisturb *DoNotDisturbModel) (err error)
 	// Get user total quantity
 	CountTotal(ctx context.Context, before *time.Time) (count int64, err error)
 	// Get user total quantity every day
@@ -0,0 +1,257 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister/nodes"
+	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"google.golang.org/grpc"
+)
+
+const (
+	scheme = "consul"
+	// checkTTL is how long a node stays passing after its keepalive stops.
+	checkTTL = 10 * time.Second
+	// deregisterAfter removes a node whose check stays critical, such as a killed process.
+	deregisterAfter = time.Minute
+	watchWait       = time.Minute
+	retryDelay      = time.Second
+)
+
+// ConsulClient registers the node in the local agent with a ttl check kept passing and resolves the services
+// with blocking health queries, the services are tagged with the root directory which also prefixes the conf keys.
+type ConsulClient struct {
+	client      *api.Client
+	root        string
+	gatewayName string
+	nodes       *nodes.Nodes
+	options     []grpc.DialOption
+
+	lock            sync.Mutex
+	registration    *api.AgentServiceRegistration
+	rpcRegisterAddr string
+	cancelKeepAlive context.CancelFunc
+}
+
+func NewConsulDiscoveryRegister(conf *config.Consul, gatewayName string) (discovery.SvcDiscoveryRegistry, error) {
+	client, err := api.NewClient(&api.Config{
+		Address:    conf.Address,
+		Token:      conf.Token,
+		Datacenter: conf.Datacenter,
+	})
+	if err != nil {
+		return nil, errs.WrapMsg(err, "create consul client failed", "address", conf.Address)
+	}
+	if _, err := client.Status().Leader(); err != nil {
+		return nil, errs.WrapMsg(err, "consul is not available", "address", conf.Address)
+	}
+	c := &ConsulClient{
+		client:      client,
+		root:        strings.Trim(conf.RootDirectory, "/"),
+		gatewayName: gatewayName,
+	}
+	c.nodes = nodes.New(scheme, c.watchService)
+	return c, nil
+}
+
+func (c *ConsulClient) confKey(key string) string {
+	return c.root + "/conf/" + key
+}
+
+func (c *ConsulClient) watchService(ctx context.Context, serviceName string, update func(addrs []string)) {
+	var index uint64
+	for ctx.Err() == nil {
+		opt := (&api.QueryOptions{WaitIndex: index, WaitTime: watchWait}).WithContext(ctx)
+		entries, meta, err := c.client.Health().Service(serviceName, c.root, true, opt)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.ZWarn(ctx, "consul watch service nodes failed", err, "serviceName", serviceName)
+				sleep(ctx, retryDelay)
+			}
+			continue
+		}
+		// the index must be reset when it goes backwards, see the consul blocking queries
+		if meta.LastIndex < index {
+			index = 0
+		} else {
+			index = meta.LastIndex
+		}
+		addrs := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			host := entry.Service.Address
+			if host == "" {
+				host = entry.Node.Address
+			}
+			addrs = append(addrs, net.JoinHostPort(host, strconv.Itoa(entry.Service.Port)))
+		}
+		update(addrs)
+	}
+}
+
+func (c *ConsulClient) GetConns(ctx context.Context, serviceName string, opts ...grpc.DialOption) ([]*grpc.ClientConn, error) {
+	return c.nodes.GetConns(ctx, serviceName, func(addr string) (*grpc.ClientConn, error) {
+		return grpc.DialContext(ctx, addr, append(append([]grpc.DialOption(nil), c.options...), opts...)...)
+	})
+}
+
+func (c *ConsulClient) GetConn(ctx context.Context, serviceName string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	options := append(append([]grpc.DialOption(nil), c.options...), opts...)
+	options = append(options, grpc.WithResolvers(c.nodes), grpc.WithDefaultServiceConfig(`{"LoadBalancingPolicy": "round_robin"}`))
+	return grpc.DialContext(ctx, c.nodes.Target(serviceName), options...)
+}
+
+func (c *ConsulClient) GetSelfConnTarget() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.rpcRegisterAddr
+}
+
+func (c *ConsulClient) AddOption(opts ...grpc.DialOption) {
+	c.options = append(c.options, opts...)
+}
+
+func (c *ConsulClient) CloseConn(conn *grpc.ClientConn) {
+	_ = conn.Close()
+}
+
+// GetClientLocalConns returns the node connections dialed so far, do not use it to call rpc.
+func (c *ConsulClient) GetClientLocalConns() map[string][]*grpc.ClientConn {
+	return c.nodes.LocalConns()
+}
+
+func (c *ConsulClient) GetUserIdHashGatewayHost(ctx context.Context, userId string) (string, error) {
+	return c.nodes.HashAddr(ctx, c.gatewayName, userId)
+}
+
+// Register adds the node to the agent with a ttl check, the check is kept passing and the node registered
+// again if the agent lost it.
+func (c *ConsulClient) Register(serviceName, host string, port int, opts ...grpc.DialOption) error {
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	id := serviceName + "-" + addr
+	registration := &api.AgentServiceRegistration{
+		ID:      id,
+		Name:    serviceName,
+		Tags:    []string{c.root},
+		Address: host,
+		Port:    port,
+		Check: &api.AgentServiceCheck{
+			CheckID:                        "service:" + id,
+			TTL:                            checkTTL.String(),
+			DeregisterCriticalServiceAfter: deregisterAfter.String(),
+		},
+	}
+	if err := c.register(registration); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c.lock.Lock()
+	c.registration, c.rpcRegisterAddr, c.cancelKeepAlive = registration, addr, cancel
+	c.lock.Unlock()
+	go c.keepAlive(ctx, registration)
+	return nil
+}
+
+func (c *ConsulClient) register(registration *api.AgentServiceRegistration) error {
+	if err := c.client.Agent().ServiceRegister(registration); err != nil {
+		return errs.WrapMsg(err, "consul register service failed", "id", registration.ID)
+	}
+	if err := c.client.Agent().UpdateTTL(registration.Check.CheckID, "", api.HealthPassing); err != nil {
+		return errs.WrapMsg(err, "consul pass ttl check failed", "id", registration.ID)
+	}
+	return nil
+}
+
+func (c *ConsulClient) keepAlive(ctx context.Context, registration *api.AgentServiceRegistration) {
+	ticker := time.NewTicker(checkTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		err := c.client.Agent().UpdateTTL(registration.Check.CheckID, "", api.HealthPassing)
+		if err == nil {
+			continue
+		}
+		log.ZWarn(ctx, "consul pass ttl check failed, register again", err, "id", registration.ID)
+		if err := c.register(registration); err != nil {
+			log.ZWarn(ctx, "consul register again failed", err, "id", registration.ID)
+		}
+	}
+}
+
+// UnRegister stops the keepalive and removes the node at once.
+func (c *ConsulClient) UnRegister() error {
+	c.lock.Lock()
+	cancel, registration := c.cancelKeepAlive, c.registration
+	c.cancelKeepAlive, c.registration = nil, nil
+	c.lock.Unlock()
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+	if err := c.client.Agent().ServiceDeregister(registration.ID); err != nil {
+		return errs.WrapMsg(err, "consul deregister service failed", "id", registration.ID)
+	}
+	return nil
+}
+
+func (c *ConsulClient) CreateRpcRootNodes(serviceNames []string) error {
+	return nil
+}
+
+func (c *ConsulClient) RegisterConf2Registry(key string, conf []byte) error {
+	if _, err := c.client.KV().Put(&api.KVPair{Key: c.confKey(key), Value: conf}, nil); err != nil {
+		return errs.WrapMsg(err, "consul put conf failed", "key", key)
+	}
+	return nil
+}
+
+func (c *ConsulClient) GetConfFromRegistry(key string) ([]byte, error) {
+	pair, _, err := c.client.KV().Get(c.confKey(key), nil)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "consul get conf failed", "key", key)
+	}
+	if pair == nil {
+		return nil, errs.New("conf not found", "key", key).Wrap()
+	}
+	return pair.Value, nil
+}
+
+func (c *ConsulClient) Close() {
+	if err := c.UnRegister(); err != nil {
+		log.ZWarn(context.Background(), "consul unregister failed", err)
+	}
+	c.nodes.Close()
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+// fakeAgent serves the agent, health, kv and status endpoints used by ConsulClient from memory, so the tests
+// run without consul. Health queries block on the index like consul does.
+type fakeAgent struct {
+	mu       sync.Mutex
+	index    uint64
+	changed  chan struct{}
+	services map[string]*api.AgentServiceRegistration
+	passing  map[string]bool
+	kv       map[string][]byte
+}
+
+func startFakeAgent(t *testing.T) (*fakeAgent, string) {
+	f := &fakeAgent{
+		index:    1,
+		changed:  make(chan struct{}),
+		services: make(map[string]*api.AgentServiceRegistration),
+		passing:  make(map[string]bool),
+		kv:       make(map[string][]byte),
+	}
+	srv := httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	t.Cleanup(srv.Close)
+	return f, strings.TrimPrefix(srv.URL, "http://")
+}
+
+// change wakes up the blocked health queries, it is called with mu held.
+func (f *fakeAgent) change() {
+	f.index++
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+// forget drops a service as an agent restarted without its state does.
+func (f *fakeAgent) forget(id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.services, id)
+	delete(f.passing, "service:"+id)
+	f.change()
+}
+
+func (f *fakeAgent) registered(id string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, ok := f.services[id]
+	return ok && f.passing["service:"+id]
+}
+
+func (f *fakeAgent) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path
+	switch {
+	case path == "/v1/status/leader":
+		writeJSON(w, "127.0.0.1:8300")
+	case path == "/v1/agent/service/register":
+		var registration api.AgentServiceRegistration
+		if err := json.NewDecoder(r.Body).Decode(&registration); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.mu.Lock()
+		f.services[registration.ID] = &registration
+		// a ttl check starts critical until it is passed
+		f.passing[registration.Check.CheckID] = false
+		f.change()
+		f.mu.Unlock()
+	case strings.HasPrefix(path, "/v1/agent/check/update/"):
+		checkID := strings.TrimPrefix(path, "/v1/agent/check/update/")
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if _, ok := f.passing[checkID]; !ok {
+			http.Error(w, "Unknown check ID", http.StatusNotFound)
+			return
+		}
+		f.passing[checkID] = true
+		f.change()
+	case strings.HasPrefix(path, "/v1/agent/service/deregister/"):
+		id := strings.TrimPrefix(path, "/v1/agent/service/deregister/")
+		f.mu.Lock()
+		delete(f.services, id)
+		delete(f.passing, "service:"+id)
+		f.change()
+		f.mu.Unlock()
+	case strings.HasPrefix(path, "/v1/health/service/"):
+		f.health(w, r, strings.TrimPrefix(path, "/v1/health/service/"))
+	case strings.HasPrefix(path, "/v1/kv/"):
+		key := strings.TrimPrefix(path, "/v1/kv/")
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if r.Method == http.MethodPut {
+			value, _ := io.ReadAll(r.Body)
+			f.kv[key] = value
+			writeJSON(w, true)
+			return
+		}
+		value, ok := f.kv[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeJSON(w, []*api.KVPair{{Key: key, Value: value}})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (f *fakeAgent) health(w http.ResponseWriter, r *http.Request, serviceName string) {
+	query := r.URL.Query()
+	index, _ := strconv.ParseUint(query.Get("index"), 10, 64)
+	wait, err := time.ParseDuration(query.Get("wait"))
+	if err != nil {
+		wait = time.Minute
+	}
+	f.mu.Lock()
+	if index >= f.index {
+		changed := f.changed
+		f.mu.Unlock()
+		select {
+		case <-changed:
+		case <-time.After(wait):
+		case <-r.Context().Done():
+			return
+		}
+		f.mu.Lock()
+	}
+	defer f.mu.Unlock()
+	entries := make([]*api.ServiceEntry, 0)
+	for _, registration := range f.services {
+		if registration.Name != serviceName || !f.passing[registration.Check.CheckID] {
+			continue
+		}
+		if tag := query.Get("tag"); tag != "" && (len(registration.Tags) == 0 || registration.Tags[0] != tag) {
+			continue
+		}
+		entries = append(entries, &api.ServiceEntry{
+			Node:    &api.Node{Address: "127.0.0.1"},
+			Service: &api.AgentService{ID: registration.ID, Service: registration.Name, Address: registration.Address, Port: registration.Port},
+		})
+	}
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(f.index, 10))
+	w.Header().Set("X-Consul-LastContact", "0")
+	w.Header().Set("X-Consul-KnownLeader", "true")
+	writeJSON(w, entries)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// TestConsulRegister runs against an in-memory agent, or against a real one such as `consul agent -dev`
+// when CONSUL_ADDRESS is set.
+func TestConsulRegister(t *testing.T) {
+	address := os.Getenv("CONSUL_ADDRESS")
+	if address == "" {
+		_, address = startFakeAgent(t)
+	}
+	root := "openim-test-" + time.Now().Format("150405.000")
+	conf := &config.Consul{RootDirectory: root, Address: address}
+	server, err := NewConsulDiscoveryRegister(conf, "messageGateway")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	client, err := NewConsulDiscoveryRegister(conf, "messageGateway")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	// A node of another deployment sharing the agent is not resolved.
+	other, err := NewConsulDiscoveryRegister(&config.Consul{RootDirectory: root + "-other", Address: address}, "messageGateway")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := other.Register("messageGateway", "127.0.0.1", 10141); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.Register("messageGateway", "127.0.0.1", 10140); err != nil {
+		t.Fatal(err)
+	}
+	if target := server.GetSelfConnTarget(); target != "127.0.0.1:10140" {
+		t.Fatalf("unexpected self target %s", target)
+	}
+	host, err := client.GetUserIdHashGatewayHost(ctx, "userID")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if host != "127.0.0.1:10140" {
+		t.Fatalf("unexpected gateway host %s", host)
+	}
+	if err := server.RegisterConf2Registry("share", []byte("conf")); err != nil {
+		t.Fatal(err)
+	}
+	if conf, err := client.GetConfFromRegistry("share"); err != nil || string(conf) != "conf" {
+		t.Fatalf("unexpected conf %s %v", conf, err)
+	}
+	if _, err := client.GetConfFromRegistry("missing"); err == nil {
+		t.Fatal("a missing conf is found")
+	}
+
+	if err := server.UnRegister(); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		if _, err := client.GetConns(ctx, "messageGateway"); err != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatal("the unregistered node is still resolved")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
+
+func TestConsulKeepAlive(t *testing.T) {
+	agent, address := startFakeAgent(t)
+	server, err := NewConsulDiscoveryRegister(&config.Consul{RootDirectory: "openim", Address: address}, "messageGateway")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	if err := server.Register("messageGateway", "127.0.0.1", 10140); err != nil {
+		t.Fatal(err)
+	}
+	const id = "messageGateway-127.0.0.1:10140"
+	if !agent.registered(id) {
+		t.Fatal("the node is not registered and passing")
+	}
+	// The node is registered again once the keepalive finds the agent lost it.
+	agent.forget(id)
+	deadline := time.After(2 * checkTTL)
+	for !agent.registered(id) {
+		select {
+		case <-deadline:
+			t.Fatal("the node was not registered again")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	if err := server.UnRegister(); err != nil {
+		t.Fatal(err)
+	}
+	if agent.registered(id) {
+		t.Fatal("the unregistered node is still registered")
+	}
+}
@@ -14,161 +14,224 @@
 
 package direct
 
-//import (
-//	"context"
-//	"fmt"
-//
-//	config2 "github.com/openimsdk/open-im-server/v3/pkg/common/config"
-//	"github.com/openimsdk/tools/errs"
-//	"google.golang.org/grpc"
-//	"google.golang.org/grpc/credentials/insecure"
-//)
-//
-//type ServiceAddresses map[string][]int
-//
-//func getServiceAddresses(rpcRegisterName *config2.RpcRegisterName,
-//	rpcPort *config2.RpcPort, longConnSvrPort []int) ServiceAddresses {
-//	return ServiceAddresses{
-//		rpcRegisterName.OpenImUserName:           rpcPort.OpenImUserPort,
-//		rpcRegisterName.OpenImFriendName:         rpcPort.OpenImFriendPort,
-//		rpcRegisterName.OpenImMsgName:            rpcPort.OpenImMessagePort,
-//		rpcRegisterName.OpenImMessageGatewayName: longConnSvrPort,
-//		rpcRegisterName.OpenImGroupName:          rpcPort.OpenImGroupPort,
-//		rpcRegisterName.OpenImAuthName:           rpcPort.OpenImAuthPort,
-//		rpcRegisterName.OpenImPushName:           rpcPort.OpenImPushPort,
-//		rpcRegisterName.OpenImConversationName:   rpcPort.OpenImConversationPort,
-//		rpcRegisterName.OpenImThirdName:          rpcPort.OpenImThirdPort,
-//	}
-//}
-//
-//type ConnDirect struct {
-//	additionalOpts        []grpc.DialOption
-//	currentServiceAddress string
-//	conns                 map[string][]*grpc.ClientConn
-//	resolverDirect        *ResolverDirect
-//	config                *config2.GlobalConfig
-//}
-//
-//func (cd *ConnDirect) GetClientLocalConns() map[string][]*grpc.ClientConn {
-//	return nil
-//}
-//
-//func (cd *ConnDirect) GetUserIdHashGatewayHost(ctx context.Context, userId string) (string, error) {
-//	return "", nil
-//}
-//
-//func (cd *ConnDirect) Register(serviceName, host string, port int, opts ...grpc.DialOption) error {
-//	return nil
-//}
-//
-//func (cd *ConnDirect) UnRegister() error {
-//	return nil
-//}
-//
-//func (cd *ConnDirect) CreateRpcRootNodes(serviceNames []string) error {
-//	return nil
-//}
-//
-//func (cd *ConnDirect) RegisterConf2Registry(key string, conf []byte) error {
-//	return nil
-//}
-//
-//func (cd *ConnDirect) GetConfFromRegistry(key string) ([]byte, error) {
-//	return nil, nil
-//}
-//
-//func (cd *ConnDirect) Close() {
-//
-//}
-//
-//func NewConnDirect(config *config2.GlobalConfig) (*ConnDirect, error) {
-//	return &ConnDirect{
-//		conns:          make(map[string][]*grpc.ClientConn),
-//		resolverDirect: NewResolverDirect(),
-//		config:         config,
-//	}, nil
-//}
-//
-//func (cd *ConnDirect) GetConns(ctx context.Context,
-//	serviceName string, opts ...grpc.DialOption) ([]*grpc.ClientConn, error) {
-//
-//	if conns, exists := cd.conns[serviceName]; exists {
-//		return conns, nil
-//	}
-//	ports := getServiceAddresses(&cd.config.RpcRegisterName,
-//		&cd.config.RpcPort, cd.config.LongConnSvr.OpenImMessageGatewayPort)[serviceName]
-//	var connections []*grpc.ClientConn
-//	for _, port := range ports {
-//		conn, err := cd.dialServiceWithoutResolver(ctx, fmt.Sprintf(cd.config.Rpc.ListenIP+":%d", port), append(cd.additionalOpts, opts...)...)
-//		if err != nil {
-//			return nil, errs.Wrap(fmt.Errorf("connect to port %d failed,serviceName %s, IP %s", port, serviceName, cd.config.Rpc.ListenIP))
-//		}
-//		connections = append(connections, conn)
-//	}
-//
-//	if len(connections) == 0 {
-//		return nil, errs.New("no connections found for service", "serviceName", serviceName).Wrap()
-//	}
-//	return connections, nil
-//}
-//
-//func (cd *ConnDirect) GetConn(ctx context.Context, serviceName string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
-//	// Get service addresses
-//	addresses := getServiceAddresses(&cd.config.RpcRegisterName,
-//		&cd.config.RpcPort, cd.config.LongConnSvr.OpenImMessageGatewayPort)
-//	address, ok := addresses[serviceName]
-//	if !ok {
-//		return nil, errs.New("unknown service name", "serviceName", serviceName).Wrap()
-//	}
-//	var result string
-//	for _, addr := range address {
-//		if result != "" {
-//			result = result + "," + fmt.Sprintf(cd.config.Rpc.ListenIP+":%d", addr)
-//		} else {
-//			result = fmt.Sprintf(cd.config.Rpc.ListenIP+":%d", addr)
-//		}
-//	}
-//	// Try to dial a new connection
-//	conn, err := cd.dialService(ctx, result, append(cd.additionalOpts, opts...)...)
-//	if err != nil {
-//		return nil, errs.WrapMsg(err, "address", result)
-//	}
-//
-//	// Store the new connection
-//	cd.conns[serviceName] = append(cd.conns[serviceName], conn)
-//	return conn, nil
-//}
-//
-//func (cd *ConnDirect) GetSelfConnTarget() string {
-//	return cd.currentServiceAddress
-//}
-//
-//func (cd *ConnDirect) AddOption(opts ...grpc.DialOption) {
-//	cd.additionalOpts = append(cd.additionalOpts, opts...)
-//}
-//
-//func (cd *ConnDirect) CloseConn(conn *grpc.ClientConn) {
-//	if conn != nil {
-//		conn.Close()
-//	}
-//}
-//
-//func (cd *ConnDirect) dialService(ctx context.Context, address string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
-//	options := append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
-//	conn, err := grpc.DialContext(ctx, cd.resolverDirect.Scheme()+":///"+address, options...)
-//
-//	if err != nil {
-//		return nil, errs.WrapMsg(err, "address", address)
-//	}
-//	return conn, nil
-//}
-//
-//func (cd *ConnDirect) dialServiceWithoutResolver(ctx context.Context, address string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
-//	options := append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
-//	conn, err := grpc.DialContext(ctx, address, options...)
-//
-//	if err != nil {
-//		return nil, errs.Wrap(err)
-//	}
-//	return conn, nil
-//}
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/stathat/consistent"
+	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/health" // enables the client side health checking
+)
+
+// healthServiceConfig balances the calls over the addresses of a service and skips the addresses
+// whose grpc health check is not serving.
+const healthServiceConfig = `{"loadBalancingConfig": [{"round_robin": {}}], "healthCheckConfig": {"serviceName": ""}}`
+
+// ConnDirect resolves the services from the static addresses in config instead of a registry,
+// so a small deployment or an integration test can run without ZooKeeper.
+type ConnDirect struct {
+	addresses      map[string][]string
+	gatewayName    string
+	gatewayHosts   *consistent.Consistent
+	additionalOpts []grpc.DialOption
+	selfTarget     string
+
+	lock      sync.Mutex
+	conns     map[string]*grpc.ClientConn // balanced over all the addresses of a service
+	nodeConns map[string]*grpc.ClientConn // one per address
+	confs     map[string][]byte
+}
+
+func NewConnDirect(share *config.Share) (*ConnDirect, error) {
+	addresses := make(map[string][]string)
+	for serviceName, addrs := range share.Direct.GetServiceAddresses(&share.RpcRegisterName) {
+		for _, addr := range addrs {
+			if _, _, err := net.SplitHostPort(addr); err != nil {
+				return nil, errs.WrapMsg(err, "invalid direct address", "serviceName", serviceName, "address", addr)
+			}
+		}
+		if len(addrs) > 0 {
+			addresses[serviceName] = addrs
+		}
+	}
+	if len(addresses) == 0 {
+		return nil, errs.New("no direct address configured").Wrap()
+	}
+	gatewayHosts := consistent.New()
+	for _, addr := range addresses[share.RpcRegisterName.MessageGateway] {
+		gatewayHosts.Add(addr)
+	}
+	return &ConnDirect{
+		addresses:    addresses,
+		gatewayName:  share.RpcRegisterName.MessageGateway,
+		gatewayHosts: gatewayHosts,
+		conns:        make(map[string]*grpc.ClientConn),
+		nodeConns:    make(map[string]*grpc.ClientConn),
+		confs:        make(map[string][]byte),
+	}, nil
+}
+
+// GetConns returns a connection to every address of the service, for the calls that must reach all the nodes.
+func (cd *ConnDirect) GetConns(ctx context.Context, serviceName string, opts ...grpc.DialOption) ([]*grpc.ClientConn, error) {
+	addrs, ok := cd.addresses[serviceName]
+	if !ok {
+		return nil, errs.New("unknown service name", "serviceName", serviceName).Wrap()
+	}
+	conns := make([]*grpc.ClientConn, 0, len(addrs))
+	for _, addr := range addrs {
+		conn, err := cd.getNodeConn(ctx, addr, opts...)
+		if err != nil {
+			return nil, err
+		}
+		conns = append(conns, conn)
+	}
+	return conns, nil
+}
+
+// GetConn returns a connection balanced over the addresses of the service, serviceName may also be
+// the address of a single node such as the one returned by GetUserIdHashGatewayHost.
+func (cd *ConnDirect) GetConn(ctx context.Context, serviceName string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	addrs, ok := cd.addresses[serviceName]
+	if !ok {
+		if _, _, err := net.SplitHostPort(serviceName); err != nil {
+			return nil, errs.New("unknown service name", "serviceName", serviceName).Wrap()
+		}
+		return cd.getNodeConn(ctx, serviceName, opts...)
+	}
+	cd.lock.Lock()
+	defer cd.lock.Unlock()
+	if conn, ok := cd.conns[serviceName]; ok {
+		return conn, nil
+	}
+	conn, err := cd.dial(ctx, scheme+":///"+strings.Join(addrs, string(EndpointSepChar)), opts...)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "serviceName", serviceName)
+	}
+	cd.conns[serviceName] = conn
+	return conn, nil
+}
+
+func (cd *ConnDirect) getNodeConn(ctx context.Context, addr string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	cd.lock.Lock()
+	defer cd.lock.Unlock()
+	if conn, ok := cd.nodeConns[addr]; ok {
+		return conn, nil
+	}
+	// the target stays the plain address so it can be compared with GetSelfConnTarget
+	conn, err := cd.dial(ctx, addr, opts...)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "address", addr)
+	}
+	cd.nodeConns[addr] = conn
+	return conn, nil
+}
+
+func (cd *ConnDirect) dial(ctx context.Context, target string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	options := make([]grpc.DialOption, 0, len(cd.additionalOpts)+len(opts)+1)
+	options = append(options, cd.additionalOpts...)
+	options = append(options, opts...)
+	options = append(options, grpc.WithDefaultServiceConfig(healthServiceConfig))
+	return grpc.DialContext(ctx, target, options...)
+}
+
+func (cd *ConnDirect) GetSelfConnTarget() string {
+	return cd.selfTarget
+}
+
+func (cd *ConnDirect) AddOption(opts ...grpc.DialOption) {
+	cd.additionalOpts = append(cd.additionalOpts, opts...)
+}
+
+func (cd *ConnDirect) CloseConn(conn *grpc.ClientConn) {
+	if conn == nil {
+		return
+	}
+	cd.lock.Lock()
+	for _, conns := range []map[string]*grpc.ClientConn{cd.conns, cd.nodeConns} {
+		for key, c := range conns {
+			if c == conn {
+				delete(conns, key)
+			}
+		}
+	}
+	cd.lock.Unlock()
+	_ = conn.Close()
+}
+
+// GetClientLocalConns returns the connections dialed so far, do not use it to call rpc.
+func (cd *ConnDirect) GetClientLocalConns() map[string][]*grpc.ClientConn {
+	cd.lock.Lock()
+	defer cd.lock.Unlock()
+	conns := make(map[string][]*grpc.ClientConn)
+	for serviceName, conn := range cd.conns {
+		conns[serviceName] = append(conns[serviceName], conn)
+	}
+	for serviceName, addrs := range cd.addresses {
+		for _, addr := range addrs {
+			if conn, ok := cd.nodeConns[addr]; ok {
+				conns[serviceName] = append(conns[serviceName], conn)
+			}
+		}
+	}
+	return conns
+}
+
+// GetUserIdHashGatewayHost returns the gateway address the user is hashed to.
+func (cd *ConnDirect) GetUserIdHashGatewayHost(ctx context.Context, userId string) (string, error) {
+	host, err := cd.gatewayHosts.Get(userId)
+	if err != nil {
+		log.ZError(ctx, "GetUserIdHashGatewayHost error", err, "userID", userId)
+		return "", errs.WrapMsg(err, "no gateway address", "gatewayName", cd.gatewayName)
+	}
+	return host, nil
+}
+
+// Register only records the address of the current node, the addresses of the services are static.
+func (cd *ConnDirect) Register(serviceName, host string, port int, opts ...grpc.DialOption) error {
+	cd.selfTarget = net.JoinHostPort(host, strconv.Itoa(port))
+	if addrs, ok := cd.addresses[serviceName]; !ok || !datautil.Contain(cd.selfTarget, addrs...) {
+		log.ZWarn(context.Background(), "registered address is not in the direct addresses", nil,
+			"serviceName", serviceName, "address", cd.selfTarget, "addresses", addrs)
+	}
+	return nil
+}
+
+func (cd *ConnDirect) UnRegister() error {
+	return nil
+}
+
+func (cd *ConnDirect) CreateRpcRootNodes(serviceNames []string) error {
+	return nil
+}
+
+// RegisterConf2Registry keeps the conf in memory, there is no registry shared by the nodes.
+func (cd *ConnDirect) RegisterConf2Registry(key string, conf []byte) error {
+	cd.lock.Lock()
+	defer cd.lock.Unlock()
+	cd.confs[key] = conf
+	return nil
+}
+
+func (cd *ConnDirect) GetConfFromRegistry(key string) ([]byte, error) {
+	cd.lock.Lock()
+	defer cd.lock.Unlock()
+	return cd.confs[key], nil
+}
+
+func (cd *ConnDirect) Close() {
+	cd.lock.Lock()
+	defer cd.lock.Unlock()
+	for _, conns := range []map[string]*grpc.ClientConn{cd.conns, cd.nodeConns} {
+		for key, conn := range conns {
+			_ = conn.Close()
+			delete(conns, key)
+		}
+	}
+}
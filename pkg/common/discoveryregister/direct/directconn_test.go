@@ -0,0 +1,128 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package direct
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// startNode serves the health service, the node answers the checks of the service named after itself.
+func startNode(t *testing.T, name string) (string, *health.Server) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(srv, healthServer)
+	go srv.Serve(listener)
+	t.Cleanup(srv.Stop)
+	return listener.Addr().String(), healthServer
+}
+
+// calledNode returns which of the nodes answered a call through conn.
+func calledNode(ctx context.Context, conn *grpc.ClientConn, names ...string) (string, error) {
+	// the node not knowing the service answers NotFound, so only the first name tells which node it is
+	_, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: names[0]})
+	if err == nil {
+		return names[0], nil
+	}
+	if status.Code(err) == codes.NotFound {
+		return names[1], nil
+	}
+	return "", err
+}
+
+func TestConnDirectFailover(t *testing.T) {
+	addrA, healthA := startNode(t, "a")
+	addrB, _ := startNode(t, "b")
+	share := &config.Share{
+		RpcRegisterName: config.RpcRegisterName{User: "user", MessageGateway: "messageGateway"},
+		Direct:          config.Direct{User: []string{addrA, addrB}, MessageGateway: []string{addrA, addrB}},
+	}
+	cd, err := NewConnDirect(share)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cd.Close()
+	cd.AddOption(grpc.WithTransportCredentials(insecure.NewCredentials()))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := cd.GetConn(ctx, "user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	called := make(map[string]int)
+	for i := 0; i < 20; i++ {
+		node, err := calledNode(ctx, conn, "a", "b")
+		if err != nil {
+			t.Fatal(err)
+		}
+		called[node]++
+	}
+	if called["a"] == 0 || called["b"] == 0 {
+		t.Fatalf("calls are not balanced: %v", called)
+	}
+
+	healthA.Shutdown()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		node, err := calledNode(ctx, conn, "a", "b")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if node == "b" {
+			if node, err = calledNode(ctx, conn, "a", "b"); err != nil || node != "b" {
+				t.Fatalf("unhealthy node still called: %s %v", node, err)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("calls do not fail over to the healthy node")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	conns, err := cd.GetConns(ctx, "messageGateway")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conns) != 2 || conns[0].Target() != addrA || conns[1].Target() != addrB {
+		t.Fatalf("unexpected node conns: %d", len(conns))
+	}
+	host, err := cd.GetUserIdHashGatewayHost(ctx, "user1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if host != addrA && host != addrB {
+		t.Fatalf("unexpected gateway host %s", host)
+	}
+	if _, err := cd.GetConn(ctx, "unknown"); err == nil {
+		t.Fatal("unknown service resolved")
+	}
+}
@@ -16,7 +16,11 @@ package discoveryregister
 
 import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister/consul"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister/direct"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister/etcd"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister/kubernetes"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister/standalone"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/discovery/zookeeper"
 	"github.com/openimsdk/tools/errs"
@@ -24,9 +28,12 @@ import (
 )
 
 const (
-	zookeeperConst = "zookeeper"
-	kubenetesConst = "k8s"
-	directConst    = "direct"
+	zookeeperConst  = "zookeeper"
+	kubenetesConst  = "k8s"
+	directConst     = "direct"
+	etcdConst       = "etcd"
+	consulConst     = "consul"
+	standaloneConst = "standalone"
 )
 
 // NewDiscoveryRegister creates a new service discovery and registry client based on the provided environment type.
@@ -45,9 +52,14 @@ func NewDiscoveryRegister(zookeeperConfig *config.ZooKeeper, share *config.Share
 	case kubenetesConst:
 		return kubernetes.NewK8sDiscoveryRegister(share.RpcRegisterName.MessageGateway)
 	case directConst:
-		//return direct.NewConnDirect(config)
+		return direct.NewConnDirect(share)
+	case etcdConst:
+		return etcd.NewEtcdDiscoveryRegister(&share.Etcd, share.RpcRegisterName.MessageGateway)
+	case consulConst:
+		return consul.NewConsulDiscoveryRegister(&share.Consul, share.RpcRegisterName.MessageGateway)
+	case standaloneConst:
+		return standalone.NewStandaloneDiscoveryRegister(share.RpcRegisterName.MessageGateway), nil
 	default:
 		return nil, errs.New("unsupported discovery type", "type", share.Env).Wrap()
 	}
-	return nil, nil
 }
@@ -0,0 +1,274 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister/nodes"
+	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc"
+)
+
+const (
+	scheme = "etcd"
+	// leaseTTL is the seconds a node stays registered after its keepalive stops.
+	leaseTTL    = 10
+	dialTimeout = 5 * time.Second
+	retryDelay  = time.Second
+)
+
+// EtcdClient registers the node under a lease kept alive and resolves the services by watching their keys,
+// a node is stored at <root>/services/<serviceName>/<host:port> and a conf at <root>/conf/<key>.
+type EtcdClient struct {
+	client      *clientv3.Client
+	root        string
+	gatewayName string
+	nodes       *nodes.Nodes
+	options     []grpc.DialOption
+
+	lock            sync.Mutex
+	rpcRegisterName string
+	rpcRegisterAddr string
+	leaseID         clientv3.LeaseID
+	cancelKeepAlive context.CancelFunc
+}
+
+func NewEtcdDiscoveryRegister(conf *config.Etcd, gatewayName string) (discovery.SvcDiscoveryRegistry, error) {
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   conf.Address,
+		Username:    conf.Username,
+		Password:    conf.Password,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return nil, errs.WrapMsg(err, "connect etcd failed", "address", conf.Address)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	defer cancel()
+	if _, err := client.Get(ctx, "/", clientv3.WithCountOnly()); err != nil {
+		_ = client.Close()
+		return nil, errs.WrapMsg(err, "etcd is not available", "address", conf.Address)
+	}
+	e := &EtcdClient{
+		client:      client,
+		root:        "/" + strings.Trim(conf.RootDirectory, "/"),
+		gatewayName: gatewayName,
+	}
+	e.nodes = nodes.New(scheme, e.watchService)
+	return e, nil
+}
+
+func (e *EtcdClient) servicePrefix(serviceName string) string {
+	return fmt.Sprintf("%s/services/%s/", e.root, serviceName)
+}
+
+func (e *EtcdClient) confKey(key string) string {
+	return fmt.Sprintf("%s/conf/%s", e.root, key)
+}
+
+func (e *EtcdClient) watchService(ctx context.Context, serviceName string, update func(addrs []string)) {
+	prefix := e.servicePrefix(serviceName)
+	for ctx.Err() == nil {
+		resp, err := e.client.Get(ctx, prefix, clientv3.WithPrefix())
+		if err != nil {
+			log.ZWarn(ctx, "etcd get service nodes failed", err, "prefix", prefix)
+			sleep(ctx, retryDelay)
+			continue
+		}
+		addrs := make(map[string]string, len(resp.Kvs))
+		for _, kv := range resp.Kvs {
+			addrs[string(kv.Key)] = string(kv.Value)
+		}
+		update(datautil.Values(addrs))
+		// the watch ends when the revision is compacted or etcd is lost, the nodes are read again then
+		for watchResp := range e.client.Watch(ctx, prefix, clientv3.WithPrefix(), clientv3.WithRev(resp.Header.Revision+1)) {
+			if err := watchResp.Err(); err != nil {
+				log.ZWarn(ctx, "etcd watch service nodes failed", err, "prefix", prefix)
+				break
+			}
+			for _, event := range watchResp.Events {
+				switch event.Type {
+				case clientv3.EventTypePut:
+					addrs[string(event.Kv.Key)] = string(event.Kv.Value)
+				case clientv3.EventTypeDelete:
+					delete(addrs, string(event.Kv.Key))
+				}
+			}
+			update(datautil.Values(addrs))
+		}
+	}
+}
+
+func (e *EtcdClient) GetConns(ctx context.Context, serviceName string, opts ...grpc.DialOption) ([]*grpc.ClientConn, error) {
+	return e.nodes.GetConns(ctx, serviceName, func(addr string) (*grpc.ClientConn, error) {
+		return grpc.DialContext(ctx, addr, append(append([]grpc.DialOption(nil), e.options...), opts...)...)
+	})
+}
+
+func (e *EtcdClient) GetConn(ctx context.Context, serviceName string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	options := append(append([]grpc.DialOption(nil), e.options...), opts...)
+	options = append(options, grpc.WithResolvers(e.nodes), grpc.WithDefaultServiceConfig(`{"LoadBalancingPolicy": "round_robin"}`))
+	return grpc.DialContext(ctx, e.nodes.Target(serviceName), options...)
+}
+
+func (e *EtcdClient) GetSelfConnTarget() string {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.rpcRegisterAddr
+}
+
+func (e *EtcdClient) AddOption(opts ...grpc.DialOption) {
+	e.options = append(e.options, opts...)
+}
+
+func (e *EtcdClient) CloseConn(conn *grpc.ClientConn) {
+	_ = conn.Close()
+}
+
+// GetClientLocalConns returns the node connections dialed so far, do not use it to call rpc.
+func (e *EtcdClient) GetClientLocalConns() map[string][]*grpc.ClientConn {
+	return e.nodes.LocalConns()
+}
+
+func (e *EtcdClient) GetUserIdHashGatewayHost(ctx context.Context, userId string) (string, error) {
+	return e.nodes.HashAddr(ctx, e.gatewayName, userId)
+}
+
+// Register puts the node under a lease, the lease is kept alive and granted again if it is lost.
+func (e *EtcdClient) Register(serviceName, host string, port int, opts ...grpc.DialOption) error {
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	key := e.servicePrefix(serviceName) + addr
+	ctx, cancel := context.WithCancel(context.Background())
+	leaseID, err := e.putWithLease(ctx, key, addr)
+	if err != nil {
+		cancel()
+		return err
+	}
+	e.lock.Lock()
+	e.rpcRegisterName, e.rpcRegisterAddr = serviceName, addr
+	e.leaseID, e.cancelKeepAlive = leaseID, cancel
+	e.lock.Unlock()
+	go e.keepAlive(ctx, key, addr, leaseID)
+	return nil
+}
+
+func (e *EtcdClient) putWithLease(ctx context.Context, key, value string) (clientv3.LeaseID, error) {
+	lease, err := e.client.Grant(ctx, leaseTTL)
+	if err != nil {
+		return 0, errs.WrapMsg(err, "etcd grant lease failed", "key", key)
+	}
+	if _, err := e.client.Put(ctx, key, value, clientv3.WithLease(lease.ID)); err != nil {
+		return 0, errs.WrapMsg(err, "etcd put failed", "key", key)
+	}
+	return lease.ID, nil
+}
+
+func (e *EtcdClient) keepAlive(ctx context.Context, key, addr string, leaseID clientv3.LeaseID) {
+	for {
+		ch, err := e.client.KeepAlive(ctx, leaseID)
+		if err == nil {
+			for range ch {
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		log.ZWarn(ctx, "etcd lease lost, register again", err, "key", key)
+		for {
+			sleep(ctx, retryDelay)
+			if ctx.Err() != nil {
+				return
+			}
+			if leaseID, err = e.putWithLease(ctx, key, addr); err == nil {
+				break
+			}
+			log.ZWarn(ctx, "etcd register again failed", err, "key", key)
+		}
+		e.lock.Lock()
+		e.leaseID = leaseID
+		e.lock.Unlock()
+	}
+}
+
+// UnRegister stops the keepalive and revokes the lease so the node is removed at once.
+func (e *EtcdClient) UnRegister() error {
+	e.lock.Lock()
+	cancel, leaseID := e.cancelKeepAlive, e.leaseID
+	e.cancelKeepAlive, e.leaseID = nil, 0
+	e.lock.Unlock()
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+	ctx, cancelRevoke := context.WithTimeout(context.Background(), dialTimeout)
+	defer cancelRevoke()
+	if _, err := e.client.Revoke(ctx, leaseID); err != nil {
+		return errs.WrapMsg(err, "etcd revoke lease failed", "serviceName", e.rpcRegisterName)
+	}
+	return nil
+}
+
+func (e *EtcdClient) CreateRpcRootNodes(serviceNames []string) error {
+	return nil
+}
+
+func (e *EtcdClient) RegisterConf2Registry(key string, conf []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	defer cancel()
+	if _, err := e.client.Put(ctx, e.confKey(key), string(conf)); err != nil {
+		return errs.WrapMsg(err, "etcd put conf failed", "key", key)
+	}
+	return nil
+}
+
+func (e *EtcdClient) GetConfFromRegistry(key string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	defer cancel()
+	resp, err := e.client.Get(ctx, e.confKey(key))
+	if err != nil {
+		return nil, errs.WrapMsg(err, "etcd get conf failed", "key", key)
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, errs.New("conf not found", "key", key).Wrap()
+	}
+	return resp.Kvs[0].Value, nil
+}
+
+func (e *EtcdClient) Close() {
+	if err := e.UnRegister(); err != nil {
+		log.ZWarn(context.Background(), "etcd unregister failed", err)
+	}
+	e.nodes.Close()
+	_ = e.client.Close()
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
@@ -0,0 +1,323 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"google.golang.org/grpc"
+)
+
+// fakeEtcd serves the kv, lease and watch rpcs used by EtcdClient from memory, so the tests run without etcd.
+// Leases never expire on their own, expire drops one as etcd does when its keepalive stops.
+type fakeEtcd struct {
+	pb.UnimplementedKVServer
+	pb.UnimplementedLeaseServer
+	pb.UnimplementedWatchServer
+
+	// mu also serializes the sends on the watch streams
+	mu      sync.Mutex
+	rev     int64
+	kvs     map[string]*mvccpb.KeyValue
+	leases  map[int64]bool
+	leaseID int64
+	watches map[int64]*fakeWatch
+	watchID int64
+}
+
+type fakeWatch struct {
+	stream pb.Watch_WatchServer
+	key    []byte
+	end    []byte
+}
+
+// startFakeEtcd returns the fake and its address.
+func startFakeEtcd(t *testing.T) (*fakeEtcd, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &fakeEtcd{kvs: make(map[string]*mvccpb.KeyValue), leases: make(map[int64]bool), watches: make(map[int64]*fakeWatch)}
+	srv := grpc.NewServer()
+	pb.RegisterKVServer(srv, f)
+	pb.RegisterLeaseServer(srv, f)
+	pb.RegisterWatchServer(srv, f)
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+	return f, listener.Addr().String()
+}
+
+// inRange follows the etcd range semantics: an empty end is the key alone and "\x00" every key from key on.
+func inRange(k, key, end []byte) bool {
+	switch {
+	case len(end) == 0:
+		return bytes.Equal(k, key)
+	case bytes.Equal(end, []byte{0}):
+		return bytes.Compare(k, key) >= 0
+	default:
+		return bytes.Compare(k, key) >= 0 && bytes.Compare(k, end) < 0
+	}
+}
+
+func (f *fakeEtcd) header() *pb.ResponseHeader {
+	return &pb.ResponseHeader{Revision: f.rev}
+}
+
+func (f *fakeEtcd) notify(event *mvccpb.Event) {
+	for id, w := range f.watches {
+		if inRange(event.Kv.Key, w.key, w.end) {
+			_ = w.stream.Send(&pb.WatchResponse{Header: f.header(), WatchId: id, Events: []*mvccpb.Event{event}})
+		}
+	}
+}
+
+func (f *fakeEtcd) Range(ctx context.Context, req *pb.RangeRequest) (*pb.RangeResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	resp := &pb.RangeResponse{Header: f.header()}
+	for k, kv := range f.kvs {
+		if !inRange([]byte(k), req.Key, req.RangeEnd) {
+			continue
+		}
+		resp.Count++
+		if !req.CountOnly {
+			resp.Kvs = append(resp.Kvs, kv)
+		}
+	}
+	sort.Slice(resp.Kvs, func(i, j int) bool { return bytes.Compare(resp.Kvs[i].Key, resp.Kvs[j].Key) < 0 })
+	return resp, nil
+}
+
+func (f *fakeEtcd) Put(ctx context.Context, req *pb.PutRequest) (*pb.PutResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if req.Lease != 0 && !f.leases[req.Lease] {
+		return nil, rpctypes.ErrGRPCLeaseNotFound
+	}
+	f.rev++
+	kv := &mvccpb.KeyValue{Key: req.Key, Value: req.Value, Lease: req.Lease, CreateRevision: f.rev, ModRevision: f.rev, Version: 1}
+	if old, ok := f.kvs[string(req.Key)]; ok {
+		kv.CreateRevision, kv.Version = old.CreateRevision, old.Version+1
+	}
+	f.kvs[string(req.Key)] = kv
+	f.notify(&mvccpb.Event{Type: mvccpb.PUT, Kv: kv})
+	return &pb.PutResponse{Header: f.header()}, nil
+}
+
+func (f *fakeEtcd) LeaseGrant(ctx context.Context, req *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.leaseID++
+	f.leases[f.leaseID] = true
+	return &pb.LeaseGrantResponse{Header: f.header(), ID: f.leaseID, TTL: req.TTL}, nil
+}
+
+func (f *fakeEtcd) LeaseRevoke(ctx context.Context, req *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
+	if !f.expire(req.ID) {
+		return nil, rpctypes.ErrGRPCLeaseNotFound
+	}
+	return &pb.LeaseRevokeResponse{Header: f.header()}, nil
+}
+
+// expire drops the lease and the keys attached to it, it returns false if the lease does not exist.
+func (f *fakeEtcd) expire(leaseID int64) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.leases[leaseID] {
+		return false
+	}
+	delete(f.leases, leaseID)
+	for k, kv := range f.kvs {
+		if kv.Lease != leaseID {
+			continue
+		}
+		f.rev++
+		delete(f.kvs, k)
+		f.notify(&mvccpb.Event{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: kv.Key, ModRevision: f.rev}})
+	}
+	return true
+}
+
+// LeaseKeepAlive answers a ttl of one second, so the client sends a keepalive about every 500ms and learns
+// quickly that a lease expired.
+func (f *fakeEtcd) LeaseKeepAlive(stream pb.Lease_LeaseKeepAliveServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		f.mu.Lock()
+		resp := &pb.LeaseKeepAliveResponse{Header: f.header(), ID: req.ID}
+		if f.leases[req.ID] {
+			resp.TTL = 1
+		}
+		f.mu.Unlock()
+		if err := stream.Send(resp); err != nil {
+			return nil
+		}
+	}
+}
+
+// Watch only sends the events following the creation of a watch, which is all EtcdClient asks for.
+func (f *fakeEtcd) Watch(stream pb.Watch_WatchServer) error {
+	var ids []int64
+	defer func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		for _, id := range ids {
+			delete(f.watches, id)
+		}
+	}()
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		f.mu.Lock()
+		switch {
+		case req.GetCreateRequest() != nil:
+			create := req.GetCreateRequest()
+			f.watchID++
+			f.watches[f.watchID] = &fakeWatch{stream: stream, key: create.Key, end: create.RangeEnd}
+			ids = append(ids, f.watchID)
+			err = stream.Send(&pb.WatchResponse{Header: f.header(), WatchId: f.watchID, Created: true})
+		case req.GetCancelRequest() != nil:
+			id := req.GetCancelRequest().WatchId
+			delete(f.watches, id)
+			err = stream.Send(&pb.WatchResponse{Header: f.header(), WatchId: id, Canceled: true})
+		}
+		f.mu.Unlock()
+		if err != nil {
+			return nil
+		}
+	}
+}
+
+// leaseOf returns the lease the key is attached to, 0 if the key does not exist.
+func (f *fakeEtcd) leaseOf(key string) int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if kv, ok := f.kvs[key]; ok {
+		return kv.Lease
+	}
+	return 0
+}
+
+// TestEtcdRegister runs against an in-memory etcd, or against a real one such as `etcd --listen-client-urls
+// http://127.0.0.1:2379 --advertise-client-urls http://127.0.0.1:2379` when ETCD_ADDRESS is set.
+func TestEtcdRegister(t *testing.T) {
+	address := os.Getenv("ETCD_ADDRESS")
+	if address == "" {
+		_, address = startFakeEtcd(t)
+	}
+	conf := &config.Etcd{RootDirectory: "openim-test-" + time.Now().Format("150405.000"), Address: strings.Split(address, ",")}
+	server, err := NewEtcdDiscoveryRegister(conf, "messageGateway")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	client, err := NewEtcdDiscoveryRegister(conf, "messageGateway")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := server.Register("messageGateway", "127.0.0.1", 10140); err != nil {
+		t.Fatal(err)
+	}
+	host, err := client.GetUserIdHashGatewayHost(ctx, "userID")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if host != "127.0.0.1:10140" {
+		t.Fatalf("unexpected gateway host %s", host)
+	}
+	if err := server.RegisterConf2Registry("share", []byte("conf")); err != nil {
+		t.Fatal(err)
+	}
+	if conf, err := client.GetConfFromRegistry("share"); err != nil || string(conf) != "conf" {
+		t.Fatalf("unexpected conf %s %v", conf, err)
+	}
+
+	if err := server.UnRegister(); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		if _, err := client.GetConns(ctx, "messageGateway"); err != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatal("the unregistered node is still resolved")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
+
+func TestEtcdKeepAlive(t *testing.T) {
+	fake, address := startFakeEtcd(t)
+	conf := &config.Etcd{RootDirectory: "openim", Address: []string{address}}
+	server, err := NewEtcdDiscoveryRegister(conf, "messageGateway")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	if err := server.Register("messageGateway", "127.0.0.1", 10140); err != nil {
+		t.Fatal(err)
+	}
+	const key = "/openim/services/messageGateway/127.0.0.1:10140"
+	leaseID := fake.leaseOf(key)
+	if leaseID == 0 {
+		t.Fatal("the node is not registered")
+	}
+	// The node is registered again under a new lease once the keepalive finds its lease gone.
+	fake.expire(leaseID)
+	deadline := time.After(10 * time.Second)
+	for {
+		if newLeaseID := fake.leaseOf(key); newLeaseID != 0 {
+			if newLeaseID == leaseID {
+				t.Fatal("the node kept the expired lease")
+			}
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatal("the node was not registered again")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	if err := server.UnRegister(); err != nil {
+		t.Fatal(err)
+	}
+	if fake.leaseOf(key) != 0 {
+		t.Fatal("the unregistered node is still stored")
+	}
+}
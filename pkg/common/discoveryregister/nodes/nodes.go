@@ -0,0 +1,264 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodes
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/stathat/consistent"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+)
+
+// readyTimeout bounds the wait for the first addresses of a service.
+const readyTimeout = 5 * time.Second
+
+// WatchFunc calls update with all the addresses of the service every time they may have changed, until ctx is done.
+type WatchFunc func(ctx context.Context, serviceName string, update func(addrs []string))
+
+// Nodes keeps the addresses of the services watched from a registry, it resolves the services for grpc
+// and holds a connection to every node for the calls that must reach all of them.
+// One watch is started per service the first time it is used.
+type Nodes struct {
+	scheme string
+	watch  WatchFunc
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	lock     sync.Mutex
+	services map[string]*service
+}
+
+type service struct {
+	ready     chan struct{} // closed on the first update
+	addrs     []string
+	conns     map[string]*grpc.ClientConn
+	resolvers map[*nodesResolver]struct{}
+}
+
+func New(scheme string, watch WatchFunc) *Nodes {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Nodes{
+		scheme:   scheme,
+		watch:    watch,
+		ctx:      ctx,
+		cancel:   cancel,
+		services: make(map[string]*service),
+	}
+}
+
+// getService returns the service, the lock must be held.
+func (n *Nodes) getService(serviceName string) *service {
+	s, ok := n.services[serviceName]
+	if ok {
+		return s
+	}
+	s = &service{
+		ready:     make(chan struct{}),
+		conns:     make(map[string]*grpc.ClientConn),
+		resolvers: make(map[*nodesResolver]struct{}),
+	}
+	n.services[serviceName] = s
+	go n.watch(n.ctx, serviceName, func(addrs []string) {
+		n.update(serviceName, addrs)
+	})
+	return s
+}
+
+func (n *Nodes) update(serviceName string, addrs []string) {
+	addrs = append([]string(nil), addrs...)
+	sort.Strings(addrs)
+	n.lock.Lock()
+	s := n.services[serviceName]
+	select {
+	case <-s.ready:
+		if strings.Join(s.addrs, ",") == strings.Join(addrs, ",") {
+			n.lock.Unlock()
+			return
+		}
+	default:
+		close(s.ready)
+	}
+	s.addrs = addrs
+	current := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		current[addr] = struct{}{}
+	}
+	var closed []*grpc.ClientConn
+	for addr, conn := range s.conns {
+		if _, ok := current[addr]; !ok {
+			delete(s.conns, addr)
+			closed = append(closed, conn)
+		}
+	}
+	resolvers := make([]*nodesResolver, 0, len(s.resolvers))
+	for r := range s.resolvers {
+		resolvers = append(resolvers, r)
+	}
+	n.lock.Unlock()
+	log.ZInfo(n.ctx, "service nodes changed", "scheme", n.scheme, "serviceName", serviceName, "addrs", addrs)
+	for _, conn := range closed {
+		_ = conn.Close()
+	}
+	for _, r := range resolvers {
+		r.updateState(addrs)
+	}
+}
+
+// Addrs returns the addresses of the service, it waits for the first watch result.
+func (n *Nodes) Addrs(ctx context.Context, serviceName string) ([]string, error) {
+	n.lock.Lock()
+	s := n.getService(serviceName)
+	n.lock.Unlock()
+	select {
+	case <-s.ready:
+	case <-ctx.Done():
+		return nil, errs.Wrap(ctx.Err())
+	case <-time.After(readyTimeout):
+		return nil, errs.New("watch service timeout", "scheme", n.scheme, "serviceName", serviceName).Wrap()
+	}
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return s.addrs, nil
+}
+
+// GetConns returns a connection to every node of the service, the connection of a removed node is closed.
+func (n *Nodes) GetConns(ctx context.Context, serviceName string, dial func(addr string) (*grpc.ClientConn, error)) ([]*grpc.ClientConn, error) {
+	addrs, err := n.Addrs(ctx, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return nil, errs.New("no node of service", "scheme", n.scheme, "serviceName", serviceName).Wrap()
+	}
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	s := n.services[serviceName]
+	conns := make([]*grpc.ClientConn, 0, len(addrs))
+	for _, addr := range addrs {
+		conn, ok := s.conns[addr]
+		if !ok {
+			conn, err = dial(addr)
+			if err != nil {
+				return nil, errs.WrapMsg(err, "dial node failed", "serviceName", serviceName, "addr", addr)
+			}
+			s.conns[addr] = conn
+		}
+		conns = append(conns, conn)
+	}
+	return conns, nil
+}
+
+// HashAddr returns the address of the service key is consistently hashed to.
+func (n *Nodes) HashAddr(ctx context.Context, serviceName string, key string) (string, error) {
+	addrs, err := n.Addrs(ctx, serviceName)
+	if err != nil {
+		return "", err
+	}
+	c := consistent.New()
+	c.Set(addrs)
+	addr, err := c.Get(key)
+	if err != nil {
+		return "", errs.WrapMsg(err, "no node of service", "scheme", n.scheme, "serviceName", serviceName)
+	}
+	return addr, nil
+}
+
+// LocalConns returns the node connections dialed so far.
+func (n *Nodes) LocalConns() map[string][]*grpc.ClientConn {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	conns := make(map[string][]*grpc.ClientConn)
+	for serviceName, s := range n.services {
+		for _, conn := range s.conns {
+			conns[serviceName] = append(conns[serviceName], conn)
+		}
+	}
+	return conns
+}
+
+// Close stops the watches and closes the node connections.
+func (n *Nodes) Close() {
+	n.cancel()
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for _, s := range n.services {
+		for addr, conn := range s.conns {
+			_ = conn.Close()
+			delete(s.conns, addr)
+		}
+	}
+}
+
+// Target returns the grpc target resolving the service through Nodes.
+func (n *Nodes) Target(serviceName string) string {
+	return n.scheme + ":///" + serviceName
+}
+
+func (n *Nodes) Scheme() string {
+	return n.scheme
+}
+
+// Build implements resolver.Builder, it is passed to grpc.WithResolvers instead of being registered globally.
+func (n *Nodes) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	serviceName := strings.Trim(target.URL.Path, "/")
+	r := &nodesResolver{nodes: n, serviceName: serviceName, cc: cc}
+	n.lock.Lock()
+	s := n.getService(serviceName)
+	s.resolvers[r] = struct{}{}
+	var addrs []string
+	select {
+	case <-s.ready:
+		addrs = s.addrs
+	default:
+		n.lock.Unlock()
+		return r, nil
+	}
+	n.lock.Unlock()
+	r.updateState(addrs)
+	return r, nil
+}
+
+type nodesResolver struct {
+	nodes       *Nodes
+	serviceName string
+	cc          resolver.ClientConn
+}
+
+func (r *nodesResolver) updateState(addrs []string) {
+	state := resolver.State{Addresses: make([]resolver.Address, 0, len(addrs))}
+	for _, addr := range addrs {
+		state.Addresses = append(state.Addresses, resolver.Address{Addr: addr, ServerName: r.serviceName})
+	}
+	if err := r.cc.UpdateState(state); err != nil {
+		log.ZWarn(context.Background(), "resolver update state failed", err, "serviceName", r.serviceName, "addrs", addrs)
+	}
+}
+
+func (r *nodesResolver) ResolveNow(resolver.ResolveNowOptions) {}
+
+func (r *nodesResolver) Close() {
+	r.nodes.lock.Lock()
+	defer r.nodes.lock.Unlock()
+	if s, ok := r.nodes.services[r.serviceName]; ok {
+		delete(s.resolvers, r)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestNodes(t *testing.T) {
+	updates := make(chan []string, 1)
+	updates <- []string{"127.0.0.1:2", "127.0.0.1:1"}
+	n := New("test", func(ctx context.Context, serviceName string, update func(addrs []string)) {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case addrs := <-updates:
+				update(addrs)
+			}
+		}
+	})
+	defer n.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	addrs, err := n.Addrs(ctx, "user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addrs) != 2 || addrs[0] != "127.0.0.1:1" || addrs[1] != "127.0.0.1:2" {
+		t.Fatalf("unexpected addrs %v", addrs)
+	}
+	dial := func(addr string) (*grpc.ClientConn, error) {
+		return grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	conns, err := n.GetConns(ctx, "user", dial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conns) != 2 || conns[0].Target() != "127.0.0.1:1" {
+		t.Fatalf("unexpected conns %d", len(conns))
+	}
+	host, err := n.HashAddr(ctx, "user", "userID")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if host != addrs[0] && host != addrs[1] {
+		t.Fatalf("unexpected hash addr %s", host)
+	}
+
+	updates <- []string{"127.0.0.1:2"}
+	deadline := time.Now().Add(time.Second)
+	for {
+		if addrs, _ := n.Addrs(ctx, "user"); len(addrs) == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("update not applied")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	again, err := n.GetConns(ctx, "user", dial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again) != 1 || again[0] != conns[1] {
+		t.Fatal("the conn of the remaining node is not reused")
+	}
+	if len(n.LocalConns()["user"]) != 1 {
+		t.Fatal("the conn of the removed node is kept")
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standalone
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister/nodes"
+	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/errs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	scheme     = "standalone"
+	bufferSize = 1024 * 1024
+)
+
+// registry holds the nodes registered in the process, it is shared by the clients of all the services
+// started in the same process.
+var registry = &memoryRegistry{
+	nodes:   make(map[string]*memoryNode),
+	confs:   make(map[string][]byte),
+	changed: make(chan struct{}),
+}
+
+type memoryNode struct {
+	serviceName string
+	listener    *bufconn.Listener
+}
+
+type memoryRegistry struct {
+	lock    sync.Mutex
+	nodes   map[string]*memoryNode // key is the registered address
+	confs   map[string][]byte
+	changed chan struct{} // closed and replaced every time a node is added or removed
+}
+
+// notify wakes up the watches, the lock must be held.
+func (r *memoryRegistry) notify() {
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+func (r *memoryRegistry) register(serviceName, addr string) (*bufconn.Listener, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if node, ok := r.nodes[addr]; ok {
+		return nil, errs.New("address already registered", "addr", addr, "serviceName", node.serviceName).Wrap()
+	}
+	listener := bufconn.Listen(bufferSize)
+	r.nodes[addr] = &memoryNode{serviceName: serviceName, listener: listener}
+	r.notify()
+	return listener, nil
+}
+
+func (r *memoryRegistry) unregister(addr string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	node, ok := r.nodes[addr]
+	if !ok {
+		return
+	}
+	delete(r.nodes, addr)
+	_ = node.listener.Close()
+	r.notify()
+}
+
+// addrs returns the addresses of the service and a channel closed on the next change.
+func (r *memoryRegistry) addrs(serviceName string) ([]string, <-chan struct{}) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	var addrs []string
+	for addr, node := range r.nodes {
+		if node.serviceName == serviceName {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs, r.changed
+}
+
+func (r *memoryRegistry) dial(ctx context.Context, addr string) (net.Conn, error) {
+	r.lock.Lock()
+	node, ok := r.nodes[addr]
+	r.lock.Unlock()
+	if !ok {
+		return nil, errs.New("node not registered", "addr", addr).Wrap()
+	}
+	return node.listener.DialContext(ctx)
+}
+
+// StandaloneClient runs the discovery in memory for the services started in one process,
+// a registered node serves on an in-memory listener the other services dial instead of the network.
+type StandaloneClient struct {
+	gatewayName string
+	nodes       *nodes.Nodes
+	options     []grpc.DialOption
+
+	lock            sync.Mutex
+	rpcRegisterName string
+	rpcRegisterAddr string
+	listener        *bufconn.Listener
+}
+
+func NewStandaloneDiscoveryRegister(gatewayName string) *StandaloneClient {
+	c := &StandaloneClient{gatewayName: gatewayName}
+	c.nodes = nodes.New(scheme, c.watchService)
+	return c
+}
+
+// Listener returns the in-memory listener the node of client is registered with, or nil if client is not
+// a standalone client or has not registered.
+func Listener(client discovery.SvcDiscoveryRegistry) net.Listener {
+	c, ok := client.(*StandaloneClient)
+	if !ok {
+		return nil
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.listener == nil {
+		return nil
+	}
+	return c.listener
+}
+
+func (c *StandaloneClient) watchService(ctx context.Context, serviceName string, update func(addrs []string)) {
+	for {
+		addrs, changed := registry.addrs(serviceName)
+		update(addrs)
+		select {
+		case <-ctx.Done():
+			return
+		case <-changed:
+		}
+	}
+}
+
+func (c *StandaloneClient) dialOptions(opts []grpc.DialOption) []grpc.DialOption {
+	options := append(append([]grpc.DialOption(nil), c.options...), opts...)
+	return append(options, grpc.WithContextDialer(registry.dial))
+}
+
+func (c *StandaloneClient) GetConns(ctx context.Context, serviceName string, opts ...grpc.DialOption) ([]*grpc.ClientConn, error) {
+	return c.nodes.GetConns(ctx, serviceName, func(addr string) (*grpc.ClientConn, error) {
+		return grpc.DialContext(ctx, addr, c.dialOptions(opts)...)
+	})
+}
+
+func (c *StandaloneClient) GetConn(ctx context.Context, serviceName string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	options := append(c.dialOptions(opts), grpc.WithResolvers(c.nodes), grpc.WithDefaultServiceConfig(`{"LoadBalancingPolicy": "round_robin"}`))
+	return grpc.DialContext(ctx, c.nodes.Target(serviceName), options...)
+}
+
+func (c *StandaloneClient) GetSelfConnTarget() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.rpcRegisterAddr
+}
+
+func (c *StandaloneClient) AddOption(opts ...grpc.DialOption) {
+	c.options = append(c.options, opts...)
+}
+
+func (c *StandaloneClient) CloseConn(conn *grpc.ClientConn) {
+	_ = conn.Close()
+}
+
+// GetClientLocalConns returns the node connections dialed so far, do not use it to call rpc.
+func (c *StandaloneClient) GetClientLocalConns() map[string][]*grpc.ClientConn {
+	return c.nodes.LocalConns()
+}
+
+func (c *StandaloneClient) GetUserIdHashGatewayHost(ctx context.Context, userId string) (string, error) {
+	return c.nodes.HashAddr(ctx, c.gatewayName, userId)
+}
+
+// Register creates the in-memory listener of the node, the caller serves its grpc server on Listener.
+func (c *StandaloneClient) Register(serviceName, host string, port int, opts ...grpc.DialOption) error {
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	listener, err := registry.register(serviceName, addr)
+	if err != nil {
+		return err
+	}
+	c.lock.Lock()
+	c.rpcRegisterName, c.rpcRegisterAddr, c.listener = serviceName, addr, listener
+	c.lock.Unlock()
+	return nil
+}
+
+func (c *StandaloneClient) UnRegister() error {
+	c.lock.Lock()
+	addr := c.rpcRegisterAddr
+	c.rpcRegisterName, c.rpcRegisterAddr, c.listener = "", "", nil
+	c.lock.Unlock()
+	if addr != "" {
+		registry.unregister(addr)
+	}
+	return nil
+}
+
+func (c *StandaloneClient) CreateRpcRootNodes(serviceNames []string) error {
+	return nil
+}
+
+func (c *StandaloneClient) RegisterConf2Registry(key string, conf []byte) error {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+	registry.confs[key] = conf
+	return nil
+}
+
+func (c *StandaloneClient) GetConfFromRegistry(key string) ([]byte, error) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+	conf, ok := registry.confs[key]
+	if !ok {
+		return nil, errs.New("conf not found", "key", key).Wrap()
+	}
+	return conf, nil
+}
+
+func (c *StandaloneClient) Close() {
+	_ = c.UnRegister()
+	c.nodes.Close()
+}
@@ -0,0 +1,81 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standalone
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestStandaloneRegisterAndCall(t *testing.T) {
+	server := NewStandaloneDiscoveryRegister("messageGateway")
+	if err := server.Register("user", "127.0.0.1", 10110); err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(Listener(server))
+	defer srv.Stop()
+
+	if err := NewStandaloneDiscoveryRegister("messageGateway").Register("user", "127.0.0.1", 10110); err == nil {
+		t.Fatal("registering an address twice should fail")
+	}
+
+	client := NewStandaloneDiscoveryRegister("messageGateway")
+	defer client.Close()
+	client.AddOption(grpc.WithTransportCredentials(insecure.NewCredentials()))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := client.GetConn(ctx, "user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	conns, err := client.GetConns(ctx, "user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conns) != 1 || conns[0].Target() != server.GetSelfConnTarget() {
+		t.Fatalf("unexpected node conns %v", conns)
+	}
+
+	server.Close()
+	if Listener(server) != nil {
+		t.Fatal("the listener should be released on close")
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		addrs, err := client.nodes.Addrs(ctx, "user")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(addrs) == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("the node is still watched after close %v", addrs)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
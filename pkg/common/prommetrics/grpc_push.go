@@ -19,8 +19,8 @@ import (
 )
 
 var (
-	MsgOfflinePushFailedCounter = prometheus.NewCounter(prometheus.CounterOpts{
+	MsgOfflinePushFailedCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "msg_offline_push_failed_total",
 		Help: "The number of msg failed offline pushed",
-	})
+	}, []string{"provider"})
 )
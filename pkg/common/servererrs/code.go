@@ -74,6 +74,8 @@ const (
 	MutedInGroup          = 1402 // Member muted in the group
 	MutedGroup            = 1403 // Group is muted
 	MsgAlreadyRevoke      = 1404 // Message already revoked
+	MsgEditExpired        = 1405 // Message can no longer be edited
+	LegalHold             = 1406 // Data is under legal hold and can not be deleted
 
 	// Token error codes.
 	TokenExpiredError     = 1501
@@ -89,6 +91,7 @@ const (
 	ConnArgsErr          = 1602
 	PushMsgErr           = 1603
 	IOSBackgroundPushErr = 1604
+	ConnDraining         = 1605
 
 	// S3 error codes.
 	FileUploadedExpiredError = 1701 // Upload expired
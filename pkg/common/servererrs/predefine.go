@@ -57,12 +57,15 @@ var (
 	ErrMutedInGroup     = errs.NewCodeError(MutedInGroup, "MutedInGroup")
 	ErrMutedGroup       = errs.NewCodeError(MutedGroup, "MutedGroup")
 	ErrMsgAlreadyRevoke = errs.NewCodeError(MsgAlreadyRevoke, "MsgAlreadyRevoke")
+	ErrMsgEditExpired   = errs.NewCodeError(MsgEditExpired, "MsgEditExpired")
+	ErrLegalHold        = errs.NewCodeError(LegalHold, "LegalHold")
 
 	ErrConnOverMaxNumLimit = errs.NewCodeError(ConnOverMaxNumLimit, "ConnOverMaxNumLimit")
 
 	ErrConnArgsErr          = errs.NewCodeError(ConnArgsErr, "args err, need token, sendID, platformID")
 	ErrPushMsgErr           = errs.NewCodeError(PushMsgErr, "push msg err")
 	ErrIOSBackgroundPushErr = errs.NewCodeError(IOSBackgroundPushErr, "ios background push err")
+	ErrConnDraining         = errs.NewCodeError(ConnDraining, "gateway is draining, connect to another node")
 
 	ErrFileUploadedExpired = errs.NewCodeError(FileUploadedExpiredError, "FileUploadedExpiredError")
 )
@@ -31,7 +31,9 @@ import (
 
 	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	kdisc "github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister/standalone"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
@@ -41,12 +43,23 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // Start rpc server.
 func Start[T any](ctx context.Context, zookeeperConfig *config2.ZooKeeper, prometheusConfig *config2.Prometheus, listenIP,
 	registerIP string, rpcPorts []int, index int, rpcRegisterName string, share *config2.Share, config T, rpcFn func(ctx context.Context,
 	config T, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error, options ...grpc.ServerOption) error {
+	return StartWithDrain(ctx, zookeeperConfig, prometheusConfig, listenIP, registerIP, rpcPorts, index, rpcRegisterName,
+		share, config, rpcFn, nil, options...)
+}
+
+// StartWithDrain is Start with drain called on SIGTERM while the rpc server is still serving, so a service holding
+// long-lived client connections can hand them over to other nodes before it stops.
+func StartWithDrain[T any](ctx context.Context, zookeeperConfig *config2.ZooKeeper, prometheusConfig *config2.Prometheus, listenIP,
+	registerIP string, rpcPorts []int, index int, rpcRegisterName string, share *config2.Share, config T, rpcFn func(ctx context.Context,
+	config T, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error, drain func(), options ...grpc.ServerOption) error {
 
 	rpcPort, err := datautil.GetElemByIndex(rpcPorts, index)
 	if err != nil {
@@ -74,7 +87,7 @@ func Start[T any](ctx context.Context, zookeeperConfig *config2.ZooKeeper, prome
 	}
 
 	defer client.Close()
-	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
+	client.AddOption(mw.GrpcClient(), tracing.GrpcDialOption(), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
 	registerIP, err = network.GetRpcRegisterIP(registerIP)
 	if err != nil {
 		return err
@@ -90,8 +103,12 @@ func Start[T any](ctx context.Context, zookeeperConfig *config2.ZooKeeper, prome
 	} else {
 		options = append(options, mw.GrpcServer())
 	}
+	options = append(options, tracing.GrpcServerOption())
 
 	srv := grpc.NewServer(options...)
+	// the health service lets the clients balancing over static addresses skip the stopping nodes
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(srv, healthServer)
 	once := sync.Once{}
 	defer func() {
 		once.Do(srv.GracefulStop)
@@ -113,7 +130,7 @@ func Start[T any](ctx context.Context, zookeeperConfig *config2.ZooKeeper, prome
 	}
 
 	var (
-		netDone    = make(chan struct{}, 2)
+		netDone    = make(chan struct{}, 3)
 		netErr     error
 		httpServer *http.Server
 	)
@@ -138,11 +155,26 @@ func Start[T any](ctx context.Context, zookeeperConfig *config2.ZooKeeper, prome
 		}
 	}()
 
+	// the services started in the same process call this one over its in-memory listener
+	if memListener := standalone.Listener(client); memListener != nil {
+		go func() {
+			err := srv.Serve(memListener)
+			if err != nil {
+				netErr = errs.WrapMsg(err, "rpc start err: ", "standalone")
+				netDone <- struct{}{}
+			}
+		}()
+	}
+
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGTERM)
 	select {
 	case <-sigs:
 		program.SIGTERMExit()
+		healthServer.Shutdown()
+		if drain != nil {
+			drain()
+		}
 		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 		defer cancel()
 		if err := gracefulStopWithCtx(ctx, srv.GracefulStop); err != nil {
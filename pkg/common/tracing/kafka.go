@@ -0,0 +1,156 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mq/kafka"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// traceHeaders are the kafka headers of the trace context, they follow the headers kafka.GetContextWithMQHeader
+// reads by position and must be left out of them.
+var traceHeaders = propagation.TraceContext{}.Fields()
+
+// KafkaProducer sends the messages like kafka.Producer and adds the trace context of the sender to their headers.
+type KafkaProducer struct {
+	topic    string
+	producer sarama.SyncProducer
+}
+
+func NewKafkaProducer(conf *sarama.Config, addr []string, topic string) (*KafkaProducer, error) {
+	producer, err := kafka.NewProducer(conf, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaProducer{topic: topic, producer: producer}, nil
+}
+
+func (p *KafkaProducer) SendMessage(ctx context.Context, key string, msg proto.Message) (partition int32, offset int64, err error) {
+	ctx, span := Start(ctx, p.topic+" publish", trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(semconv.MessagingSystemKafka, semconv.MessagingOperationPublish,
+			semconv.MessagingDestinationName(p.topic), semconv.MessagingKafkaMessageKey(key)))
+	defer func() {
+		End(span, err)
+	}()
+	bMsg, err := proto.Marshal(msg)
+	if err != nil {
+		return 0, 0, errs.WrapMsg(err, "kafka proto Marshal err")
+	}
+	if len(bMsg) == 0 || len(key) == 0 {
+		return 0, 0, errs.New("kafka msg key or value is empty", "topic", p.topic, "key", key).Wrap()
+	}
+	header, err := kafka.GetMQHeaderWithContext(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	carrier := producerCarrier(header)
+	otel.GetTextMapPropagator().Inject(ctx, &carrier)
+	kMsg := &sarama.ProducerMessage{
+		Topic:   p.topic,
+		Key:     sarama.StringEncoder(key),
+		Value:   sarama.ByteEncoder(bMsg),
+		Headers: carrier,
+	}
+	partition, offset, err = p.producer.SendMessage(kMsg)
+	if err != nil {
+		return 0, 0, errs.WrapMsg(err, "p.producer.SendMessage error")
+	}
+	span.SetAttributes(semconv.MessagingKafkaDestinationPartition(int(partition)), semconv.MessagingKafkaMessageOffset(int(offset)))
+	return partition, offset, nil
+}
+
+// StartConsume returns the context of kafka.GetContextWithMQHeader for msg with a consumer span continuing
+// the trace of its producer.
+func StartConsume(msg *sarama.ConsumerMessage, groupID string) (context.Context, trace.Span) {
+	carrier := make(consumerCarrier, 0, len(traceHeaders))
+	header := make([]*sarama.RecordHeader, 0, len(msg.Headers))
+	for _, h := range msg.Headers {
+		if isTraceHeader(string(h.Key)) {
+			carrier = append(carrier, h)
+		} else {
+			header = append(header, h)
+		}
+	}
+	ctx := kafka.GetContextWithMQHeader(header)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
+	return Start(ctx, msg.Topic+" receive", trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(semconv.MessagingSystemKafka, semconv.MessagingOperationReceive,
+			semconv.MessagingDestinationName(msg.Topic), semconv.MessagingKafkaConsumerGroup(groupID),
+			semconv.MessagingKafkaMessageKey(string(msg.Key)), semconv.MessagingKafkaDestinationPartition(int(msg.Partition)),
+			semconv.MessagingKafkaMessageOffset(int(msg.Offset))))
+}
+
+func isTraceHeader(key string) bool {
+	for _, field := range traceHeaders {
+		if key == field {
+			return true
+		}
+	}
+	return false
+}
+
+// producerCarrier adds the trace context to the headers of a sent message.
+type producerCarrier []sarama.RecordHeader
+
+func (c *producerCarrier) Get(key string) string {
+	for _, h := range *c {
+		if string(h.Key) == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func (c *producerCarrier) Set(key, value string) {
+	*c = append(*c, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+}
+
+func (c *producerCarrier) Keys() []string {
+	keys := make([]string, 0, len(*c))
+	for _, h := range *c {
+		keys = append(keys, string(h.Key))
+	}
+	return keys
+}
+
+// consumerCarrier reads the trace context from the headers of a received message.
+type consumerCarrier []*sarama.RecordHeader
+
+func (c consumerCarrier) Get(key string) string {
+	for _, h := range c {
+		if string(h.Key) == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func (c consumerCarrier) Set(string, string) {}
+
+func (c consumerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for _, h := range c {
+		keys = append(keys, string(h.Key))
+	}
+	return keys
+}
@@ -0,0 +1,61 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/mq/kafka"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestStartConsumeSplitsTraceHeaders(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+
+	traceID := trace.TraceID{1, 2, 3}
+	ctx := mcontext.WithMustInfoCtx([]string{"operationID", "opUserID", "1", "connID"})
+	ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     trace.SpanID{4, 5, 6},
+		TraceFlags: trace.FlagsSampled,
+	}))
+	header, err := kafka.GetMQHeaderWithContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	carrier := producerCarrier(header)
+	otel.GetTextMapPropagator().Inject(ctx, &carrier)
+	if len(carrier) != len(header)+1 {
+		t.Fatalf("expected the traceparent header to be added, got %d headers", len(carrier))
+	}
+
+	msg := &sarama.ConsumerMessage{Topic: "toPush"}
+	for i := range carrier {
+		msg.Headers = append(msg.Headers, &carrier[i])
+	}
+	ctx, span := StartConsume(msg, "push")
+	defer span.End()
+	if operationID := mcontext.GetOperationID(ctx); operationID != "operationID" {
+		t.Fatalf("expected operationID, got %q", operationID)
+	}
+	if got := trace.SpanContextFromContext(ctx).TraceID(); got != traceID {
+		t.Fatalf("expected trace %s, got %s", traceID, got)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/errs"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+const instrumentationName = "github.com/openimsdk/open-im-server/v3"
+
+var (
+	lock     sync.Mutex
+	provider *sdktrace.TracerProvider
+)
+
+// Init exports the spans of the process to the OTLP endpoint of conf. Until it is called, or when conf
+// is disabled, the spans are not recorded and no trace context is propagated.
+// The services started in the same process share the provider of the first one.
+func Init(ctx context.Context, serviceName string, conf *config.Tracing) error {
+	if !conf.Enable {
+		return nil
+	}
+	if conf.SampleRatio < 0 || conf.SampleRatio > 1 {
+		return errs.New("tracing sampleRatio must be between 0 and 1", "sampleRatio", conf.SampleRatio).Wrap()
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if provider != nil {
+		return nil
+	}
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(conf.Endpoint)}
+	if conf.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return errs.WrapMsg(err, "create otlp exporter failed", "endpoint", conf.Endpoint)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(serviceName), semconv.ServiceVersion(config.Version)))
+	if err != nil {
+		return errs.WrapMsg(err, "create tracing resource failed")
+	}
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(conf.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return nil
+}
+
+// Shutdown exports the spans still buffered and stops the exporter.
+func Shutdown(ctx context.Context) error {
+	lock.Lock()
+	defer lock.Unlock()
+	if provider == nil {
+		return nil
+	}
+	err := provider.Shutdown(ctx)
+	provider = nil
+	return errs.WrapMsg(err, "shutdown tracer provider failed")
+}
+
+// Start starts a span under the span of ctx.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// End marks span failed when err is not nil and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// StartLinked starts a span handling messages sent in different traces. It continues the trace of the
+// first message and links the spans of the others, so the trace of every message leads to it.
+func StartLinked(ctx context.Context, name string, msgCtxs []context.Context, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	var (
+		parent trace.SpanContext
+		links  []trace.Link
+	)
+	for _, msgCtx := range msgCtxs {
+		spanContext := trace.SpanContextFromContext(msgCtx)
+		if !spanContext.IsValid() {
+			continue
+		}
+		if !parent.IsValid() {
+			parent = spanContext
+			continue
+		}
+		links = append(links, trace.Link{SpanContext: spanContext})
+	}
+	if parent.IsValid() {
+		ctx = trace.ContextWithSpanContext(ctx, parent)
+	}
+	return Start(ctx, name, append(opts, trace.WithLinks(links...))...)
+}
+
+// InjectHeader adds the trace context of ctx to the http headers.
+func InjectHeader(ctx context.Context, header map[string]string) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(header))
+}
+
+// GrpcServerOption continues the trace of the grpc metadata in the rpc handlers.
+func GrpcServerOption() grpc.ServerOption {
+	return grpc.StatsHandler(otelgrpc.NewServerHandler())
+}
+
+// GrpcDialOption adds the trace context of the calls to the grpc metadata.
+func GrpcDialOption() grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler())
+}
@@ -20,17 +20,19 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/callbackstruct"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tracing"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/mq/memamq"
 	"github.com/openimsdk/tools/utils/httputil"
+	"go.opentelemetry.io/otel/trace"
 	"net/http"
 )
 
 type Client struct {
 	client *httputil.HTTPClient
-	url    string
+	config *config.Webhooks
 	queue  *memamq.MemoryQueue
 }
 
@@ -39,7 +41,8 @@ const (
 	webhookBufferSize  = 100
 )
 
-func NewWebhookClient(url string, options ...*memamq.MemoryQueue) *Client {
+// NewWebhookClient posts to the url of conf, the url of the reloaded webhooks.yml is used once it is reloaded.
+func NewWebhookClient(conf *config.Webhooks, options ...*memamq.MemoryQueue) *Client {
 	var queue *memamq.MemoryQueue
 	if len(options) > 0 && options[0] != nil {
 		queue = options[0]
@@ -51,7 +54,7 @@ func NewWebhookClient(url string, options ...*memamq.MemoryQueue) *Client {
 
 	return &Client{
 		client: httputil.NewHTTPClient(httputil.NewClientConfig()),
-		url:    url,
+		config: conf,
 		queue:  queue,
 	}
 }
@@ -66,12 +69,18 @@ func (c *Client) AsyncPost(ctx context.Context, command string, req callbackstru
 	}
 }
 
-func (c *Client) post(ctx context.Context, command string, input interface{}, output callbackstruct.CallbackResp, timeout int) error {
+func (c *Client) post(ctx context.Context, command string, input interface{}, output callbackstruct.CallbackResp, timeout int) (err error) {
+	spanCtx, span := tracing.Start(ctx, "webhook "+command, trace.WithSpanKind(trace.SpanKindClient))
+	defer func() {
+		tracing.End(span, err)
+	}()
 	ctx = mcontext.WithMustInfoCtx([]string{mcontext.GetOperationID(ctx), mcontext.GetOpUserID(ctx), mcontext.GetOpUserPlatform(ctx), mcontext.GetConnID(ctx)})
-	fullURL := c.url + "/" + command
+	fullURL := c.config.Current().URL + "/" + command
 	log.ZInfo(ctx, "webhook", "url", fullURL, "input", input, "config", timeout)
 	operationID, _ := ctx.Value(constant.OperationID).(string)
-	b, err := c.client.Post(ctx, fullURL, map[string]string{constant.OperationID: operationID}, input, timeout)
+	header := map[string]string{constant.OperationID: operationID}
+	tracing.InjectHeader(spanCtx, header)
+	b, err := c.client.Post(ctx, fullURL, header, input, timeout)
 	if err != nil {
 		return servererrs.ErrNetwork.WrapMsg(err.Error(), "post url", fullURL)
 	}
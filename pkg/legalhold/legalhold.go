@@ -0,0 +1,208 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package legalhold tells whether the data of a user, a group or a conversation is frozen by a legal hold,
+// the destructive paths load the active holds and refuse or skip the deletion of held data.
+package legalhold
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// Holds indexes the active legal holds by the user and the conversation they freeze.
+type Holds struct {
+	users         map[string][]string
+	conversations map[string][]string
+}
+
+func NewHolds(holds []*relation.LegalHoldModel) *Holds {
+	h := &Holds{
+		users:         make(map[string][]string),
+		conversations: make(map[string][]string),
+	}
+	for _, hold := range holds {
+		switch hold.TargetType {
+		case relation.LegalHoldTargetUser:
+			h.users[hold.TargetID] = append(h.users[hold.TargetID], hold.HoldID)
+		case relation.LegalHoldTargetGroup:
+			conversationID := msgprocessor.GetConversationIDBySessionType(constant.ReadGroupChatType, hold.TargetID)
+			h.conversations[conversationID] = append(h.conversations[conversationID], hold.HoldID)
+		case relation.LegalHoldTargetConversation:
+			h.conversations[hold.TargetID] = append(h.conversations[hold.TargetID], hold.HoldID)
+		}
+	}
+	return h
+}
+
+func (h *Holds) Empty() bool {
+	return len(h.users) == 0 && len(h.conversations) == 0
+}
+
+// HoldIDs returns all the holds.
+func (h *Holds) HoldIDs() []string {
+	var holdIDs []string
+	for _, ids := range h.users {
+		holdIDs = append(holdIDs, ids...)
+	}
+	for _, ids := range h.conversations {
+		holdIDs = append(holdIDs, ids...)
+	}
+	return datautil.Distinct(holdIDs)
+}
+
+// UserIDs returns the users under hold.
+func (h *Holds) UserIDs() []string {
+	return datautil.Keys(h.users)
+}
+
+// AddUserConversations extends the holds of the user to the conversations it takes part in,
+// such as its groups which can not be told from the conversationID.
+func (h *Holds) AddUserConversations(userID string, conversationIDs []string) {
+	holdIDs := h.users[userID]
+	if len(holdIDs) == 0 {
+		return
+	}
+	for _, conversationID := range conversationIDs {
+		h.conversations[conversationID] = datautil.Distinct(append(h.conversations[conversationID], holdIDs...))
+	}
+}
+
+// UserHoldIDs returns the holds freezing the user.
+func (h *Holds) UserHoldIDs(userID string) []string {
+	return h.users[userID]
+}
+
+// ConversationHoldIDs returns the holds freezing the conversation, the threads of a group follow the group
+// and single chats follow both users.
+func (h *Holds) ConversationHoldIDs(conversationID string) []string {
+	holdIDs := h.conversations[conversationID]
+	if msgprocessor.IsThread(conversationID) {
+		if groupID := threadGroupID(conversationID); groupID != "" {
+			holdIDs = append(holdIDs, h.conversations[msgprocessor.GetConversationIDBySessionType(constant.ReadGroupChatType, groupID)]...)
+		}
+	}
+	for _, prefix := range userConversationPrefixes {
+		if !strings.HasPrefix(conversationID, prefix) {
+			continue
+		}
+		for userID, userHoldIDs := range h.users {
+			if strings.HasPrefix(conversationID, prefix+userID+"_") || strings.HasSuffix(conversationID, "_"+userID) {
+				holdIDs = append(holdIDs, userHoldIDs...)
+			}
+		}
+	}
+	return datautil.Distinct(holdIDs)
+}
+
+// userConversationPrefixes prefix the conversations named after the two users taking part in them: the single
+// chats, the server notification chats and the notifications of both.
+var userConversationPrefixes = []string{"si_", "sn_", "n_"}
+
+// DocIDPattern returns the regular expression matching the docIDs of the msg docs of the held conversations,
+// it matches the conversations ConversationHoldIDs holds. It is empty when nothing is held.
+func (h *Holds) DocIDPattern() string {
+	var patterns []string
+	for conversationID := range h.conversations {
+		patterns = append(patterns, regexp.QuoteMeta(conversationID+":"))
+		if strings.HasPrefix(conversationID, "sg_") {
+			patterns = append(patterns, regexp.QuoteMeta(msgprocessor.GetThreadConversationID(strings.TrimPrefix(conversationID, "sg_"), "")))
+		}
+	}
+	for userID := range h.users {
+		for _, prefix := range userConversationPrefixes {
+			// the user sorts either first or second in the conversationID
+			patterns = append(patterns, regexp.QuoteMeta(prefix+userID+"_"), regexp.QuoteMeta(prefix)+"[^:]*_"+regexp.QuoteMeta(userID+":"))
+		}
+	}
+	if len(patterns) == 0 {
+		return ""
+	}
+	sort.Strings(patterns)
+	return "^(?:" + strings.Join(patterns, "|") + ")"
+}
+
+func threadGroupID(conversationID string) string {
+	groupRoot := strings.TrimPrefix(conversationID, "th_")
+	if i := strings.LastIndex(groupRoot, "_"); i > 0 {
+		return groupRoot[:i]
+	}
+	return ""
+}
+
+// Checker loads the active holds and records the deletions they block as audit events.
+type Checker struct {
+	db controller.LegalHoldDatabase
+	// userConversationIDs is used to extend user holds to their conversations, it is optional.
+	userConversationIDs func(ctx context.Context, userID string) ([]string, error)
+}
+
+func NewChecker(db controller.LegalHoldDatabase, userConversationIDs func(ctx context.Context, userID string) ([]string, error)) *Checker {
+	return &Checker{db: db, userConversationIDs: userConversationIDs}
+}
+
+// Load returns the holds active now.
+func (c *Checker) Load(ctx context.Context) (*Holds, error) {
+	holds, err := c.db.FindActiveLegalHolds(ctx)
+	if err != nil {
+		return nil, err
+	}
+	h := NewHolds(holds)
+	if c.userConversationIDs != nil {
+		for _, userID := range h.UserIDs() {
+			conversationIDs, err := c.userConversationIDs(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			h.AddUserConversations(userID, conversationIDs)
+		}
+	}
+	return h, nil
+}
+
+// Block records the deletion of targetID blocked by holdIDs and returns the error to report to the caller.
+func (c *Checker) Block(ctx context.Context, holdIDs []string, operation string, targetID string, detail string) error {
+	c.Audit(ctx, holdIDs, operation, targetID, detail)
+	return servererrs.ErrLegalHold.WrapMsg(fmt.Sprintf("%s is under legal hold", targetID), "holdIDs", holdIDs)
+}
+
+// Audit records the deletion of targetID skipped because of holdIDs.
+func (c *Checker) Audit(ctx context.Context, holdIDs []string, operation string, targetID string, detail string) {
+	log.ZWarn(ctx, "deletion blocked by legal hold", nil, "operation", operation, "targetID", targetID, "holdIDs", holdIDs, "detail", detail)
+	audit := &relation.LegalHoldAuditModel{
+		HoldIDs:        holdIDs,
+		Operation:      operation,
+		TargetID:       targetID,
+		OperatorUserID: mcontext.GetOpUserID(ctx),
+		OperationID:    mcontext.GetOperationID(ctx),
+		Detail:         detail,
+		CreateTime:     time.Now(),
+	}
+	if err := c.db.CreateLegalHoldAudits(ctx, []*relation.LegalHoldAuditModel{audit}); err != nil {
+		log.ZError(ctx, "create legal hold audit failed", err, "operation", operation, "targetID", targetID)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package legalhold
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func TestHoldsConversationHoldIDs(t *testing.T) {
+	holds := NewHolds([]*relation.LegalHoldModel{
+		{HoldID: "h1", TargetType: relation.LegalHoldTargetUser, TargetID: "u1"},
+		{HoldID: "h2", TargetType: relation.LegalHoldTargetGroup, TargetID: "g1"},
+		{HoldID: "h3", TargetType: relation.LegalHoldTargetConversation, TargetID: "si_u2_u3"},
+	})
+	holds.AddUserConversations("u1", []string{"sg_g2"})
+	tests := []struct {
+		name           string
+		conversationID string
+		want           []string
+	}{
+		{name: "group", conversationID: "sg_g1", want: []string{"h2"}},
+		{name: "thread of held group", conversationID: "th_g1_root", want: []string{"h2"}},
+		{name: "group of held user", conversationID: "sg_g2", want: []string{"h1"}},
+		{name: "single chat of held user", conversationID: "si_u0_u1", want: []string{"h1"}},
+		{name: "single chat of held user sorting first", conversationID: "si_u1_u9", want: []string{"h1"}},
+		{name: "notifications of held user", conversationID: "n_u0_u1", want: []string{"h1"}},
+		{name: "server notifications to held user", conversationID: "sn_admin_u1", want: []string{"h1"}},
+		{name: "conversation", conversationID: "si_u2_u3", want: []string{"h3"}},
+		{name: "not held", conversationID: "si_u2_u4", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := holds.ConversationHoldIDs(tt.conversationID); len(got) != len(tt.want) || (len(got) > 0 && !reflect.DeepEqual(got, tt.want)) {
+				t.Errorf("ConversationHoldIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHoldsDocIDPattern(t *testing.T) {
+	if pattern := NewHolds(nil).DocIDPattern(); pattern != "" {
+		t.Errorf("DocIDPattern() = %q, want empty", pattern)
+	}
+	holds := NewHolds([]*relation.LegalHoldModel{
+		{HoldID: "h1", TargetType: relation.LegalHoldTargetUser, TargetID: "u1"},
+		{HoldID: "h2", TargetType: relation.LegalHoldTargetGroup, TargetID: "g1"},
+		{HoldID: "h3", TargetType: relation.LegalHoldTargetConversation, TargetID: "si_u2_u3"},
+	})
+	pattern := regexp.MustCompile(holds.DocIDPattern())
+	tests := []struct {
+		docID string
+		want  bool
+	}{
+		{docID: "si_u1_u9:0", want: true},
+		// the held user sorts second
+		{docID: "si_u0_u1:0", want: true},
+		{docID: "n_u0_u1:3", want: true},
+		{docID: "sn_admin_u1:1", want: true},
+		{docID: "sg_g1:0", want: true},
+		{docID: "th_g1_root:0", want: true},
+		{docID: "si_u2_u3:2", want: true},
+		{docID: "si_u0_u10:0", want: false},
+		{docID: "si_u11_u2:0", want: false},
+		{docID: "sg_g10:0", want: false},
+		{docID: "si_u2_u4:0", want: false},
+	}
+	for _, tt := range tests {
+		// every doc held by DocIDPattern is held by ConversationHoldIDs too
+		conversationID := tt.docID[:strings.LastIndex(tt.docID, ":")]
+		if got := pattern.MatchString(tt.docID); got != tt.want || got != (len(holds.ConversationHoldIDs(conversationID)) > 0) {
+			t.Errorf("DocIDPattern() matches %s = %v, want %v", tt.docID, got, tt.want)
+		}
+	}
+}
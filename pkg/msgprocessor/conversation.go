@@ -36,6 +36,9 @@ func GetNotificationConversationIDByMsg(msg *sdkws.MsgData) string {
 		return "n_" + msg.GroupID
 	case constant.NotificationChatType:
 		return "n_" + msg.SendID + "_" + msg.RecvID
+	case ThreadChatType:
+		// notifications of a thread share the seqs of its replies
+		return GetThreadConversationID(msg.GroupID, msg.RecvID)
 	}
 	return ""
 }
@@ -52,6 +55,8 @@ func GetChatConversationIDByMsg(msg *sdkws.MsgData) string {
 		return "sg_" + msg.GroupID
 	case constant.NotificationChatType:
 		return "sn_" + msg.SendID + "_" + msg.RecvID
+	case ThreadChatType:
+		return GetThreadConversationID(msg.GroupID, msg.RecvID)
 	}
 
 	return ""
@@ -65,6 +70,8 @@ func GenConversationUniqueKey(msg *sdkws.MsgData) string {
 		return strings.Join(l, "_")
 	case constant.ReadGroupChatType:
 		return msg.GroupID
+	case ThreadChatType:
+		return GetThreadConversationID(msg.GroupID, msg.RecvID)
 	}
 	return ""
 }
@@ -94,6 +101,8 @@ func GetConversationIDByMsg(msg *sdkws.MsgData) string {
 			return "n_" + msg.SendID + "_" + msg.RecvID // super group chat
 		}
 		return "sn_" + msg.SendID + "_" + msg.RecvID // server notification chat
+	case ThreadChatType:
+		return GetThreadConversationID(msg.GroupID, msg.RecvID)
 	}
 	return ""
 }
@@ -168,6 +177,8 @@ func ParseConversationID(msg *sdkws.MsgData) (isNotification bool, conversationI
 			return true, "n_" + msg.SendID + "_" + msg.RecvID // super group chat
 		}
 		return false, "sn_" + msg.SendID + "_" + msg.RecvID // server notification chat
+	case ThreadChatType:
+		return false, GetThreadConversationID(msg.GroupID, msg.RecvID)
 	}
 	return false, ""
 }
@@ -50,7 +50,11 @@ func TestGetChatConversationIDByMsg(t *testing.T) {
 		args args
 		want string
 	}{
-		// TODO: Add test cases.
+		{
+			name: "thread",
+			args: args{msg: &sdkws.MsgData{SessionType: ThreadChatType, GroupID: "g1", RecvID: "root"}},
+			want: "th_g1_root",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
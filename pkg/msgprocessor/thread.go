@@ -0,0 +1,33 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import "strings"
+
+// ThreadChatType is the session type of replies in a thread of a group message.
+// Thread messages carry the group in GroupID and the clientMsgID of the root message in RecvID.
+const ThreadChatType = 5
+
+const threadConversationPrefix = "th_"
+
+// GetThreadConversationID returns the conversationID of the thread started from a group message,
+// it has its own seq space like any other conversation.
+func GetThreadConversationID(groupID, rootClientMsgID string) string {
+	return threadConversationPrefix + groupID + "_" + rootClientMsgID
+}
+
+func IsThread(conversationID string) bool {
+	return strings.HasPrefix(conversationID, threadConversationPrefix)
+}
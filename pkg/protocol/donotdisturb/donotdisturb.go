@@ -0,0 +1,48 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package donotdisturb
+
+import (
+	"errors"
+	"time"
+)
+
+func (x *SetDoNotDisturbReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.DoNotDisturb == nil {
+		return errors.New("doNotDisturb is empty")
+	}
+	if _, err := time.LoadLocation(x.DoNotDisturb.TimeZone); err != nil {
+		return errors.New("timeZone is invalid")
+	}
+	for _, window := range x.DoNotDisturb.Windows {
+		if window.Weekday < 0 || window.Weekday > 6 {
+			return errors.New("weekday is invalid")
+		}
+		if window.StartMinute < 0 || window.StartMinute >= 24*60 || window.EndMinute < 0 || window.EndMinute > 24*60 {
+			return errors.New("window minute is invalid")
+		}
+	}
+	return nil
+}
+
+func (x *GetDoNotDisturbReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
@@ -0,0 +1,583 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.26.0
+// source: donotdisturb/donotdisturb.proto
+
+package donotdisturb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TimeWindow is a weekly quiet period in the user's time zone.
+// endMinute <= startMinute means the window ends on the next day.
+type TimeWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 Sunday ... 6 Saturday
+	Weekday int32 `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	// Minutes since 00:00, 0 ~ 1439
+	StartMinute int32 `protobuf:"varint,2,opt,name=startMinute,proto3" json:"startMinute,omitempty"`
+	// Minutes since 00:00, 0 ~ 1440
+	EndMinute int32 `protobuf:"varint,3,opt,name=endMinute,proto3" json:"endMinute,omitempty"`
+}
+
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_donotdisturb_donotdisturb_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeWindow) ProtoMessage() {}
+
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_donotdisturb_donotdisturb_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return file_donotdisturb_donotdisturb_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TimeWindow) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *TimeWindow) GetStartMinute() int32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *TimeWindow) GetEndMinute() int32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+type DoNotDisturb struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	// IANA time zone name, e.g. Asia/Shanghai; empty means UTC
+	TimeZone string        `protobuf:"bytes,2,opt,name=timeZone,proto3" json:"timeZone,omitempty"`
+	Windows  []*TimeWindow `protobuf:"bytes,3,rep,name=windows,proto3" json:"windows,omitempty"`
+	// Messages that @ the user are still pushed during the windows
+	AllowAtMention bool `protobuf:"varint,4,opt,name=allowAtMention,proto3" json:"allowAtMention,omitempty"`
+	// Conversations that are still pushed during the windows
+	ExceptConversationIDs []string `protobuf:"bytes,5,rep,name=exceptConversationIDs,proto3" json:"exceptConversationIDs,omitempty"`
+	// Push without sound during the windows instead of suppressing the push
+	Silent bool `protobuf:"varint,6,opt,name=silent,proto3" json:"silent,omitempty"`
+}
+
+func (x *DoNotDisturb) Reset() {
+	*x = DoNotDisturb{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_donotdisturb_donotdisturb_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoNotDisturb) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoNotDisturb) ProtoMessage() {}
+
+func (x *DoNotDisturb) ProtoReflect() protoreflect.Message {
+	mi := &file_donotdisturb_donotdisturb_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoNotDisturb.ProtoReflect.Descriptor instead.
+func (*DoNotDisturb) Descriptor() ([]byte, []int) {
+	return file_donotdisturb_donotdisturb_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DoNotDisturb) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *DoNotDisturb) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *DoNotDisturb) GetWindows() []*TimeWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *DoNotDisturb) GetAllowAtMention() bool {
+	if x != nil {
+		return x.AllowAtMention
+	}
+	return false
+}
+
+func (x *DoNotDisturb) GetExceptConversationIDs() []string {
+	if x != nil {
+		return x.ExceptConversationIDs
+	}
+	return nil
+}
+
+func (x *DoNotDisturb) GetSilent() bool {
+	if x != nil {
+		return x.Silent
+	}
+	return false
+}
+
+type SetDoNotDisturbReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID       string        `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	DoNotDisturb *DoNotDisturb `protobuf:"bytes,2,opt,name=doNotDisturb,proto3" json:"doNotDisturb,omitempty"`
+}
+
+func (x *SetDoNotDisturbReq) Reset() {
+	*x = SetDoNotDisturbReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_donotdisturb_donotdisturb_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDoNotDisturbReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDoNotDisturbReq) ProtoMessage() {}
+
+func (x *SetDoNotDisturbReq) ProtoReflect() protoreflect.Message {
+	mi := &file_donotdisturb_donotdisturb_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDoNotDisturbReq.ProtoReflect.Descriptor instead.
+func (*SetDoNotDisturbReq) Descriptor() ([]byte, []int) {
+	return file_donotdisturb_donotdisturb_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetDoNotDisturbReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetDoNotDisturbReq) GetDoNotDisturb() *DoNotDisturb {
+	if x != nil {
+		return x.DoNotDisturb
+	}
+	return nil
+}
+
+type SetDoNotDisturbResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetDoNotDisturbResp) Reset() {
+	*x = SetDoNotDisturbResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_donotdisturb_donotdisturb_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDoNotDisturbResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDoNotDisturbResp) ProtoMessage() {}
+
+func (x *SetDoNotDisturbResp) ProtoReflect() protoreflect.Message {
+	mi := &file_donotdisturb_donotdisturb_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDoNotDisturbResp.ProtoReflect.Descriptor instead.
+func (*SetDoNotDisturbResp) Descriptor() ([]byte, []int) {
+	return file_donotdisturb_donotdisturb_proto_rawDescGZIP(), []int{3}
+}
+
+type GetDoNotDisturbReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *GetDoNotDisturbReq) Reset() {
+	*x = GetDoNotDisturbReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_donotdisturb_donotdisturb_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDoNotDisturbReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoNotDisturbReq) ProtoMessage() {}
+
+func (x *GetDoNotDisturbReq) ProtoReflect() protoreflect.Message {
+	mi := &file_donotdisturb_donotdisturb_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoNotDisturbReq.ProtoReflect.Descriptor instead.
+func (*GetDoNotDisturbReq) Descriptor() ([]byte, []int) {
+	return file_donotdisturb_donotdisturb_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDoNotDisturbReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetDoNotDisturbResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DoNotDisturb *DoNotDisturb `protobuf:"bytes,1,opt,name=doNotDisturb,proto3" json:"doNotDisturb,omitempty"`
+}
+
+func (x *GetDoNotDisturbResp) Reset() {
+	*x = GetDoNotDisturbResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_donotdisturb_donotdisturb_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDoNotDisturbResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoNotDisturbResp) ProtoMessage() {}
+
+func (x *GetDoNotDisturbResp) ProtoReflect() protoreflect.Message {
+	mi := &file_donotdisturb_donotdisturb_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoNotDisturbResp.ProtoReflect.Descriptor instead.
+func (*GetDoNotDisturbResp) Descriptor() ([]byte, []int) {
+	return file_donotdisturb_donotdisturb_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDoNotDisturbResp) GetDoNotDisturb() *DoNotDisturb {
+	if x != nil {
+		return x.DoNotDisturb
+	}
+	return nil
+}
+
+var File_donotdisturb_donotdisturb_proto protoreflect.FileDescriptor
+
+var file_donotdisturb_donotdisturb_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x64, 0x6f, 0x6e, 0x6f, 0x74, 0x64, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x2f, 0x64,
+	0x6f, 0x6e, 0x6f, 0x74, 0x64, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x13, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x64, 0x6f, 0x6e, 0x6f, 0x74, 0x64,
+	0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x22, 0x66, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x22, 0xf3,
+	0x01, 0x0a, 0x0c, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x64, 0x6f,
+	0x6e, 0x6f, 0x74, 0x64, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x26,
+	0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x74, 0x4d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x15, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x69, 0x6c, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x69,
+	0x6c, 0x65, 0x6e, 0x74, 0x22, 0x73, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x44, 0x6f, 0x4e, 0x6f, 0x74,
+	0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x45, 0x0a, 0x0c, 0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75,
+	0x72, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x64, 0x6f, 0x6e, 0x6f, 0x74, 0x64, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x2e, 0x44,
+	0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x52, 0x0c, 0x64, 0x6f, 0x4e,
+	0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74,
+	0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74,
+	0x75, 0x72, 0x62, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x5c,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0c, 0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69,
+	0x73, 0x74, 0x75, 0x72, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x64, 0x6f, 0x6e, 0x6f, 0x74, 0x64, 0x69, 0x73, 0x74, 0x75, 0x72,
+	0x62, 0x2e, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x52, 0x0c,
+	0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x32, 0xe1, 0x01, 0x0a,
+	0x13, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x44, 0x6f, 0x4e, 0x6f, 0x74,
+	0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x64, 0x6f, 0x6e, 0x6f, 0x74, 0x64, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x2e, 0x53, 0x65,
+	0x74, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x52, 0x65, 0x71,
+	0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x64, 0x6f, 0x6e, 0x6f, 0x74, 0x64,
+	0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44,
+	0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x12, 0x27, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x64, 0x6f, 0x6e, 0x6f, 0x74, 0x64, 0x69, 0x73, 0x74,
+	0x75, 0x72, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74,
+	0x75, 0x72, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x64, 0x6f, 0x6e, 0x6f, 0x74, 0x64, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x52, 0x65, 0x73, 0x70,
+	0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d,
+	0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x64, 0x6f, 0x6e, 0x6f, 0x74, 0x64, 0x69, 0x73,
+	0x74, 0x75, 0x72, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_donotdisturb_donotdisturb_proto_rawDescOnce sync.Once
+	file_donotdisturb_donotdisturb_proto_rawDescData = file_donotdisturb_donotdisturb_proto_rawDesc
+)
+
+func file_donotdisturb_donotdisturb_proto_rawDescGZIP() []byte {
+	file_donotdisturb_donotdisturb_proto_rawDescOnce.Do(func() {
+		file_donotdisturb_donotdisturb_proto_rawDescData = protoimpl.X.CompressGZIP(file_donotdisturb_donotdisturb_proto_rawDescData)
+	})
+	return file_donotdisturb_donotdisturb_proto_rawDescData
+}
+
+var file_donotdisturb_donotdisturb_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_donotdisturb_donotdisturb_proto_goTypes = []interface{}{
+	(*TimeWindow)(nil),          // 0: openim.donotdisturb.TimeWindow
+	(*DoNotDisturb)(nil),        // 1: openim.donotdisturb.DoNotDisturb
+	(*SetDoNotDisturbReq)(nil),  // 2: openim.donotdisturb.SetDoNotDisturbReq
+	(*SetDoNotDisturbResp)(nil), // 3: openim.donotdisturb.SetDoNotDisturbResp
+	(*GetDoNotDisturbReq)(nil),  // 4: openim.donotdisturb.GetDoNotDisturbReq
+	(*GetDoNotDisturbResp)(nil), // 5: openim.donotdisturb.GetDoNotDisturbResp
+}
+var file_donotdisturb_donotdisturb_proto_depIdxs = []int32{
+	0, // 0: openim.donotdisturb.DoNotDisturb.windows:type_name -> openim.donotdisturb.TimeWindow
+	1, // 1: openim.donotdisturb.SetDoNotDisturbReq.doNotDisturb:type_name -> openim.donotdisturb.DoNotDisturb
+	1, // 2: openim.donotdisturb.GetDoNotDisturbResp.doNotDisturb:type_name -> openim.donotdisturb.DoNotDisturb
+	2, // 3: openim.donotdisturb.DoNotDisturbService.SetDoNotDisturb:input_type -> openim.donotdisturb.SetDoNotDisturbReq
+	4, // 4: openim.donotdisturb.DoNotDisturbService.GetDoNotDisturb:input_type -> openim.donotdisturb.GetDoNotDisturbReq
+	3, // 5: openim.donotdisturb.DoNotDisturbService.SetDoNotDisturb:output_type -> openim.donotdisturb.SetDoNotDisturbResp
+	5, // 6: openim.donotdisturb.DoNotDisturbService.GetDoNotDisturb:output_type -> openim.donotdisturb.GetDoNotDisturbResp
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_donotdisturb_donotdisturb_proto_init() }
+func file_donotdisturb_donotdisturb_proto_init() {
+	if File_donotdisturb_donotdisturb_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_donotdisturb_donotdisturb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_donotdisturb_donotdisturb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoNotDisturb); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_donotdisturb_donotdisturb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDoNotDisturbReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_donotdisturb_donotdisturb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDoNotDisturbResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_donotdisturb_donotdisturb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDoNotDisturbReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_donotdisturb_donotdisturb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDoNotDisturbResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_donotdisturb_donotdisturb_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_donotdisturb_donotdisturb_proto_goTypes,
+		DependencyIndexes: file_donotdisturb_donotdisturb_proto_depIdxs,
+		MessageInfos:      file_donotdisturb_donotdisturb_proto_msgTypes,
+	}.Build()
+	File_donotdisturb_donotdisturb_proto = out.File
+	file_donotdisturb_donotdisturb_proto_rawDesc = nil
+	file_donotdisturb_donotdisturb_proto_goTypes = nil
+	file_donotdisturb_donotdisturb_proto_depIdxs = nil
+}
@@ -0,0 +1,160 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.0
+// source: donotdisturb/donotdisturb.proto
+
+package donotdisturb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DoNotDisturbService_SetDoNotDisturb_FullMethodName = "/openim.donotdisturb.DoNotDisturbService/SetDoNotDisturb"
+	DoNotDisturbService_GetDoNotDisturb_FullMethodName = "/openim.donotdisturb.DoNotDisturbService/GetDoNotDisturb"
+)
+
+// DoNotDisturbServiceClient is the client API for DoNotDisturbService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DoNotDisturbServiceClient interface {
+	SetDoNotDisturb(ctx context.Context, in *SetDoNotDisturbReq, opts ...grpc.CallOption) (*SetDoNotDisturbResp, error)
+	GetDoNotDisturb(ctx context.Context, in *GetDoNotDisturbReq, opts ...grpc.CallOption) (*GetDoNotDisturbResp, error)
+}
+
+type doNotDisturbServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDoNotDisturbServiceClient(cc grpc.ClientConnInterface) DoNotDisturbServiceClient {
+	return &doNotDisturbServiceClient{cc}
+}
+
+func (c *doNotDisturbServiceClient) SetDoNotDisturb(ctx context.Context, in *SetDoNotDisturbReq, opts ...grpc.CallOption) (*SetDoNotDisturbResp, error) {
+	out := new(SetDoNotDisturbResp)
+	err := c.cc.Invoke(ctx, DoNotDisturbService_SetDoNotDisturb_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doNotDisturbServiceClient) GetDoNotDisturb(ctx context.Context, in *GetDoNotDisturbReq, opts ...grpc.CallOption) (*GetDoNotDisturbResp, error) {
+	out := new(GetDoNotDisturbResp)
+	err := c.cc.Invoke(ctx, DoNotDisturbService_GetDoNotDisturb_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DoNotDisturbServiceServer is the server API for DoNotDisturbService service.
+// All implementations must embed UnimplementedDoNotDisturbServiceServer
+// for forward compatibility
+type DoNotDisturbServiceServer interface {
+	SetDoNotDisturb(context.Context, *SetDoNotDisturbReq) (*SetDoNotDisturbResp, error)
+	GetDoNotDisturb(context.Context, *GetDoNotDisturbReq) (*GetDoNotDisturbResp, error)
+	mustEmbedUnimplementedDoNotDisturbServiceServer()
+}
+
+// UnimplementedDoNotDisturbServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDoNotDisturbServiceServer struct {
+}
+
+func (UnimplementedDoNotDisturbServiceServer) SetDoNotDisturb(context.Context, *SetDoNotDisturbReq) (*SetDoNotDisturbResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDoNotDisturb not implemented")
+}
+func (UnimplementedDoNotDisturbServiceServer) GetDoNotDisturb(context.Context, *GetDoNotDisturbReq) (*GetDoNotDisturbResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoNotDisturb not implemented")
+}
+func (UnimplementedDoNotDisturbServiceServer) mustEmbedUnimplementedDoNotDisturbServiceServer() {}
+
+// UnsafeDoNotDisturbServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DoNotDisturbServiceServer will
+// result in compilation errors.
+type UnsafeDoNotDisturbServiceServer interface {
+	mustEmbedUnimplementedDoNotDisturbServiceServer()
+}
+
+func RegisterDoNotDisturbServiceServer(s grpc.ServiceRegistrar, srv DoNotDisturbServiceServer) {
+	s.RegisterService(&DoNotDisturbService_ServiceDesc, srv)
+}
+
+func _DoNotDisturbService_SetDoNotDisturb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDoNotDisturbReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoNotDisturbServiceServer).SetDoNotDisturb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoNotDisturbService_SetDoNotDisturb_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoNotDisturbServiceServer).SetDoNotDisturb(ctx, req.(*SetDoNotDisturbReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoNotDisturbService_GetDoNotDisturb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDoNotDisturbReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoNotDisturbServiceServer).GetDoNotDisturb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoNotDisturbService_GetDoNotDisturb_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoNotDisturbServiceServer).GetDoNotDisturb(ctx, req.(*GetDoNotDisturbReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DoNotDisturbService_ServiceDesc is the grpc.ServiceDesc for DoNotDisturbService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DoNotDisturbService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.donotdisturb.DoNotDisturbService",
+	HandlerType: (*DoNotDisturbServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetDoNotDisturb",
+			Handler:    _DoNotDisturbService_SetDoNotDisturb_Handler,
+		},
+		{
+			MethodName: "GetDoNotDisturb",
+			Handler:    _DoNotDisturbService_GetDoNotDisturb_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "donotdisturb/donotdisturb.proto",
+}
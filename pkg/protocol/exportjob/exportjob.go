@@ -0,0 +1,49 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportjob
+
+import "errors"
+
+func (x *CreateExportJobReq) Check() error {
+	switch x.TargetType {
+	case ExportTargetType_Conversation, ExportTargetType_User, ExportTargetType_Group:
+	default:
+		return errors.New("targetType is invalid")
+	}
+	if x.TargetID == "" {
+		return errors.New("targetID is empty")
+	}
+	if x.StartTime < 0 || x.EndTime < 0 {
+		return errors.New("time range is invalid")
+	}
+	if x.EndTime > 0 && x.StartTime > x.EndTime {
+		return errors.New("startTime is after endTime")
+	}
+	return nil
+}
+
+func (x *GetExportJobReq) Check() error {
+	if x.JobID == "" {
+		return errors.New("jobID is empty")
+	}
+	return nil
+}
+
+func (x *GetExportJobsReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
@@ -0,0 +1,880 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.26.0
+// source: exportjob/exportjob.proto
+
+package exportjob
+
+import (
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExportTargetType int32
+
+const (
+	ExportTargetType_Unknown      ExportTargetType = 0
+	ExportTargetType_Conversation ExportTargetType = 1
+	// all the conversations of the user
+	ExportTargetType_User  ExportTargetType = 2
+	ExportTargetType_Group ExportTargetType = 3
+)
+
+// Enum value maps for ExportTargetType.
+var (
+	ExportTargetType_name = map[int32]string{
+		0: "Unknown",
+		1: "Conversation",
+		2: "User",
+		3: "Group",
+	}
+	ExportTargetType_value = map[string]int32{
+		"Unknown":      0,
+		"Conversation": 1,
+		"User":         2,
+		"Group":        3,
+	}
+)
+
+func (x ExportTargetType) Enum() *ExportTargetType {
+	p := new(ExportTargetType)
+	*p = x
+	return p
+}
+
+func (x ExportTargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportTargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_exportjob_exportjob_proto_enumTypes[0].Descriptor()
+}
+
+func (ExportTargetType) Type() protoreflect.EnumType {
+	return &file_exportjob_exportjob_proto_enumTypes[0]
+}
+
+func (x ExportTargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportTargetType.Descriptor instead.
+func (ExportTargetType) EnumDescriptor() ([]byte, []int) {
+	return file_exportjob_exportjob_proto_rawDescGZIP(), []int{0}
+}
+
+type ExportJobStatus int32
+
+const (
+	ExportJobStatus_Pending ExportJobStatus = 0
+	ExportJobStatus_Running ExportJobStatus = 1
+	ExportJobStatus_Done    ExportJobStatus = 2
+	ExportJobStatus_Failed  ExportJobStatus = 3
+)
+
+// Enum value maps for ExportJobStatus.
+var (
+	ExportJobStatus_name = map[int32]string{
+		0: "Pending",
+		1: "Running",
+		2: "Done",
+		3: "Failed",
+	}
+	ExportJobStatus_value = map[string]int32{
+		"Pending": 0,
+		"Running": 1,
+		"Done":    2,
+		"Failed":  3,
+	}
+)
+
+func (x ExportJobStatus) Enum() *ExportJobStatus {
+	p := new(ExportJobStatus)
+	*p = x
+	return p
+}
+
+func (x ExportJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_exportjob_exportjob_proto_enumTypes[1].Descriptor()
+}
+
+func (ExportJobStatus) Type() protoreflect.EnumType {
+	return &file_exportjob_exportjob_proto_enumTypes[1]
+}
+
+func (x ExportJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportJobStatus.Descriptor instead.
+func (ExportJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_exportjob_exportjob_proto_rawDescGZIP(), []int{1}
+}
+
+type ExportJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID          string           `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	TargetType     ExportTargetType `protobuf:"varint,2,opt,name=targetType,proto3,enum=openim.exportjob.ExportTargetType" json:"targetType,omitempty"`
+	TargetID       string           `protobuf:"bytes,3,opt,name=targetID,proto3" json:"targetID,omitempty"`
+	StartTime      int64            `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime        int64            `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	OperatorUserID string           `protobuf:"bytes,6,opt,name=operatorUserID,proto3" json:"operatorUserID,omitempty"`
+	Status         ExportJobStatus  `protobuf:"varint,7,opt,name=status,proto3,enum=openim.exportjob.ExportJobStatus" json:"status,omitempty"`
+	// reason of the failure when status is Failed
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// size of the archive in bytes
+	Size    int64 `protobuf:"varint,9,opt,name=size,proto3" json:"size,omitempty"`
+	MsgNum  int64 `protobuf:"varint,10,opt,name=msgNum,proto3" json:"msgNum,omitempty"`
+	FileNum int64 `protobuf:"varint,11,opt,name=fileNum,proto3" json:"fileNum,omitempty"`
+	// signed download url of the archive when status is Done
+	Url           string `protobuf:"bytes,12,opt,name=url,proto3" json:"url,omitempty"`
+	UrlExpireTime int64  `protobuf:"varint,13,opt,name=urlExpireTime,proto3" json:"urlExpireTime,omitempty"`
+	CreateTime    int64  `protobuf:"varint,14,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	FinishTime    int64  `protobuf:"varint,15,opt,name=finishTime,proto3" json:"finishTime,omitempty"`
+}
+
+func (x *ExportJob) Reset() {
+	*x = ExportJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exportjob_exportjob_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJob) ProtoMessage() {}
+
+func (x *ExportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_exportjob_exportjob_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJob.ProtoReflect.Descriptor instead.
+func (*ExportJob) Descriptor() ([]byte, []int) {
+	return file_exportjob_exportjob_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportJob) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *ExportJob) GetTargetType() ExportTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return ExportTargetType_Unknown
+}
+
+func (x *ExportJob) GetTargetID() string {
+	if x != nil {
+		return x.TargetID
+	}
+	return ""
+}
+
+func (x *ExportJob) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ExportJob) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ExportJob) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
+	}
+	return ""
+}
+
+func (x *ExportJob) GetStatus() ExportJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExportJobStatus_Pending
+}
+
+func (x *ExportJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ExportJob) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ExportJob) GetMsgNum() int64 {
+	if x != nil {
+		return x.MsgNum
+	}
+	return 0
+}
+
+func (x *ExportJob) GetFileNum() int64 {
+	if x != nil {
+		return x.FileNum
+	}
+	return 0
+}
+
+func (x *ExportJob) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ExportJob) GetUrlExpireTime() int64 {
+	if x != nil {
+		return x.UrlExpireTime
+	}
+	return 0
+}
+
+func (x *ExportJob) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *ExportJob) GetFinishTime() int64 {
+	if x != nil {
+		return x.FinishTime
+	}
+	return 0
+}
+
+// CreateExportJobReq exports the msgs sent in [startTime, endTime] in unix milliseconds, 0 is unbounded.
+type CreateExportJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetType ExportTargetType `protobuf:"varint,1,opt,name=targetType,proto3,enum=openim.exportjob.ExportTargetType" json:"targetType,omitempty"`
+	TargetID   string           `protobuf:"bytes,2,opt,name=targetID,proto3" json:"targetID,omitempty"`
+	StartTime  int64            `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime    int64            `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+}
+
+func (x *CreateExportJobReq) Reset() {
+	*x = CreateExportJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exportjob_exportjob_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateExportJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExportJobReq) ProtoMessage() {}
+
+func (x *CreateExportJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_exportjob_exportjob_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExportJobReq.ProtoReflect.Descriptor instead.
+func (*CreateExportJobReq) Descriptor() ([]byte, []int) {
+	return file_exportjob_exportjob_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateExportJobReq) GetTargetType() ExportTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return ExportTargetType_Unknown
+}
+
+func (x *CreateExportJobReq) GetTargetID() string {
+	if x != nil {
+		return x.TargetID
+	}
+	return ""
+}
+
+func (x *CreateExportJobReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CreateExportJobReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type CreateExportJobResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID string `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+}
+
+func (x *CreateExportJobResp) Reset() {
+	*x = CreateExportJobResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exportjob_exportjob_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateExportJobResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExportJobResp) ProtoMessage() {}
+
+func (x *CreateExportJobResp) ProtoReflect() protoreflect.Message {
+	mi := &file_exportjob_exportjob_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExportJobResp.ProtoReflect.Descriptor instead.
+func (*CreateExportJobResp) Descriptor() ([]byte, []int) {
+	return file_exportjob_exportjob_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateExportJobResp) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+type GetExportJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID string `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+}
+
+func (x *GetExportJobReq) Reset() {
+	*x = GetExportJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exportjob_exportjob_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExportJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportJobReq) ProtoMessage() {}
+
+func (x *GetExportJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_exportjob_exportjob_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportJobReq.ProtoReflect.Descriptor instead.
+func (*GetExportJobReq) Descriptor() ([]byte, []int) {
+	return file_exportjob_exportjob_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetExportJobReq) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+type GetExportJobResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *ExportJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *GetExportJobResp) Reset() {
+	*x = GetExportJobResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exportjob_exportjob_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExportJobResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportJobResp) ProtoMessage() {}
+
+func (x *GetExportJobResp) ProtoReflect() protoreflect.Message {
+	mi := &file_exportjob_exportjob_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportJobResp.ProtoReflect.Descriptor instead.
+func (*GetExportJobResp) Descriptor() ([]byte, []int) {
+	return file_exportjob_exportjob_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetExportJobResp) GetJob() *ExportJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetExportJobsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetExportJobsReq) Reset() {
+	*x = GetExportJobsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exportjob_exportjob_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExportJobsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportJobsReq) ProtoMessage() {}
+
+func (x *GetExportJobsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_exportjob_exportjob_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportJobsReq.ProtoReflect.Descriptor instead.
+func (*GetExportJobsReq) Descriptor() ([]byte, []int) {
+	return file_exportjob_exportjob_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetExportJobsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetExportJobsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64        `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Jobs  []*ExportJob `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *GetExportJobsResp) Reset() {
+	*x = GetExportJobsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exportjob_exportjob_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExportJobsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportJobsResp) ProtoMessage() {}
+
+func (x *GetExportJobsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_exportjob_exportjob_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportJobsResp.ProtoReflect.Descriptor instead.
+func (*GetExportJobsResp) Descriptor() ([]byte, []int) {
+	return file_exportjob_exportjob_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetExportJobsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetExportJobsResp) GetJobs() []*ExportJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+var File_exportjob_exportjob_proto protoreflect.FileDescriptor
+
+var file_exportjob_exportjob_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x6a, 0x6f, 0x62, 0x2f, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x6a, 0x6f, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x6a, 0x6f, 0x62, 0x1a, 0x11, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xf0, 0x03, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x14,
+	0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a,
+	0x6f, 0x62, 0x49, 0x44, 0x12, 0x42, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x6a, 0x6f, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x6a, 0x6f, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f,
+	0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x73, 0x67,
+	0x4e, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4e, 0x75,
+	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x24, 0x0a,
+	0x0d, 0x75, 0x72, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x72, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x42, 0x0a, 0x0a, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x6a, 0x6f,
+	0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x22,
+	0x27, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x41, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2d, 0x0a, 0x03,
+	0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x6a, 0x6f, 0x62, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x53, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b,
+	0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x04, 0x6a,
+	0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x6a, 0x6f, 0x62, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x2a, 0x46, 0x0a, 0x10,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x10, 0x0a,
+	0x0c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x01, 0x12,
+	0x08, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x10, 0x03, 0x2a, 0x41, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f,
+	0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x6f, 0x6e, 0x65, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x32, 0xa3, 0x02, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5e, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12,
+	0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x6a,
+	0x6f, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x6a, 0x6f, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x21, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x6a, 0x6f, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a,
+	0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x6a,
+	0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x4a, 0x6f, 0x62, 0x73, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x6a, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x6a, 0x6f, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x3f, 0x5a,
+	0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x6a, 0x6f, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_exportjob_exportjob_proto_rawDescOnce sync.Once
+	file_exportjob_exportjob_proto_rawDescData = file_exportjob_exportjob_proto_rawDesc
+)
+
+func file_exportjob_exportjob_proto_rawDescGZIP() []byte {
+	file_exportjob_exportjob_proto_rawDescOnce.Do(func() {
+		file_exportjob_exportjob_proto_rawDescData = protoimpl.X.CompressGZIP(file_exportjob_exportjob_proto_rawDescData)
+	})
+	return file_exportjob_exportjob_proto_rawDescData
+}
+
+var file_exportjob_exportjob_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_exportjob_exportjob_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_exportjob_exportjob_proto_goTypes = []interface{}{
+	(ExportTargetType)(0),           // 0: openim.exportjob.ExportTargetType
+	(ExportJobStatus)(0),            // 1: openim.exportjob.ExportJobStatus
+	(*ExportJob)(nil),               // 2: openim.exportjob.ExportJob
+	(*CreateExportJobReq)(nil),      // 3: openim.exportjob.CreateExportJobReq
+	(*CreateExportJobResp)(nil),     // 4: openim.exportjob.CreateExportJobResp
+	(*GetExportJobReq)(nil),         // 5: openim.exportjob.GetExportJobReq
+	(*GetExportJobResp)(nil),        // 6: openim.exportjob.GetExportJobResp
+	(*GetExportJobsReq)(nil),        // 7: openim.exportjob.GetExportJobsReq
+	(*GetExportJobsResp)(nil),       // 8: openim.exportjob.GetExportJobsResp
+	(*sdkws.RequestPagination)(nil), // 9: openim.sdkws.RequestPagination
+}
+var file_exportjob_exportjob_proto_depIdxs = []int32{
+	0, // 0: openim.exportjob.ExportJob.targetType:type_name -> openim.exportjob.ExportTargetType
+	1, // 1: openim.exportjob.ExportJob.status:type_name -> openim.exportjob.ExportJobStatus
+	0, // 2: openim.exportjob.CreateExportJobReq.targetType:type_name -> openim.exportjob.ExportTargetType
+	2, // 3: openim.exportjob.GetExportJobResp.job:type_name -> openim.exportjob.ExportJob
+	9, // 4: openim.exportjob.GetExportJobsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	2, // 5: openim.exportjob.GetExportJobsResp.jobs:type_name -> openim.exportjob.ExportJob
+	3, // 6: openim.exportjob.ExportJobService.CreateExportJob:input_type -> openim.exportjob.CreateExportJobReq
+	5, // 7: openim.exportjob.ExportJobService.GetExportJob:input_type -> openim.exportjob.GetExportJobReq
+	7, // 8: openim.exportjob.ExportJobService.GetExportJobs:input_type -> openim.exportjob.GetExportJobsReq
+	4, // 9: openim.exportjob.ExportJobService.CreateExportJob:output_type -> openim.exportjob.CreateExportJobResp
+	6, // 10: openim.exportjob.ExportJobService.GetExportJob:output_type -> openim.exportjob.GetExportJobResp
+	8, // 11: openim.exportjob.ExportJobService.GetExportJobs:output_type -> openim.exportjob.GetExportJobsResp
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_exportjob_exportjob_proto_init() }
+func file_exportjob_exportjob_proto_init() {
+	if File_exportjob_exportjob_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_exportjob_exportjob_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exportjob_exportjob_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExportJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exportjob_exportjob_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExportJobResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exportjob_exportjob_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExportJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exportjob_exportjob_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExportJobResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exportjob_exportjob_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExportJobsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exportjob_exportjob_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExportJobsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_exportjob_exportjob_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_exportjob_exportjob_proto_goTypes,
+		DependencyIndexes: file_exportjob_exportjob_proto_depIdxs,
+		EnumInfos:         file_exportjob_exportjob_proto_enumTypes,
+		MessageInfos:      file_exportjob_exportjob_proto_msgTypes,
+	}.Build()
+	File_exportjob_exportjob_proto = out.File
+	file_exportjob_exportjob_proto_rawDesc = nil
+	file_exportjob_exportjob_proto_goTypes = nil
+	file_exportjob_exportjob_proto_depIdxs = nil
+}
@@ -0,0 +1,197 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.0
+// source: exportjob/exportjob.proto
+
+package exportjob
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ExportJobService_CreateExportJob_FullMethodName = "/openim.exportjob.ExportJobService/CreateExportJob"
+	ExportJobService_GetExportJob_FullMethodName    = "/openim.exportjob.ExportJobService/GetExportJob"
+	ExportJobService_GetExportJobs_FullMethodName   = "/openim.exportjob.ExportJobService/GetExportJobs"
+)
+
+// ExportJobServiceClient is the client API for ExportJobService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExportJobServiceClient interface {
+	CreateExportJob(ctx context.Context, in *CreateExportJobReq, opts ...grpc.CallOption) (*CreateExportJobResp, error)
+	GetExportJob(ctx context.Context, in *GetExportJobReq, opts ...grpc.CallOption) (*GetExportJobResp, error)
+	GetExportJobs(ctx context.Context, in *GetExportJobsReq, opts ...grpc.CallOption) (*GetExportJobsResp, error)
+}
+
+type exportJobServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExportJobServiceClient(cc grpc.ClientConnInterface) ExportJobServiceClient {
+	return &exportJobServiceClient{cc}
+}
+
+func (c *exportJobServiceClient) CreateExportJob(ctx context.Context, in *CreateExportJobReq, opts ...grpc.CallOption) (*CreateExportJobResp, error) {
+	out := new(CreateExportJobResp)
+	err := c.cc.Invoke(ctx, ExportJobService_CreateExportJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exportJobServiceClient) GetExportJob(ctx context.Context, in *GetExportJobReq, opts ...grpc.CallOption) (*GetExportJobResp, error) {
+	out := new(GetExportJobResp)
+	err := c.cc.Invoke(ctx, ExportJobService_GetExportJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exportJobServiceClient) GetExportJobs(ctx context.Context, in *GetExportJobsReq, opts ...grpc.CallOption) (*GetExportJobsResp, error) {
+	out := new(GetExportJobsResp)
+	err := c.cc.Invoke(ctx, ExportJobService_GetExportJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExportJobServiceServer is the server API for ExportJobService service.
+// All implementations must embed UnimplementedExportJobServiceServer
+// for forward compatibility
+type ExportJobServiceServer interface {
+	CreateExportJob(context.Context, *CreateExportJobReq) (*CreateExportJobResp, error)
+	GetExportJob(context.Context, *GetExportJobReq) (*GetExportJobResp, error)
+	GetExportJobs(context.Context, *GetExportJobsReq) (*GetExportJobsResp, error)
+	mustEmbedUnimplementedExportJobServiceServer()
+}
+
+// UnimplementedExportJobServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedExportJobServiceServer struct {
+}
+
+func (UnimplementedExportJobServiceServer) CreateExportJob(context.Context, *CreateExportJobReq) (*CreateExportJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExportJob not implemented")
+}
+func (UnimplementedExportJobServiceServer) GetExportJob(context.Context, *GetExportJobReq) (*GetExportJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportJob not implemented")
+}
+func (UnimplementedExportJobServiceServer) GetExportJobs(context.Context, *GetExportJobsReq) (*GetExportJobsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportJobs not implemented")
+}
+func (UnimplementedExportJobServiceServer) mustEmbedUnimplementedExportJobServiceServer() {}
+
+// UnsafeExportJobServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExportJobServiceServer will
+// result in compilation errors.
+type UnsafeExportJobServiceServer interface {
+	mustEmbedUnimplementedExportJobServiceServer()
+}
+
+func RegisterExportJobServiceServer(s grpc.ServiceRegistrar, srv ExportJobServiceServer) {
+	s.RegisterService(&ExportJobService_ServiceDesc, srv)
+}
+
+func _ExportJobService_CreateExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExportJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportJobServiceServer).CreateExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExportJobService_CreateExportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportJobServiceServer).CreateExportJob(ctx, req.(*CreateExportJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExportJobService_GetExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportJobServiceServer).GetExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExportJobService_GetExportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportJobServiceServer).GetExportJob(ctx, req.(*GetExportJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExportJobService_GetExportJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportJobsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportJobServiceServer).GetExportJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExportJobService_GetExportJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportJobServiceServer).GetExportJobs(ctx, req.(*GetExportJobsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExportJobService_ServiceDesc is the grpc.ServiceDesc for ExportJobService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExportJobService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.exportjob.ExportJobService",
+	HandlerType: (*ExportJobServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateExportJob",
+			Handler:    _ExportJobService_CreateExportJob_Handler,
+		},
+		{
+			MethodName: "GetExportJob",
+			Handler:    _ExportJobService_GetExportJob_Handler,
+		},
+		{
+			MethodName: "GetExportJobs",
+			Handler:    _ExportJobService_GetExportJobs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "exportjob/exportjob.proto",
+}
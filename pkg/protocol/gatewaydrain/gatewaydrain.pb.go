@@ -0,0 +1,368 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.26.0
+// source: gatewaydrain/gatewaydrain.proto
+
+package gatewaydrain
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DrainReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// seconds to wait for connections to leave, 0 uses the configured deadline
+	Deadline int32 `protobuf:"varint,1,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (x *DrainReq) Reset() {
+	*x = DrainReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gatewaydrain_gatewaydrain_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainReq) ProtoMessage() {}
+
+func (x *DrainReq) ProtoReflect() protoreflect.Message {
+	mi := &file_gatewaydrain_gatewaydrain_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainReq.ProtoReflect.Descriptor instead.
+func (*DrainReq) Descriptor() ([]byte, []int) {
+	return file_gatewaydrain_gatewaydrain_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DrainReq) GetDeadline() int32 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+type DrainResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OnlineUserConnNum int64 `protobuf:"varint,1,opt,name=onlineUserConnNum,proto3" json:"onlineUserConnNum,omitempty"`
+}
+
+func (x *DrainResp) Reset() {
+	*x = DrainResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gatewaydrain_gatewaydrain_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainResp) ProtoMessage() {}
+
+func (x *DrainResp) ProtoReflect() protoreflect.Message {
+	mi := &file_gatewaydrain_gatewaydrain_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainResp.ProtoReflect.Descriptor instead.
+func (*DrainResp) Descriptor() ([]byte, []int) {
+	return file_gatewaydrain_gatewaydrain_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DrainResp) GetOnlineUserConnNum() int64 {
+	if x != nil {
+		return x.OnlineUserConnNum
+	}
+	return 0
+}
+
+type GetDrainStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDrainStatusReq) Reset() {
+	*x = GetDrainStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gatewaydrain_gatewaydrain_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDrainStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDrainStatusReq) ProtoMessage() {}
+
+func (x *GetDrainStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_gatewaydrain_gatewaydrain_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDrainStatusReq.ProtoReflect.Descriptor instead.
+func (*GetDrainStatusReq) Descriptor() ([]byte, []int) {
+	return file_gatewaydrain_gatewaydrain_proto_rawDescGZIP(), []int{2}
+}
+
+type GetDrainStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Draining          bool  `protobuf:"varint,1,opt,name=draining,proto3" json:"draining,omitempty"`
+	OnlineUserConnNum int64 `protobuf:"varint,2,opt,name=onlineUserConnNum,proto3" json:"onlineUserConnNum,omitempty"`
+}
+
+func (x *GetDrainStatusResp) Reset() {
+	*x = GetDrainStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gatewaydrain_gatewaydrain_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDrainStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDrainStatusResp) ProtoMessage() {}
+
+func (x *GetDrainStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_gatewaydrain_gatewaydrain_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDrainStatusResp.ProtoReflect.Descriptor instead.
+func (*GetDrainStatusResp) Descriptor() ([]byte, []int) {
+	return file_gatewaydrain_gatewaydrain_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDrainStatusResp) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *GetDrainStatusResp) GetOnlineUserConnNum() int64 {
+	if x != nil {
+		return x.OnlineUserConnNum
+	}
+	return 0
+}
+
+var File_gatewaydrain_gatewaydrain_proto protoreflect.FileDescriptor
+
+var file_gatewaydrain_gatewaydrain_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x2f, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x13, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x22, 0x26, 0x0a, 0x08, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x39,
+	0x0a, 0x09, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x4e, 0x75, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x4e, 0x75, 0x6d, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x22, 0x5e,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x12, 0x2c, 0x0a, 0x11, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x6e, 0x4e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x4e, 0x75, 0x6d, 0x32, 0xb9,
+	0x01, 0x0a, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x12,
+	0x46, 0x0a, 0x05, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x44,
+	0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x72,
+	0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x72,
+	0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x69, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73,
+	0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gatewaydrain_gatewaydrain_proto_rawDescOnce sync.Once
+	file_gatewaydrain_gatewaydrain_proto_rawDescData = file_gatewaydrain_gatewaydrain_proto_rawDesc
+)
+
+func file_gatewaydrain_gatewaydrain_proto_rawDescGZIP() []byte {
+	file_gatewaydrain_gatewaydrain_proto_rawDescOnce.Do(func() {
+		file_gatewaydrain_gatewaydrain_proto_rawDescData = protoimpl.X.CompressGZIP(file_gatewaydrain_gatewaydrain_proto_rawDescData)
+	})
+	return file_gatewaydrain_gatewaydrain_proto_rawDescData
+}
+
+var file_gatewaydrain_gatewaydrain_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_gatewaydrain_gatewaydrain_proto_goTypes = []interface{}{
+	(*DrainReq)(nil),           // 0: openim.gatewaydrain.DrainReq
+	(*DrainResp)(nil),          // 1: openim.gatewaydrain.DrainResp
+	(*GetDrainStatusReq)(nil),  // 2: openim.gatewaydrain.GetDrainStatusReq
+	(*GetDrainStatusResp)(nil), // 3: openim.gatewaydrain.GetDrainStatusResp
+}
+var file_gatewaydrain_gatewaydrain_proto_depIdxs = []int32{
+	0, // 0: openim.gatewaydrain.GatewayDrain.Drain:input_type -> openim.gatewaydrain.DrainReq
+	2, // 1: openim.gatewaydrain.GatewayDrain.GetDrainStatus:input_type -> openim.gatewaydrain.GetDrainStatusReq
+	1, // 2: openim.gatewaydrain.GatewayDrain.Drain:output_type -> openim.gatewaydrain.DrainResp
+	3, // 3: openim.gatewaydrain.GatewayDrain.GetDrainStatus:output_type -> openim.gatewaydrain.GetDrainStatusResp
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_gatewaydrain_gatewaydrain_proto_init() }
+func file_gatewaydrain_gatewaydrain_proto_init() {
+	if File_gatewaydrain_gatewaydrain_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gatewaydrain_gatewaydrain_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gatewaydrain_gatewaydrain_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gatewaydrain_gatewaydrain_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDrainStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gatewaydrain_gatewaydrain_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDrainStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gatewaydrain_gatewaydrain_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gatewaydrain_gatewaydrain_proto_goTypes,
+		DependencyIndexes: file_gatewaydrain_gatewaydrain_proto_depIdxs,
+		MessageInfos:      file_gatewaydrain_gatewaydrain_proto_msgTypes,
+	}.Build()
+	File_gatewaydrain_gatewaydrain_proto = out.File
+	file_gatewaydrain_gatewaydrain_proto_rawDesc = nil
+	file_gatewaydrain_gatewaydrain_proto_goTypes = nil
+	file_gatewaydrain_gatewaydrain_proto_depIdxs = nil
+}
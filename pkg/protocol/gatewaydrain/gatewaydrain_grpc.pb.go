@@ -0,0 +1,164 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.0
+// source: gatewaydrain/gatewaydrain.proto
+
+package gatewaydrain
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GatewayDrain_Drain_FullMethodName          = "/openim.gatewaydrain.GatewayDrain/Drain"
+	GatewayDrain_GetDrainStatus_FullMethodName = "/openim.gatewaydrain.GatewayDrain/GetDrainStatus"
+)
+
+// GatewayDrainClient is the client API for GatewayDrain service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GatewayDrainClient interface {
+	// Drain is served by the msggateway node it is sent to: the node refuses new connections, leaves service
+	// discovery, asks its clients to reconnect elsewhere in batches and exits when they are gone or the deadline passes.
+	Drain(ctx context.Context, in *DrainReq, opts ...grpc.CallOption) (*DrainResp, error)
+	GetDrainStatus(ctx context.Context, in *GetDrainStatusReq, opts ...grpc.CallOption) (*GetDrainStatusResp, error)
+}
+
+type gatewayDrainClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGatewayDrainClient(cc grpc.ClientConnInterface) GatewayDrainClient {
+	return &gatewayDrainClient{cc}
+}
+
+func (c *gatewayDrainClient) Drain(ctx context.Context, in *DrainReq, opts ...grpc.CallOption) (*DrainResp, error) {
+	out := new(DrainResp)
+	err := c.cc.Invoke(ctx, GatewayDrain_Drain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDrainClient) GetDrainStatus(ctx context.Context, in *GetDrainStatusReq, opts ...grpc.CallOption) (*GetDrainStatusResp, error) {
+	out := new(GetDrainStatusResp)
+	err := c.cc.Invoke(ctx, GatewayDrain_GetDrainStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GatewayDrainServer is the server API for GatewayDrain service.
+// All implementations must embed UnimplementedGatewayDrainServer
+// for forward compatibility
+type GatewayDrainServer interface {
+	// Drain is served by the msggateway node it is sent to: the node refuses new connections, leaves service
+	// discovery, asks its clients to reconnect elsewhere in batches and exits when they are gone or the deadline passes.
+	Drain(context.Context, *DrainReq) (*DrainResp, error)
+	GetDrainStatus(context.Context, *GetDrainStatusReq) (*GetDrainStatusResp, error)
+	mustEmbedUnimplementedGatewayDrainServer()
+}
+
+// UnimplementedGatewayDrainServer must be embedded to have forward compatible implementations.
+type UnimplementedGatewayDrainServer struct {
+}
+
+func (UnimplementedGatewayDrainServer) Drain(context.Context, *DrainReq) (*DrainResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+func (UnimplementedGatewayDrainServer) GetDrainStatus(context.Context, *GetDrainStatusReq) (*GetDrainStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrainStatus not implemented")
+}
+func (UnimplementedGatewayDrainServer) mustEmbedUnimplementedGatewayDrainServer() {}
+
+// UnsafeGatewayDrainServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GatewayDrainServer will
+// result in compilation errors.
+type UnsafeGatewayDrainServer interface {
+	mustEmbedUnimplementedGatewayDrainServer()
+}
+
+func RegisterGatewayDrainServer(s grpc.ServiceRegistrar, srv GatewayDrainServer) {
+	s.RegisterService(&GatewayDrain_ServiceDesc, srv)
+}
+
+func _GatewayDrain_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDrainServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDrain_Drain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDrainServer).Drain(ctx, req.(*DrainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDrain_GetDrainStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDrainStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDrainServer).GetDrainStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDrain_GetDrainStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDrainServer).GetDrainStatus(ctx, req.(*GetDrainStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GatewayDrain_ServiceDesc is the grpc.ServiceDesc for GatewayDrain service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GatewayDrain_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.gatewaydrain.GatewayDrain",
+	HandlerType: (*GatewayDrainServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Drain",
+			Handler:    _GatewayDrain_Drain_Handler,
+		},
+		{
+			MethodName: "GetDrainStatus",
+			Handler:    _GatewayDrain_GetDrainStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gatewaydrain/gatewaydrain.proto",
+}
@@ -0,0 +1,30 @@
+#!/usr/bin/env bash
+# Copyright © 2023 OpenIM. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Regenerates the Go code of the server-side protos in this directory.
+# Requires protoc, protoc-gen-go and protoc-gen-go-grpc in PATH.
+# Imports such as "sdkws/sdkws.proto" are resolved from the github.com/openimsdk/protocol module.
+
+set -e
+
+cd "$(dirname "$0")"
+PROTOCOL_DIR=$(go list -m -f '{{.Dir}}' github.com/openimsdk/protocol)
+
+for file in */*.proto; do
+  protoc -I . -I "${PROTOCOL_DIR}" \
+    --go_out=paths=source_relative:. \
+    --go-grpc_out=paths=source_relative:. \
+    "${file}"
+done
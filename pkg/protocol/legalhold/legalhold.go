@@ -0,0 +1,69 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package legalhold
+
+import "errors"
+
+func (x *CreateLegalHoldReq) Check() error {
+	switch x.TargetType {
+	case LegalHoldTargetType_User, LegalHoldTargetType_Group, LegalHoldTargetType_Conversation:
+	default:
+		return errors.New("targetType is invalid")
+	}
+	if x.TargetID == "" {
+		return errors.New("targetID is empty")
+	}
+	if x.Reason == "" {
+		return errors.New("reason is empty")
+	}
+	if x.ExpireTime < 0 {
+		return errors.New("expireTime is invalid")
+	}
+	return nil
+}
+
+func (x *UpdateLegalHoldReq) Check() error {
+	if x.HoldID == "" {
+		return errors.New("holdID is empty")
+	}
+	if x.Reason != nil && *x.Reason == "" {
+		return errors.New("reason is empty")
+	}
+	if x.ExpireTime != nil && *x.ExpireTime < 0 {
+		return errors.New("expireTime is invalid")
+	}
+	return nil
+}
+
+func (x *ReleaseLegalHoldReq) Check() error {
+	if x.HoldID == "" {
+		return errors.New("holdID is empty")
+	}
+	return nil
+}
+
+func (x *GetLegalHoldsReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *GetLegalHoldAuditsReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
@@ -0,0 +1,1205 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.26.0
+// source: legalhold/legalhold.proto
+
+package legalhold
+
+import (
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LegalHoldTargetType int32
+
+const (
+	LegalHoldTargetType_Unknown      LegalHoldTargetType = 0
+	LegalHoldTargetType_User         LegalHoldTargetType = 1
+	LegalHoldTargetType_Group        LegalHoldTargetType = 2
+	LegalHoldTargetType_Conversation LegalHoldTargetType = 3
+)
+
+// Enum value maps for LegalHoldTargetType.
+var (
+	LegalHoldTargetType_name = map[int32]string{
+		0: "Unknown",
+		1: "User",
+		2: "Group",
+		3: "Conversation",
+	}
+	LegalHoldTargetType_value = map[string]int32{
+		"Unknown":      0,
+		"User":         1,
+		"Group":        2,
+		"Conversation": 3,
+	}
+)
+
+func (x LegalHoldTargetType) Enum() *LegalHoldTargetType {
+	p := new(LegalHoldTargetType)
+	*p = x
+	return p
+}
+
+func (x LegalHoldTargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LegalHoldTargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_legalhold_legalhold_proto_enumTypes[0].Descriptor()
+}
+
+func (LegalHoldTargetType) Type() protoreflect.EnumType {
+	return &file_legalhold_legalhold_proto_enumTypes[0]
+}
+
+func (x LegalHoldTargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LegalHoldTargetType.Descriptor instead.
+func (LegalHoldTargetType) EnumDescriptor() ([]byte, []int) {
+	return file_legalhold_legalhold_proto_rawDescGZIP(), []int{0}
+}
+
+type LegalHold struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoldID         string              `protobuf:"bytes,1,opt,name=holdID,proto3" json:"holdID,omitempty"`
+	TargetType     LegalHoldTargetType `protobuf:"varint,2,opt,name=targetType,proto3,enum=openim.legalhold.LegalHoldTargetType" json:"targetType,omitempty"`
+	TargetID       string              `protobuf:"bytes,3,opt,name=targetID,proto3" json:"targetID,omitempty"`
+	Reason         string              `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	OperatorUserID string              `protobuf:"bytes,5,opt,name=operatorUserID,proto3" json:"operatorUserID,omitempty"`
+	// unix milliseconds, 0 when the hold lasts until it is released
+	ExpireTime int64 `protobuf:"varint,6,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	CreateTime int64 `protobuf:"varint,7,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	UpdateTime int64 `protobuf:"varint,8,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+	Active     bool  `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *LegalHold) Reset() {
+	*x = LegalHold{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legalhold_legalhold_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LegalHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegalHold) ProtoMessage() {}
+
+func (x *LegalHold) ProtoReflect() protoreflect.Message {
+	mi := &file_legalhold_legalhold_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegalHold.ProtoReflect.Descriptor instead.
+func (*LegalHold) Descriptor() ([]byte, []int) {
+	return file_legalhold_legalhold_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LegalHold) GetHoldID() string {
+	if x != nil {
+		return x.HoldID
+	}
+	return ""
+}
+
+func (x *LegalHold) GetTargetType() LegalHoldTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return LegalHoldTargetType_Unknown
+}
+
+func (x *LegalHold) GetTargetID() string {
+	if x != nil {
+		return x.TargetID
+	}
+	return ""
+}
+
+func (x *LegalHold) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LegalHold) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
+	}
+	return ""
+}
+
+func (x *LegalHold) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *LegalHold) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *LegalHold) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *LegalHold) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type LegalHoldAudit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoldIDs []string `protobuf:"bytes,1,rep,name=holdIDs,proto3" json:"holdIDs,omitempty"`
+	// the blocked operation, such as ClearMsg or DeleteLogs
+	Operation      string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	TargetID       string `protobuf:"bytes,3,opt,name=targetID,proto3" json:"targetID,omitempty"`
+	OperatorUserID string `protobuf:"bytes,4,opt,name=operatorUserID,proto3" json:"operatorUserID,omitempty"`
+	OperationID    string `protobuf:"bytes,5,opt,name=operationID,proto3" json:"operationID,omitempty"`
+	Detail         string `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"`
+	CreateTime     int64  `protobuf:"varint,7,opt,name=createTime,proto3" json:"createTime,omitempty"`
+}
+
+func (x *LegalHoldAudit) Reset() {
+	*x = LegalHoldAudit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legalhold_legalhold_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LegalHoldAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegalHoldAudit) ProtoMessage() {}
+
+func (x *LegalHoldAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_legalhold_legalhold_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegalHoldAudit.ProtoReflect.Descriptor instead.
+func (*LegalHoldAudit) Descriptor() ([]byte, []int) {
+	return file_legalhold_legalhold_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LegalHoldAudit) GetHoldIDs() []string {
+	if x != nil {
+		return x.HoldIDs
+	}
+	return nil
+}
+
+func (x *LegalHoldAudit) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *LegalHoldAudit) GetTargetID() string {
+	if x != nil {
+		return x.TargetID
+	}
+	return ""
+}
+
+func (x *LegalHoldAudit) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
+	}
+	return ""
+}
+
+func (x *LegalHoldAudit) GetOperationID() string {
+	if x != nil {
+		return x.OperationID
+	}
+	return ""
+}
+
+func (x *LegalHoldAudit) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *LegalHoldAudit) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type CreateLegalHoldReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetType LegalHoldTargetType `protobuf:"varint,1,opt,name=targetType,proto3,enum=openim.legalhold.LegalHoldTargetType" json:"targetType,omitempty"`
+	TargetID   string              `protobuf:"bytes,2,opt,name=targetID,proto3" json:"targetID,omitempty"`
+	Reason     string              `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpireTime int64               `protobuf:"varint,4,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+}
+
+func (x *CreateLegalHoldReq) Reset() {
+	*x = CreateLegalHoldReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legalhold_legalhold_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLegalHoldReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLegalHoldReq) ProtoMessage() {}
+
+func (x *CreateLegalHoldReq) ProtoReflect() protoreflect.Message {
+	mi := &file_legalhold_legalhold_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLegalHoldReq.ProtoReflect.Descriptor instead.
+func (*CreateLegalHoldReq) Descriptor() ([]byte, []int) {
+	return file_legalhold_legalhold_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateLegalHoldReq) GetTargetType() LegalHoldTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return LegalHoldTargetType_Unknown
+}
+
+func (x *CreateLegalHoldReq) GetTargetID() string {
+	if x != nil {
+		return x.TargetID
+	}
+	return ""
+}
+
+func (x *CreateLegalHoldReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateLegalHoldReq) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type CreateLegalHoldResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoldID string `protobuf:"bytes,1,opt,name=holdID,proto3" json:"holdID,omitempty"`
+}
+
+func (x *CreateLegalHoldResp) Reset() {
+	*x = CreateLegalHoldResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legalhold_legalhold_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLegalHoldResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLegalHoldResp) ProtoMessage() {}
+
+func (x *CreateLegalHoldResp) ProtoReflect() protoreflect.Message {
+	mi := &file_legalhold_legalhold_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLegalHoldResp.ProtoReflect.Descriptor instead.
+func (*CreateLegalHoldResp) Descriptor() ([]byte, []int) {
+	return file_legalhold_legalhold_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateLegalHoldResp) GetHoldID() string {
+	if x != nil {
+		return x.HoldID
+	}
+	return ""
+}
+
+type UpdateLegalHoldReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoldID string  `protobuf:"bytes,1,opt,name=holdID,proto3" json:"holdID,omitempty"`
+	Reason *string `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// 0 removes the expiry
+	ExpireTime *int64 `protobuf:"varint,3,opt,name=expireTime,proto3,oneof" json:"expireTime,omitempty"`
+}
+
+func (x *UpdateLegalHoldReq) Reset() {
+	*x = UpdateLegalHoldReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legalhold_legalhold_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLegalHoldReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLegalHoldReq) ProtoMessage() {}
+
+func (x *UpdateLegalHoldReq) ProtoReflect() protoreflect.Message {
+	mi := &file_legalhold_legalhold_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLegalHoldReq.ProtoReflect.Descriptor instead.
+func (*UpdateLegalHoldReq) Descriptor() ([]byte, []int) {
+	return file_legalhold_legalhold_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateLegalHoldReq) GetHoldID() string {
+	if x != nil {
+		return x.HoldID
+	}
+	return ""
+}
+
+func (x *UpdateLegalHoldReq) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateLegalHoldReq) GetExpireTime() int64 {
+	if x != nil && x.ExpireTime != nil {
+		return *x.ExpireTime
+	}
+	return 0
+}
+
+type UpdateLegalHoldResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateLegalHoldResp) Reset() {
+	*x = UpdateLegalHoldResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legalhold_legalhold_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLegalHoldResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLegalHoldResp) ProtoMessage() {}
+
+func (x *UpdateLegalHoldResp) ProtoReflect() protoreflect.Message {
+	mi := &file_legalhold_legalhold_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLegalHoldResp.ProtoReflect.Descriptor instead.
+func (*UpdateLegalHoldResp) Descriptor() ([]byte, []int) {
+	return file_legalhold_legalhold_proto_rawDescGZIP(), []int{5}
+}
+
+// ReleaseLegalHoldReq removes the hold, the deletions it blocked are allowed again.
+type ReleaseLegalHoldReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoldID string `protobuf:"bytes,1,opt,name=holdID,proto3" json:"holdID,omitempty"`
+}
+
+func (x *ReleaseLegalHoldReq) Reset() {
+	*x = ReleaseLegalHoldReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legalhold_legalhold_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseLegalHoldReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLegalHoldReq) ProtoMessage() {}
+
+func (x *ReleaseLegalHoldReq) ProtoReflect() protoreflect.Message {
+	mi := &file_legalhold_legalhold_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLegalHoldReq.ProtoReflect.Descriptor instead.
+func (*ReleaseLegalHoldReq) Descriptor() ([]byte, []int) {
+	return file_legalhold_legalhold_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReleaseLegalHoldReq) GetHoldID() string {
+	if x != nil {
+		return x.HoldID
+	}
+	return ""
+}
+
+type ReleaseLegalHoldResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReleaseLegalHoldResp) Reset() {
+	*x = ReleaseLegalHoldResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legalhold_legalhold_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseLegalHoldResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLegalHoldResp) ProtoMessage() {}
+
+func (x *ReleaseLegalHoldResp) ProtoReflect() protoreflect.Message {
+	mi := &file_legalhold_legalhold_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLegalHoldResp.ProtoReflect.Descriptor instead.
+func (*ReleaseLegalHoldResp) Descriptor() ([]byte, []int) {
+	return file_legalhold_legalhold_proto_rawDescGZIP(), []int{7}
+}
+
+type GetLegalHoldsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// all types when Unknown
+	TargetType LegalHoldTargetType      `protobuf:"varint,1,opt,name=targetType,proto3,enum=openim.legalhold.LegalHoldTargetType" json:"targetType,omitempty"`
+	TargetID   string                   `protobuf:"bytes,2,opt,name=targetID,proto3" json:"targetID,omitempty"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetLegalHoldsReq) Reset() {
+	*x = GetLegalHoldsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legalhold_legalhold_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLegalHoldsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLegalHoldsReq) ProtoMessage() {}
+
+func (x *GetLegalHoldsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_legalhold_legalhold_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLegalHoldsReq.ProtoReflect.Descriptor instead.
+func (*GetLegalHoldsReq) Descriptor() ([]byte, []int) {
+	return file_legalhold_legalhold_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetLegalHoldsReq) GetTargetType() LegalHoldTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return LegalHoldTargetType_Unknown
+}
+
+func (x *GetLegalHoldsReq) GetTargetID() string {
+	if x != nil {
+		return x.TargetID
+	}
+	return ""
+}
+
+func (x *GetLegalHoldsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetLegalHoldsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64        `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Holds []*LegalHold `protobuf:"bytes,2,rep,name=holds,proto3" json:"holds,omitempty"`
+}
+
+func (x *GetLegalHoldsResp) Reset() {
+	*x = GetLegalHoldsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legalhold_legalhold_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLegalHoldsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLegalHoldsResp) ProtoMessage() {}
+
+func (x *GetLegalHoldsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_legalhold_legalhold_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLegalHoldsResp.ProtoReflect.Descriptor instead.
+func (*GetLegalHoldsResp) Descriptor() ([]byte, []int) {
+	return file_legalhold_legalhold_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetLegalHoldsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetLegalHoldsResp) GetHolds() []*LegalHold {
+	if x != nil {
+		return x.Holds
+	}
+	return nil
+}
+
+type GetLegalHoldAuditsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// audits of all holds when empty
+	HoldID     string                   `protobuf:"bytes,1,opt,name=holdID,proto3" json:"holdID,omitempty"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetLegalHoldAuditsReq) Reset() {
+	*x = GetLegalHoldAuditsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legalhold_legalhold_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLegalHoldAuditsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLegalHoldAuditsReq) ProtoMessage() {}
+
+func (x *GetLegalHoldAuditsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_legalhold_legalhold_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLegalHoldAuditsReq.ProtoReflect.Descriptor instead.
+func (*GetLegalHoldAuditsReq) Descriptor() ([]byte, []int) {
+	return file_legalhold_legalhold_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetLegalHoldAuditsReq) GetHoldID() string {
+	if x != nil {
+		return x.HoldID
+	}
+	return ""
+}
+
+func (x *GetLegalHoldAuditsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetLegalHoldAuditsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total  int64             `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Audits []*LegalHoldAudit `protobuf:"bytes,2,rep,name=audits,proto3" json:"audits,omitempty"`
+}
+
+func (x *GetLegalHoldAuditsResp) Reset() {
+	*x = GetLegalHoldAuditsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_legalhold_legalhold_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLegalHoldAuditsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLegalHoldAuditsResp) ProtoMessage() {}
+
+func (x *GetLegalHoldAuditsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_legalhold_legalhold_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLegalHoldAuditsResp.ProtoReflect.Descriptor instead.
+func (*GetLegalHoldAuditsResp) Descriptor() ([]byte, []int) {
+	return file_legalhold_legalhold_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetLegalHoldAuditsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetLegalHoldAuditsResp) GetAudits() []*LegalHoldAudit {
+	if x != nil {
+		return x.Audits
+	}
+	return nil
+}
+
+var File_legalhold_legalhold_proto protoreflect.FileDescriptor
+
+var file_legalhold_legalhold_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x68, 0x6f, 0x6c, 0x64, 0x2f, 0x6c, 0x65, 0x67, 0x61,
+	0x6c, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x68, 0x6f, 0x6c, 0x64, 0x1a, 0x11, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xbe, 0x02, 0x0a, 0x09, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x45, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x22, 0xe6, 0x01, 0x0a, 0x0e, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x12, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65,
+	0x71, 0x12, 0x45, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6c,
+	0x65, 0x67, 0x61, 0x6c, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f,
+	0x6c, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x22, 0x88, 0x01, 0x0a, 0x12,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2d, 0x0a,
+	0x13, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c,
+	0x64, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x22, 0x16, 0x0a, 0x14,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0xb6, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x67, 0x61,
+	0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x45, 0x0a, 0x0a, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x68, 0x6f, 0x6c, 0x64,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x12, 0x3f, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5c, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x31, 0x0a, 0x05, 0x68, 0x6f, 0x6c, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x6c,
+	0x48, 0x6f, 0x6c, 0x64, 0x52, 0x05, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x3f, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x68, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x38, 0x0a,
+	0x06, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x68, 0x6f, 0x6c, 0x64,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x06, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x2a, 0x49, 0x0a, 0x13, 0x4c, 0x65, 0x67, 0x61, 0x6c,
+	0x48, 0x6f, 0x6c, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x55,
+	0x73, 0x65, 0x72, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x10, 0x02,
+	0x12, 0x10, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x10, 0x03, 0x32, 0xf8, 0x03, 0x0a, 0x10, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x68,
+	0x6f, 0x6c, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48,
+	0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x68,
+	0x6f, 0x6c, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48,
+	0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x25, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52,
+	0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6c, 0x65, 0x67, 0x61,
+	0x6c, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x67,
+	0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x22, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x68, 0x6f,
+	0x6c, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c,
+	0x48, 0x6f, 0x6c, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6c, 0x65, 0x67,
+	0x61, 0x6c, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48,
+	0x6f, 0x6c, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x3f, 0x5a,
+	0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x68, 0x6f, 0x6c, 0x64, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_legalhold_legalhold_proto_rawDescOnce sync.Once
+	file_legalhold_legalhold_proto_rawDescData = file_legalhold_legalhold_proto_rawDesc
+)
+
+func file_legalhold_legalhold_proto_rawDescGZIP() []byte {
+	file_legalhold_legalhold_proto_rawDescOnce.Do(func() {
+		file_legalhold_legalhold_proto_rawDescData = protoimpl.X.CompressGZIP(file_legalhold_legalhold_proto_rawDescData)
+	})
+	return file_legalhold_legalhold_proto_rawDescData
+}
+
+var file_legalhold_legalhold_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_legalhold_legalhold_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_legalhold_legalhold_proto_goTypes = []interface{}{
+	(LegalHoldTargetType)(0),        // 0: openim.legalhold.LegalHoldTargetType
+	(*LegalHold)(nil),               // 1: openim.legalhold.LegalHold
+	(*LegalHoldAudit)(nil),          // 2: openim.legalhold.LegalHoldAudit
+	(*CreateLegalHoldReq)(nil),      // 3: openim.legalhold.CreateLegalHoldReq
+	(*CreateLegalHoldResp)(nil),     // 4: openim.legalhold.CreateLegalHoldResp
+	(*UpdateLegalHoldReq)(nil),      // 5: openim.legalhold.UpdateLegalHoldReq
+	(*UpdateLegalHoldResp)(nil),     // 6: openim.legalhold.UpdateLegalHoldResp
+	(*ReleaseLegalHoldReq)(nil),     // 7: openim.legalhold.ReleaseLegalHoldReq
+	(*ReleaseLegalHoldResp)(nil),    // 8: openim.legalhold.ReleaseLegalHoldResp
+	(*GetLegalHoldsReq)(nil),        // 9: openim.legalhold.GetLegalHoldsReq
+	(*GetLegalHoldsResp)(nil),       // 10: openim.legalhold.GetLegalHoldsResp
+	(*GetLegalHoldAuditsReq)(nil),   // 11: openim.legalhold.GetLegalHoldAuditsReq
+	(*GetLegalHoldAuditsResp)(nil),  // 12: openim.legalhold.GetLegalHoldAuditsResp
+	(*sdkws.RequestPagination)(nil), // 13: openim.sdkws.RequestPagination
+}
+var file_legalhold_legalhold_proto_depIdxs = []int32{
+	0,  // 0: openim.legalhold.LegalHold.targetType:type_name -> openim.legalhold.LegalHoldTargetType
+	0,  // 1: openim.legalhold.CreateLegalHoldReq.targetType:type_name -> openim.legalhold.LegalHoldTargetType
+	0,  // 2: openim.legalhold.GetLegalHoldsReq.targetType:type_name -> openim.legalhold.LegalHoldTargetType
+	13, // 3: openim.legalhold.GetLegalHoldsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	1,  // 4: openim.legalhold.GetLegalHoldsResp.holds:type_name -> openim.legalhold.LegalHold
+	13, // 5: openim.legalhold.GetLegalHoldAuditsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	2,  // 6: openim.legalhold.GetLegalHoldAuditsResp.audits:type_name -> openim.legalhold.LegalHoldAudit
+	3,  // 7: openim.legalhold.LegalHoldService.CreateLegalHold:input_type -> openim.legalhold.CreateLegalHoldReq
+	5,  // 8: openim.legalhold.LegalHoldService.UpdateLegalHold:input_type -> openim.legalhold.UpdateLegalHoldReq
+	7,  // 9: openim.legalhold.LegalHoldService.ReleaseLegalHold:input_type -> openim.legalhold.ReleaseLegalHoldReq
+	9,  // 10: openim.legalhold.LegalHoldService.GetLegalHolds:input_type -> openim.legalhold.GetLegalHoldsReq
+	11, // 11: openim.legalhold.LegalHoldService.GetLegalHoldAudits:input_type -> openim.legalhold.GetLegalHoldAuditsReq
+	4,  // 12: openim.legalhold.LegalHoldService.CreateLegalHold:output_type -> openim.legalhold.CreateLegalHoldResp
+	6,  // 13: openim.legalhold.LegalHoldService.UpdateLegalHold:output_type -> openim.legalhold.UpdateLegalHoldResp
+	8,  // 14: openim.legalhold.LegalHoldService.ReleaseLegalHold:output_type -> openim.legalhold.ReleaseLegalHoldResp
+	10, // 15: openim.legalhold.LegalHoldService.GetLegalHolds:output_type -> openim.legalhold.GetLegalHoldsResp
+	12, // 16: openim.legalhold.LegalHoldService.GetLegalHoldAudits:output_type -> openim.legalhold.GetLegalHoldAuditsResp
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_legalhold_legalhold_proto_init() }
+func file_legalhold_legalhold_proto_init() {
+	if File_legalhold_legalhold_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_legalhold_legalhold_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LegalHold); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legalhold_legalhold_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LegalHoldAudit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legalhold_legalhold_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLegalHoldReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legalhold_legalhold_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLegalHoldResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legalhold_legalhold_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLegalHoldReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legalhold_legalhold_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLegalHoldResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legalhold_legalhold_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLegalHoldReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legalhold_legalhold_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLegalHoldResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legalhold_legalhold_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLegalHoldsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legalhold_legalhold_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLegalHoldsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legalhold_legalhold_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLegalHoldAuditsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_legalhold_legalhold_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLegalHoldAuditsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_legalhold_legalhold_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_legalhold_legalhold_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_legalhold_legalhold_proto_goTypes,
+		DependencyIndexes: file_legalhold_legalhold_proto_depIdxs,
+		EnumInfos:         file_legalhold_legalhold_proto_enumTypes,
+		MessageInfos:      file_legalhold_legalhold_proto_msgTypes,
+	}.Build()
+	File_legalhold_legalhold_proto = out.File
+	file_legalhold_legalhold_proto_rawDesc = nil
+	file_legalhold_legalhold_proto_goTypes = nil
+	file_legalhold_legalhold_proto_depIdxs = nil
+}
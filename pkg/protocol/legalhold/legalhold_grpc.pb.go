@@ -0,0 +1,271 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.0
+// source: legalhold/legalhold.proto
+
+package legalhold
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	LegalHoldService_CreateLegalHold_FullMethodName    = "/openim.legalhold.LegalHoldService/CreateLegalHold"
+	LegalHoldService_UpdateLegalHold_FullMethodName    = "/openim.legalhold.LegalHoldService/UpdateLegalHold"
+	LegalHoldService_ReleaseLegalHold_FullMethodName   = "/openim.legalhold.LegalHoldService/ReleaseLegalHold"
+	LegalHoldService_GetLegalHolds_FullMethodName      = "/openim.legalhold.LegalHoldService/GetLegalHolds"
+	LegalHoldService_GetLegalHoldAudits_FullMethodName = "/openim.legalhold.LegalHoldService/GetLegalHoldAudits"
+)
+
+// LegalHoldServiceClient is the client API for LegalHoldService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LegalHoldServiceClient interface {
+	CreateLegalHold(ctx context.Context, in *CreateLegalHoldReq, opts ...grpc.CallOption) (*CreateLegalHoldResp, error)
+	UpdateLegalHold(ctx context.Context, in *UpdateLegalHoldReq, opts ...grpc.CallOption) (*UpdateLegalHoldResp, error)
+	ReleaseLegalHold(ctx context.Context, in *ReleaseLegalHoldReq, opts ...grpc.CallOption) (*ReleaseLegalHoldResp, error)
+	GetLegalHolds(ctx context.Context, in *GetLegalHoldsReq, opts ...grpc.CallOption) (*GetLegalHoldsResp, error)
+	GetLegalHoldAudits(ctx context.Context, in *GetLegalHoldAuditsReq, opts ...grpc.CallOption) (*GetLegalHoldAuditsResp, error)
+}
+
+type legalHoldServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLegalHoldServiceClient(cc grpc.ClientConnInterface) LegalHoldServiceClient {
+	return &legalHoldServiceClient{cc}
+}
+
+func (c *legalHoldServiceClient) CreateLegalHold(ctx context.Context, in *CreateLegalHoldReq, opts ...grpc.CallOption) (*CreateLegalHoldResp, error) {
+	out := new(CreateLegalHoldResp)
+	err := c.cc.Invoke(ctx, LegalHoldService_CreateLegalHold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *legalHoldServiceClient) UpdateLegalHold(ctx context.Context, in *UpdateLegalHoldReq, opts ...grpc.CallOption) (*UpdateLegalHoldResp, error) {
+	out := new(UpdateLegalHoldResp)
+	err := c.cc.Invoke(ctx, LegalHoldService_UpdateLegalHold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *legalHoldServiceClient) ReleaseLegalHold(ctx context.Context, in *ReleaseLegalHoldReq, opts ...grpc.CallOption) (*ReleaseLegalHoldResp, error) {
+	out := new(ReleaseLegalHoldResp)
+	err := c.cc.Invoke(ctx, LegalHoldService_ReleaseLegalHold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *legalHoldServiceClient) GetLegalHolds(ctx context.Context, in *GetLegalHoldsReq, opts ...grpc.CallOption) (*GetLegalHoldsResp, error) {
+	out := new(GetLegalHoldsResp)
+	err := c.cc.Invoke(ctx, LegalHoldService_GetLegalHolds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *legalHoldServiceClient) GetLegalHoldAudits(ctx context.Context, in *GetLegalHoldAuditsReq, opts ...grpc.CallOption) (*GetLegalHoldAuditsResp, error) {
+	out := new(GetLegalHoldAuditsResp)
+	err := c.cc.Invoke(ctx, LegalHoldService_GetLegalHoldAudits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LegalHoldServiceServer is the server API for LegalHoldService service.
+// All implementations must embed UnimplementedLegalHoldServiceServer
+// for forward compatibility
+type LegalHoldServiceServer interface {
+	CreateLegalHold(context.Context, *CreateLegalHoldReq) (*CreateLegalHoldResp, error)
+	UpdateLegalHold(context.Context, *UpdateLegalHoldReq) (*UpdateLegalHoldResp, error)
+	ReleaseLegalHold(context.Context, *ReleaseLegalHoldReq) (*ReleaseLegalHoldResp, error)
+	GetLegalHolds(context.Context, *GetLegalHoldsReq) (*GetLegalHoldsResp, error)
+	GetLegalHoldAudits(context.Context, *GetLegalHoldAuditsReq) (*GetLegalHoldAuditsResp, error)
+	mustEmbedUnimplementedLegalHoldServiceServer()
+}
+
+// UnimplementedLegalHoldServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLegalHoldServiceServer struct {
+}
+
+func (UnimplementedLegalHoldServiceServer) CreateLegalHold(context.Context, *CreateLegalHoldReq) (*CreateLegalHoldResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLegalHold not implemented")
+}
+func (UnimplementedLegalHoldServiceServer) UpdateLegalHold(context.Context, *UpdateLegalHoldReq) (*UpdateLegalHoldResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLegalHold not implemented")
+}
+func (UnimplementedLegalHoldServiceServer) ReleaseLegalHold(context.Context, *ReleaseLegalHoldReq) (*ReleaseLegalHoldResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLegalHold not implemented")
+}
+func (UnimplementedLegalHoldServiceServer) GetLegalHolds(context.Context, *GetLegalHoldsReq) (*GetLegalHoldsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLegalHolds not implemented")
+}
+func (UnimplementedLegalHoldServiceServer) GetLegalHoldAudits(context.Context, *GetLegalHoldAuditsReq) (*GetLegalHoldAuditsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLegalHoldAudits not implemented")
+}
+func (UnimplementedLegalHoldServiceServer) mustEmbedUnimplementedLegalHoldServiceServer() {}
+
+// UnsafeLegalHoldServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LegalHoldServiceServer will
+// result in compilation errors.
+type UnsafeLegalHoldServiceServer interface {
+	mustEmbedUnimplementedLegalHoldServiceServer()
+}
+
+func RegisterLegalHoldServiceServer(s grpc.ServiceRegistrar, srv LegalHoldServiceServer) {
+	s.RegisterService(&LegalHoldService_ServiceDesc, srv)
+}
+
+func _LegalHoldService_CreateLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLegalHoldReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegalHoldServiceServer).CreateLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LegalHoldService_CreateLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegalHoldServiceServer).CreateLegalHold(ctx, req.(*CreateLegalHoldReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LegalHoldService_UpdateLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLegalHoldReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegalHoldServiceServer).UpdateLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LegalHoldService_UpdateLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegalHoldServiceServer).UpdateLegalHold(ctx, req.(*UpdateLegalHoldReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LegalHoldService_ReleaseLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLegalHoldReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegalHoldServiceServer).ReleaseLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LegalHoldService_ReleaseLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegalHoldServiceServer).ReleaseLegalHold(ctx, req.(*ReleaseLegalHoldReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LegalHoldService_GetLegalHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLegalHoldsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegalHoldServiceServer).GetLegalHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LegalHoldService_GetLegalHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegalHoldServiceServer).GetLegalHolds(ctx, req.(*GetLegalHoldsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LegalHoldService_GetLegalHoldAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLegalHoldAuditsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegalHoldServiceServer).GetLegalHoldAudits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LegalHoldService_GetLegalHoldAudits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegalHoldServiceServer).GetLegalHoldAudits(ctx, req.(*GetLegalHoldAuditsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LegalHoldService_ServiceDesc is the grpc.ServiceDesc for LegalHoldService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LegalHoldService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.legalhold.LegalHoldService",
+	HandlerType: (*LegalHoldServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLegalHold",
+			Handler:    _LegalHoldService_CreateLegalHold_Handler,
+		},
+		{
+			MethodName: "UpdateLegalHold",
+			Handler:    _LegalHoldService_UpdateLegalHold_Handler,
+		},
+		{
+			MethodName: "ReleaseLegalHold",
+			Handler:    _LegalHoldService_ReleaseLegalHold_Handler,
+		},
+		{
+			MethodName: "GetLegalHolds",
+			Handler:    _LegalHoldService_GetLegalHolds_Handler,
+		},
+		{
+			MethodName: "GetLegalHoldAudits",
+			Handler:    _LegalHoldService_GetLegalHoldAudits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "legalhold/legalhold.proto",
+}
@@ -0,0 +1,41 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgdestruct
+
+import "errors"
+
+func (x *DestructMsgsReq) Check() error {
+	if x.BurnLimit <= 0 {
+		return errors.New("burnLimit is invalid")
+	}
+	return nil
+}
+
+func (x *GetConversationsNeedDestructReq) Check() error {
+	return nil
+}
+
+func (x *SetLatestMsgDestructTimeReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.LatestMsgDestructTime <= 0 {
+		return errors.New("latestMsgDestructTime is invalid")
+	}
+	return nil
+}
@@ -0,0 +1,642 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.26.0
+// source: msgdestruct/msgdestruct.proto
+
+package msgdestruct
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DestructMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// maximum number of burn after reading ranges handled in one call
+	BurnLimit int32 `protobuf:"varint,1,opt,name=burnLimit,proto3" json:"burnLimit,omitempty"`
+}
+
+func (x *DestructMsgsReq) Reset() {
+	*x = DestructMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgdestruct_msgdestruct_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DestructMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestructMsgsReq) ProtoMessage() {}
+
+func (x *DestructMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgdestruct_msgdestruct_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestructMsgsReq.ProtoReflect.Descriptor instead.
+func (*DestructMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgdestruct_msgdestruct_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DestructMsgsReq) GetBurnLimit() int32 {
+	if x != nil {
+		return x.BurnLimit
+	}
+	return 0
+}
+
+// DestructMsgsResp reports what was destroyed, it is called periodically by openim-crontask.
+type DestructMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// burn after reading msgs physically deleted
+	BurnMsgNum int64 `protobuf:"varint,1,opt,name=burnMsgNum,proto3" json:"burnMsgNum,omitempty"`
+	// user conversations swept by the timed destruction
+	DestructConversationNum int64 `protobuf:"varint,2,opt,name=destructConversationNum,proto3" json:"destructConversationNum,omitempty"`
+	DestructMsgNum          int64 `protobuf:"varint,3,opt,name=destructMsgNum,proto3" json:"destructMsgNum,omitempty"`
+}
+
+func (x *DestructMsgsResp) Reset() {
+	*x = DestructMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgdestruct_msgdestruct_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DestructMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestructMsgsResp) ProtoMessage() {}
+
+func (x *DestructMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgdestruct_msgdestruct_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestructMsgsResp.ProtoReflect.Descriptor instead.
+func (*DestructMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgdestruct_msgdestruct_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DestructMsgsResp) GetBurnMsgNum() int64 {
+	if x != nil {
+		return x.BurnMsgNum
+	}
+	return 0
+}
+
+func (x *DestructMsgsResp) GetDestructConversationNum() int64 {
+	if x != nil {
+		return x.DestructConversationNum
+	}
+	return 0
+}
+
+func (x *DestructMsgsResp) GetDestructMsgNum() int64 {
+	if x != nil {
+		return x.DestructMsgNum
+	}
+	return 0
+}
+
+// DestructConversation is a user conversation with timed destruction enabled.
+type DestructConversation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerUserID    string `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID,omitempty"`
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	// seconds after which the msgs are destructed
+	MsgDestructTime int64 `protobuf:"varint,3,opt,name=msgDestructTime,proto3" json:"msgDestructTime,omitempty"`
+	// unix milliseconds of the last sweep
+	LatestMsgDestructTime int64 `protobuf:"varint,4,opt,name=latestMsgDestructTime,proto3" json:"latestMsgDestructTime,omitempty"`
+}
+
+func (x *DestructConversation) Reset() {
+	*x = DestructConversation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgdestruct_msgdestruct_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DestructConversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestructConversation) ProtoMessage() {}
+
+func (x *DestructConversation) ProtoReflect() protoreflect.Message {
+	mi := &file_msgdestruct_msgdestruct_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestructConversation.ProtoReflect.Descriptor instead.
+func (*DestructConversation) Descriptor() ([]byte, []int) {
+	return file_msgdestruct_msgdestruct_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DestructConversation) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *DestructConversation) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *DestructConversation) GetMsgDestructTime() int64 {
+	if x != nil {
+		return x.MsgDestructTime
+	}
+	return 0
+}
+
+func (x *DestructConversation) GetLatestMsgDestructTime() int64 {
+	if x != nil {
+		return x.LatestMsgDestructTime
+	}
+	return 0
+}
+
+type GetConversationsNeedDestructReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetConversationsNeedDestructReq) Reset() {
+	*x = GetConversationsNeedDestructReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgdestruct_msgdestruct_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationsNeedDestructReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsNeedDestructReq) ProtoMessage() {}
+
+func (x *GetConversationsNeedDestructReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgdestruct_msgdestruct_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsNeedDestructReq.ProtoReflect.Descriptor instead.
+func (*GetConversationsNeedDestructReq) Descriptor() ([]byte, []int) {
+	return file_msgdestruct_msgdestruct_proto_rawDescGZIP(), []int{3}
+}
+
+type GetConversationsNeedDestructResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Conversations []*DestructConversation `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+}
+
+func (x *GetConversationsNeedDestructResp) Reset() {
+	*x = GetConversationsNeedDestructResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgdestruct_msgdestruct_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationsNeedDestructResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsNeedDestructResp) ProtoMessage() {}
+
+func (x *GetConversationsNeedDestructResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgdestruct_msgdestruct_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsNeedDestructResp.ProtoReflect.Descriptor instead.
+func (*GetConversationsNeedDestructResp) Descriptor() ([]byte, []int) {
+	return file_msgdestruct_msgdestruct_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetConversationsNeedDestructResp) GetConversations() []*DestructConversation {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+type SetLatestMsgDestructTimeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerUserID    string `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID,omitempty"`
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	// unix milliseconds
+	LatestMsgDestructTime int64 `protobuf:"varint,3,opt,name=latestMsgDestructTime,proto3" json:"latestMsgDestructTime,omitempty"`
+}
+
+func (x *SetLatestMsgDestructTimeReq) Reset() {
+	*x = SetLatestMsgDestructTimeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgdestruct_msgdestruct_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLatestMsgDestructTimeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLatestMsgDestructTimeReq) ProtoMessage() {}
+
+func (x *SetLatestMsgDestructTimeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgdestruct_msgdestruct_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLatestMsgDestructTimeReq.ProtoReflect.Descriptor instead.
+func (*SetLatestMsgDestructTimeReq) Descriptor() ([]byte, []int) {
+	return file_msgdestruct_msgdestruct_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetLatestMsgDestructTimeReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *SetLatestMsgDestructTimeReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *SetLatestMsgDestructTimeReq) GetLatestMsgDestructTime() int64 {
+	if x != nil {
+		return x.LatestMsgDestructTime
+	}
+	return 0
+}
+
+type SetLatestMsgDestructTimeResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetLatestMsgDestructTimeResp) Reset() {
+	*x = SetLatestMsgDestructTimeResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgdestruct_msgdestruct_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLatestMsgDestructTimeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLatestMsgDestructTimeResp) ProtoMessage() {}
+
+func (x *SetLatestMsgDestructTimeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgdestruct_msgdestruct_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLatestMsgDestructTimeResp.ProtoReflect.Descriptor instead.
+func (*SetLatestMsgDestructTimeResp) Descriptor() ([]byte, []int) {
+	return file_msgdestruct_msgdestruct_proto_rawDescGZIP(), []int{6}
+}
+
+var File_msgdestruct_msgdestruct_proto protoreflect.FileDescriptor
+
+var file_msgdestruct_msgdestruct_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x6d, 0x73, 0x67, 0x64, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2f, 0x6d, 0x73,
+	0x67, 0x64, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x12, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x64, 0x65, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x22, 0x2f, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d,
+	0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x75, 0x72, 0x6e, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x75, 0x72, 0x6e, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x72,
+	0x6e, 0x4d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62,
+	0x75, 0x72, 0x6e, 0x4d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x12, 0x38, 0x0a, 0x17, 0x64, 0x65, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x64, 0x65, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x75, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d,
+	0x73, 0x67, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x65, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x22, 0xc0, 0x01, 0x0a, 0x14,
+	0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x28,
+	0x0a, 0x0f, 0x6d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4d,
+	0x73, 0x67, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x21,
+	0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x4e, 0x65, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x22, 0x72, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4e, 0x65, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x64, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x34, 0x0a, 0x15, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x32, 0x68, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x12, 0x59, 0x0a, 0x0c, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x4d, 0x73, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73,
+	0x67, 0x64, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x64, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x44,
+	0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x32,
+	0xa1, 0x02, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x89, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4e, 0x65, 0x65,
+	0x64, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x33, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x64, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4e,
+	0x65, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x34,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x64, 0x65, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x4e, 0x65, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x7d, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x64, 0x65, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4d,
+	0x73, 0x67, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x64, 0x65,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x4d, 0x73, 0x67, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e,
+	0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x6d, 0x73, 0x67, 0x64, 0x65,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_msgdestruct_msgdestruct_proto_rawDescOnce sync.Once
+	file_msgdestruct_msgdestruct_proto_rawDescData = file_msgdestruct_msgdestruct_proto_rawDesc
+)
+
+func file_msgdestruct_msgdestruct_proto_rawDescGZIP() []byte {
+	file_msgdestruct_msgdestruct_proto_rawDescOnce.Do(func() {
+		file_msgdestruct_msgdestruct_proto_rawDescData = protoimpl.X.CompressGZIP(file_msgdestruct_msgdestruct_proto_rawDescData)
+	})
+	return file_msgdestruct_msgdestruct_proto_rawDescData
+}
+
+var file_msgdestruct_msgdestruct_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_msgdestruct_msgdestruct_proto_goTypes = []interface{}{
+	(*DestructMsgsReq)(nil),                  // 0: openim.msgdestruct.DestructMsgsReq
+	(*DestructMsgsResp)(nil),                 // 1: openim.msgdestruct.DestructMsgsResp
+	(*DestructConversation)(nil),             // 2: openim.msgdestruct.DestructConversation
+	(*GetConversationsNeedDestructReq)(nil),  // 3: openim.msgdestruct.GetConversationsNeedDestructReq
+	(*GetConversationsNeedDestructResp)(nil), // 4: openim.msgdestruct.GetConversationsNeedDestructResp
+	(*SetLatestMsgDestructTimeReq)(nil),      // 5: openim.msgdestruct.SetLatestMsgDestructTimeReq
+	(*SetLatestMsgDestructTimeResp)(nil),     // 6: openim.msgdestruct.SetLatestMsgDestructTimeResp
+}
+var file_msgdestruct_msgdestruct_proto_depIdxs = []int32{
+	2, // 0: openim.msgdestruct.GetConversationsNeedDestructResp.conversations:type_name -> openim.msgdestruct.DestructConversation
+	0, // 1: openim.msgdestruct.MsgDestruct.DestructMsgs:input_type -> openim.msgdestruct.DestructMsgsReq
+	3, // 2: openim.msgdestruct.ConversationDestruct.GetConversationsNeedDestruct:input_type -> openim.msgdestruct.GetConversationsNeedDestructReq
+	5, // 3: openim.msgdestruct.ConversationDestruct.SetLatestMsgDestructTime:input_type -> openim.msgdestruct.SetLatestMsgDestructTimeReq
+	1, // 4: openim.msgdestruct.MsgDestruct.DestructMsgs:output_type -> openim.msgdestruct.DestructMsgsResp
+	4, // 5: openim.msgdestruct.ConversationDestruct.GetConversationsNeedDestruct:output_type -> openim.msgdestruct.GetConversationsNeedDestructResp
+	6, // 6: openim.msgdestruct.ConversationDestruct.SetLatestMsgDestructTime:output_type -> openim.msgdestruct.SetLatestMsgDestructTimeResp
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_msgdestruct_msgdestruct_proto_init() }
+func file_msgdestruct_msgdestruct_proto_init() {
+	if File_msgdestruct_msgdestruct_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_msgdestruct_msgdestruct_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DestructMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgdestruct_msgdestruct_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DestructMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgdestruct_msgdestruct_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DestructConversation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgdestruct_msgdestruct_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConversationsNeedDestructReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgdestruct_msgdestruct_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConversationsNeedDestructResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgdestruct_msgdestruct_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLatestMsgDestructTimeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgdestruct_msgdestruct_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLatestMsgDestructTimeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_msgdestruct_msgdestruct_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_msgdestruct_msgdestruct_proto_goTypes,
+		DependencyIndexes: file_msgdestruct_msgdestruct_proto_depIdxs,
+		MessageInfos:      file_msgdestruct_msgdestruct_proto_msgTypes,
+	}.Build()
+	File_msgdestruct_msgdestruct_proto = out.File
+	file_msgdestruct_msgdestruct_proto_rawDesc = nil
+	file_msgdestruct_msgdestruct_proto_goTypes = nil
+	file_msgdestruct_msgdestruct_proto_depIdxs = nil
+}
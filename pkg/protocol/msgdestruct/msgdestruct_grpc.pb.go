@@ -0,0 +1,250 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.0
+// source: msgdestruct/msgdestruct.proto
+
+package msgdestruct
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MsgDestruct_DestructMsgs_FullMethodName = "/openim.msgdestruct.MsgDestruct/DestructMsgs"
+)
+
+// MsgDestructClient is the client API for MsgDestruct service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgDestructClient interface {
+	DestructMsgs(ctx context.Context, in *DestructMsgsReq, opts ...grpc.CallOption) (*DestructMsgsResp, error)
+}
+
+type msgDestructClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgDestructClient(cc grpc.ClientConnInterface) MsgDestructClient {
+	return &msgDestructClient{cc}
+}
+
+func (c *msgDestructClient) DestructMsgs(ctx context.Context, in *DestructMsgsReq, opts ...grpc.CallOption) (*DestructMsgsResp, error) {
+	out := new(DestructMsgsResp)
+	err := c.cc.Invoke(ctx, MsgDestruct_DestructMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgDestructServer is the server API for MsgDestruct service.
+// All implementations must embed UnimplementedMsgDestructServer
+// for forward compatibility
+type MsgDestructServer interface {
+	DestructMsgs(context.Context, *DestructMsgsReq) (*DestructMsgsResp, error)
+	mustEmbedUnimplementedMsgDestructServer()
+}
+
+// UnimplementedMsgDestructServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgDestructServer struct {
+}
+
+func (UnimplementedMsgDestructServer) DestructMsgs(context.Context, *DestructMsgsReq) (*DestructMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DestructMsgs not implemented")
+}
+func (UnimplementedMsgDestructServer) mustEmbedUnimplementedMsgDestructServer() {}
+
+// UnsafeMsgDestructServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgDestructServer will
+// result in compilation errors.
+type UnsafeMsgDestructServer interface {
+	mustEmbedUnimplementedMsgDestructServer()
+}
+
+func RegisterMsgDestructServer(s grpc.ServiceRegistrar, srv MsgDestructServer) {
+	s.RegisterService(&MsgDestruct_ServiceDesc, srv)
+}
+
+func _MsgDestruct_DestructMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DestructMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgDestructServer).DestructMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgDestruct_DestructMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgDestructServer).DestructMsgs(ctx, req.(*DestructMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MsgDestruct_ServiceDesc is the grpc.ServiceDesc for MsgDestruct service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MsgDestruct_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.msgdestruct.MsgDestruct",
+	HandlerType: (*MsgDestructServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DestructMsgs",
+			Handler:    _MsgDestruct_DestructMsgs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msgdestruct/msgdestruct.proto",
+}
+
+const (
+	ConversationDestruct_GetConversationsNeedDestruct_FullMethodName = "/openim.msgdestruct.ConversationDestruct/GetConversationsNeedDestruct"
+	ConversationDestruct_SetLatestMsgDestructTime_FullMethodName     = "/openim.msgdestruct.ConversationDestruct/SetLatestMsgDestructTime"
+)
+
+// ConversationDestructClient is the client API for ConversationDestruct service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConversationDestructClient interface {
+	GetConversationsNeedDestruct(ctx context.Context, in *GetConversationsNeedDestructReq, opts ...grpc.CallOption) (*GetConversationsNeedDestructResp, error)
+	SetLatestMsgDestructTime(ctx context.Context, in *SetLatestMsgDestructTimeReq, opts ...grpc.CallOption) (*SetLatestMsgDestructTimeResp, error)
+}
+
+type conversationDestructClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConversationDestructClient(cc grpc.ClientConnInterface) ConversationDestructClient {
+	return &conversationDestructClient{cc}
+}
+
+func (c *conversationDestructClient) GetConversationsNeedDestruct(ctx context.Context, in *GetConversationsNeedDestructReq, opts ...grpc.CallOption) (*GetConversationsNeedDestructResp, error) {
+	out := new(GetConversationsNeedDestructResp)
+	err := c.cc.Invoke(ctx, ConversationDestruct_GetConversationsNeedDestruct_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationDestructClient) SetLatestMsgDestructTime(ctx context.Context, in *SetLatestMsgDestructTimeReq, opts ...grpc.CallOption) (*SetLatestMsgDestructTimeResp, error) {
+	out := new(SetLatestMsgDestructTimeResp)
+	err := c.cc.Invoke(ctx, ConversationDestruct_SetLatestMsgDestructTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConversationDestructServer is the server API for ConversationDestruct service.
+// All implementations must embed UnimplementedConversationDestructServer
+// for forward compatibility
+type ConversationDestructServer interface {
+	GetConversationsNeedDestruct(context.Context, *GetConversationsNeedDestructReq) (*GetConversationsNeedDestructResp, error)
+	SetLatestMsgDestructTime(context.Context, *SetLatestMsgDestructTimeReq) (*SetLatestMsgDestructTimeResp, error)
+	mustEmbedUnimplementedConversationDestructServer()
+}
+
+// UnimplementedConversationDestructServer must be embedded to have forward compatible implementations.
+type UnimplementedConversationDestructServer struct {
+}
+
+func (UnimplementedConversationDestructServer) GetConversationsNeedDestruct(context.Context, *GetConversationsNeedDestructReq) (*GetConversationsNeedDestructResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationsNeedDestruct not implemented")
+}
+func (UnimplementedConversationDestructServer) SetLatestMsgDestructTime(context.Context, *SetLatestMsgDestructTimeReq) (*SetLatestMsgDestructTimeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLatestMsgDestructTime not implemented")
+}
+func (UnimplementedConversationDestructServer) mustEmbedUnimplementedConversationDestructServer() {}
+
+// UnsafeConversationDestructServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConversationDestructServer will
+// result in compilation errors.
+type UnsafeConversationDestructServer interface {
+	mustEmbedUnimplementedConversationDestructServer()
+}
+
+func RegisterConversationDestructServer(s grpc.ServiceRegistrar, srv ConversationDestructServer) {
+	s.RegisterService(&ConversationDestruct_ServiceDesc, srv)
+}
+
+func _ConversationDestruct_GetConversationsNeedDestruct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationsNeedDestructReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationDestructServer).GetConversationsNeedDestruct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationDestruct_GetConversationsNeedDestruct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationDestructServer).GetConversationsNeedDestruct(ctx, req.(*GetConversationsNeedDestructReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationDestruct_SetLatestMsgDestructTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLatestMsgDestructTimeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationDestructServer).SetLatestMsgDestructTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationDestruct_SetLatestMsgDestructTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationDestructServer).SetLatestMsgDestructTime(ctx, req.(*SetLatestMsgDestructTimeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConversationDestruct_ServiceDesc is the grpc.ServiceDesc for ConversationDestruct service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConversationDestruct_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.msgdestruct.ConversationDestruct",
+	HandlerType: (*ConversationDestructServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetConversationsNeedDestruct",
+			Handler:    _ConversationDestruct_GetConversationsNeedDestruct_Handler,
+		},
+		{
+			MethodName: "SetLatestMsgDestructTime",
+			Handler:    _ConversationDestruct_SetLatestMsgDestructTime_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msgdestruct/msgdestruct.proto",
+}
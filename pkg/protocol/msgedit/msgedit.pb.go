@@ -0,0 +1,617 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.26.0
+// source: msgedit/msgedit.proto
+
+package msgedit
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EditMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	// the sender of the message
+	UserID string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	// replaces MsgData.content, the content type stays the same
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *EditMsgReq) Reset() {
+	*x = EditMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgedit_msgedit_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMsgReq) ProtoMessage() {}
+
+func (x *EditMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgedit_msgedit_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMsgReq.ProtoReflect.Descriptor instead.
+func (*EditMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgedit_msgedit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EditMsgReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *EditMsgReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *EditMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *EditMsgReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type EditMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EditTime int64 `protobuf:"varint,1,opt,name=editTime,proto3" json:"editTime,omitempty"`
+}
+
+func (x *EditMsgResp) Reset() {
+	*x = EditMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgedit_msgedit_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMsgResp) ProtoMessage() {}
+
+func (x *EditMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgedit_msgedit_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMsgResp.ProtoReflect.Descriptor instead.
+func (*EditMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgedit_msgedit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EditMsgResp) GetEditTime() int64 {
+	if x != nil {
+		return x.EditTime
+	}
+	return 0
+}
+
+// MsgEdit is a previous version of an edited message.
+type MsgEditRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content      string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	EditorUserID string `protobuf:"bytes,2,opt,name=editorUserID,proto3" json:"editorUserID,omitempty"`
+	// milliseconds, when this version was replaced
+	EditTime int64 `protobuf:"varint,3,opt,name=editTime,proto3" json:"editTime,omitempty"`
+}
+
+func (x *MsgEditRecord) Reset() {
+	*x = MsgEditRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgedit_msgedit_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgEditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgEditRecord) ProtoMessage() {}
+
+func (x *MsgEditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_msgedit_msgedit_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgEditRecord.ProtoReflect.Descriptor instead.
+func (*MsgEditRecord) Descriptor() ([]byte, []int) {
+	return file_msgedit_msgedit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgEditRecord) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MsgEditRecord) GetEditorUserID() string {
+	if x != nil {
+		return x.EditorUserID
+	}
+	return ""
+}
+
+func (x *MsgEditRecord) GetEditTime() int64 {
+	if x != nil {
+		return x.EditTime
+	}
+	return 0
+}
+
+type GetMsgEditHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (x *GetMsgEditHistoryReq) Reset() {
+	*x = GetMsgEditHistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgedit_msgedit_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgEditHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgEditHistoryReq) ProtoMessage() {}
+
+func (x *GetMsgEditHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgedit_msgedit_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgEditHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetMsgEditHistoryReq) Descriptor() ([]byte, []int) {
+	return file_msgedit_msgedit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMsgEditHistoryReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetMsgEditHistoryReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type GetMsgEditHistoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// oldest first
+	Edits []*MsgEditRecord `protobuf:"bytes,1,rep,name=edits,proto3" json:"edits,omitempty"`
+}
+
+func (x *GetMsgEditHistoryResp) Reset() {
+	*x = GetMsgEditHistoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgedit_msgedit_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgEditHistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgEditHistoryResp) ProtoMessage() {}
+
+func (x *GetMsgEditHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgedit_msgedit_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgEditHistoryResp.ProtoReflect.Descriptor instead.
+func (*GetMsgEditHistoryResp) Descriptor() ([]byte, []int) {
+	return file_msgedit_msgedit_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMsgEditHistoryResp) GetEdits() []*MsgEditRecord {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+// MsgEditedTips is the detail of the MsgEditNotification sent after a message is edited.
+type MsgEditedTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	ClientMsgID    string `protobuf:"bytes,3,opt,name=clientMsgID,proto3" json:"clientMsgID,omitempty"`
+	SessionType    int32  `protobuf:"varint,4,opt,name=sessionType,proto3" json:"sessionType,omitempty"`
+	EditorUserID   string `protobuf:"bytes,5,opt,name=editorUserID,proto3" json:"editorUserID,omitempty"`
+	Content        string `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	EditTime       int64  `protobuf:"varint,7,opt,name=editTime,proto3" json:"editTime,omitempty"`
+}
+
+func (x *MsgEditedTips) Reset() {
+	*x = MsgEditedTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgedit_msgedit_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgEditedTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgEditedTips) ProtoMessage() {}
+
+func (x *MsgEditedTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgedit_msgedit_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgEditedTips.ProtoReflect.Descriptor instead.
+func (*MsgEditedTips) Descriptor() ([]byte, []int) {
+	return file_msgedit_msgedit_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgEditedTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgEditedTips) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MsgEditedTips) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *MsgEditedTips) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *MsgEditedTips) GetEditorUserID() string {
+	if x != nil {
+		return x.EditorUserID
+	}
+	return ""
+}
+
+func (x *MsgEditedTips) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MsgEditedTips) GetEditTime() int64 {
+	if x != nil {
+		return x.EditTime
+	}
+	return 0
+}
+
+var File_msgedit_msgedit_proto protoreflect.FileDescriptor
+
+var file_msgedit_msgedit_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x6d, 0x73, 0x67, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x6d, 0x73, 0x67, 0x65, 0x64, 0x69,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x64, 0x69, 0x74, 0x22, 0x78, 0x0a, 0x0a, 0x45, 0x64, 0x69, 0x74, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x22, 0x29, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x69, 0x0a, 0x0d,
+	0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x6f,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x64, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x64, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65,
+	0x64, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x50, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x73,
+	0x67, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0x4c, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x64,
+	0x69, 0x74, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x05, 0x65, 0x64, 0x69, 0x74, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x45,
+	0x64, 0x69, 0x74, 0x65, 0x64, 0x54, 0x69, 0x70, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x73, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x6f,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x64, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x32, 0xaf, 0x01, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x12, 0x42, 0x0a,
+	0x07, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x60, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x45, 0x64,
+	0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e,
+	0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x6d, 0x73, 0x67, 0x65, 0x64,
+	0x69, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_msgedit_msgedit_proto_rawDescOnce sync.Once
+	file_msgedit_msgedit_proto_rawDescData = file_msgedit_msgedit_proto_rawDesc
+)
+
+func file_msgedit_msgedit_proto_rawDescGZIP() []byte {
+	file_msgedit_msgedit_proto_rawDescOnce.Do(func() {
+		file_msgedit_msgedit_proto_rawDescData = protoimpl.X.CompressGZIP(file_msgedit_msgedit_proto_rawDescData)
+	})
+	return file_msgedit_msgedit_proto_rawDescData
+}
+
+var file_msgedit_msgedit_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_msgedit_msgedit_proto_goTypes = []interface{}{
+	(*EditMsgReq)(nil),            // 0: openim.msgedit.EditMsgReq
+	(*EditMsgResp)(nil),           // 1: openim.msgedit.EditMsgResp
+	(*MsgEditRecord)(nil),         // 2: openim.msgedit.MsgEditRecord
+	(*GetMsgEditHistoryReq)(nil),  // 3: openim.msgedit.GetMsgEditHistoryReq
+	(*GetMsgEditHistoryResp)(nil), // 4: openim.msgedit.GetMsgEditHistoryResp
+	(*MsgEditedTips)(nil),         // 5: openim.msgedit.MsgEditedTips
+}
+var file_msgedit_msgedit_proto_depIdxs = []int32{
+	2, // 0: openim.msgedit.GetMsgEditHistoryResp.edits:type_name -> openim.msgedit.MsgEditRecord
+	0, // 1: openim.msgedit.MsgEdit.EditMsg:input_type -> openim.msgedit.EditMsgReq
+	3, // 2: openim.msgedit.MsgEdit.GetMsgEditHistory:input_type -> openim.msgedit.GetMsgEditHistoryReq
+	1, // 3: openim.msgedit.MsgEdit.EditMsg:output_type -> openim.msgedit.EditMsgResp
+	4, // 4: openim.msgedit.MsgEdit.GetMsgEditHistory:output_type -> openim.msgedit.GetMsgEditHistoryResp
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_msgedit_msgedit_proto_init() }
+func file_msgedit_msgedit_proto_init() {
+	if File_msgedit_msgedit_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_msgedit_msgedit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgedit_msgedit_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgedit_msgedit_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgEditRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgedit_msgedit_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgEditHistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgedit_msgedit_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgEditHistoryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgedit_msgedit_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgEditedTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_msgedit_msgedit_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_msgedit_msgedit_proto_goTypes,
+		DependencyIndexes: file_msgedit_msgedit_proto_depIdxs,
+		MessageInfos:      file_msgedit_msgedit_proto_msgTypes,
+	}.Build()
+	File_msgedit_msgedit_proto = out.File
+	file_msgedit_msgedit_proto_rawDesc = nil
+	file_msgedit_msgedit_proto_goTypes = nil
+	file_msgedit_msgedit_proto_depIdxs = nil
+}
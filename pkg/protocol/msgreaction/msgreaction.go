@@ -0,0 +1,78 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgreaction
+
+import "errors"
+
+const (
+	maxEmojiLen     = 64
+	maxPullSeqRange = 200
+)
+
+func checkReaction(conversationID string, seq int64, userID, emoji string) error {
+	if conversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if seq <= 0 {
+		return errors.New("seq is invalid")
+	}
+	if userID == "" {
+		return errors.New("userID is empty")
+	}
+	if emoji == "" || len(emoji) > maxEmojiLen {
+		return errors.New("emoji length must be between 1 and 64")
+	}
+	return nil
+}
+
+func (x *AddMsgReactionReq) Check() error {
+	return checkReaction(x.ConversationID, x.Seq, x.UserID, x.Emoji)
+}
+
+func (x *RemoveMsgReactionReq) Check() error {
+	return checkReaction(x.ConversationID, x.Seq, x.UserID, x.Emoji)
+}
+
+func (x *GetMsgReactionUsersReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq <= 0 {
+		return errors.New("seq is invalid")
+	}
+	if x.Emoji == "" {
+		return errors.New("emoji is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *PullMsgReactionsReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.BeginSeq <= 0 || x.EndSeq < x.BeginSeq {
+		return errors.New("seq range is invalid")
+	}
+	if x.EndSeq-x.BeginSeq >= maxPullSeqRange {
+		return errors.New("seq range must not exceed 200")
+	}
+	return nil
+}
@@ -0,0 +1,1026 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.26.0
+// source: msgreaction/msgreaction.proto
+
+package msgreaction
+
+import (
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddMsgReactionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Emoji          string `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji,omitempty"`
+}
+
+func (x *AddMsgReactionReq) Reset() {
+	*x = AddMsgReactionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgreaction_msgreaction_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMsgReactionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMsgReactionReq) ProtoMessage() {}
+
+func (x *AddMsgReactionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgreaction_msgreaction_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMsgReactionReq.ProtoReflect.Descriptor instead.
+func (*AddMsgReactionReq) Descriptor() ([]byte, []int) {
+	return file_msgreaction_msgreaction_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddMsgReactionReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *AddMsgReactionReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *AddMsgReactionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AddMsgReactionReq) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type AddMsgReactionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddMsgReactionResp) Reset() {
+	*x = AddMsgReactionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgreaction_msgreaction_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMsgReactionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMsgReactionResp) ProtoMessage() {}
+
+func (x *AddMsgReactionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgreaction_msgreaction_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMsgReactionResp.ProtoReflect.Descriptor instead.
+func (*AddMsgReactionResp) Descriptor() ([]byte, []int) {
+	return file_msgreaction_msgreaction_proto_rawDescGZIP(), []int{1}
+}
+
+type RemoveMsgReactionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Emoji          string `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji,omitempty"`
+}
+
+func (x *RemoveMsgReactionReq) Reset() {
+	*x = RemoveMsgReactionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgreaction_msgreaction_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMsgReactionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMsgReactionReq) ProtoMessage() {}
+
+func (x *RemoveMsgReactionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgreaction_msgreaction_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMsgReactionReq.ProtoReflect.Descriptor instead.
+func (*RemoveMsgReactionReq) Descriptor() ([]byte, []int) {
+	return file_msgreaction_msgreaction_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RemoveMsgReactionReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *RemoveMsgReactionReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *RemoveMsgReactionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RemoveMsgReactionReq) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type RemoveMsgReactionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveMsgReactionResp) Reset() {
+	*x = RemoveMsgReactionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgreaction_msgreaction_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMsgReactionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMsgReactionResp) ProtoMessage() {}
+
+func (x *RemoveMsgReactionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgreaction_msgreaction_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMsgReactionResp.ProtoReflect.Descriptor instead.
+func (*RemoveMsgReactionResp) Descriptor() ([]byte, []int) {
+	return file_msgreaction_msgreaction_proto_rawDescGZIP(), []int{3}
+}
+
+type GetMsgReactionUsersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string                   `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seq            int64                    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Emoji          string                   `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Pagination     *sdkws.RequestPagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetMsgReactionUsersReq) Reset() {
+	*x = GetMsgReactionUsersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgreaction_msgreaction_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgReactionUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgReactionUsersReq) ProtoMessage() {}
+
+func (x *GetMsgReactionUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgreaction_msgreaction_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgReactionUsersReq.ProtoReflect.Descriptor instead.
+func (*GetMsgReactionUsersReq) Descriptor() ([]byte, []int) {
+	return file_msgreaction_msgreaction_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMsgReactionUsersReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetMsgReactionUsersReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GetMsgReactionUsersReq) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *GetMsgReactionUsersReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetMsgReactionUsersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// in the order of reacting
+	UserIDs []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs,omitempty"`
+}
+
+func (x *GetMsgReactionUsersResp) Reset() {
+	*x = GetMsgReactionUsersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgreaction_msgreaction_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgReactionUsersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgReactionUsersResp) ProtoMessage() {}
+
+func (x *GetMsgReactionUsersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgreaction_msgreaction_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgReactionUsersResp.ProtoReflect.Descriptor instead.
+func (*GetMsgReactionUsersResp) Descriptor() ([]byte, []int) {
+	return file_msgreaction_msgreaction_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetMsgReactionUsersResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetMsgReactionUsersResp) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type MsgReactionCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Emoji string `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// whether the requesting user reacted with the emoji
+	Reacted bool `protobuf:"varint,3,opt,name=reacted,proto3" json:"reacted,omitempty"`
+}
+
+func (x *MsgReactionCount) Reset() {
+	*x = MsgReactionCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgreaction_msgreaction_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgReactionCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgReactionCount) ProtoMessage() {}
+
+func (x *MsgReactionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_msgreaction_msgreaction_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgReactionCount.ProtoReflect.Descriptor instead.
+func (*MsgReactionCount) Descriptor() ([]byte, []int) {
+	return file_msgreaction_msgreaction_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgReactionCount) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *MsgReactionCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MsgReactionCount) GetReacted() bool {
+	if x != nil {
+		return x.Reacted
+	}
+	return false
+}
+
+type MsgReactions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq       int64               `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Reactions []*MsgReactionCount `protobuf:"bytes,2,rep,name=reactions,proto3" json:"reactions,omitempty"`
+}
+
+func (x *MsgReactions) Reset() {
+	*x = MsgReactions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgreaction_msgreaction_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgReactions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgReactions) ProtoMessage() {}
+
+func (x *MsgReactions) ProtoReflect() protoreflect.Message {
+	mi := &file_msgreaction_msgreaction_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgReactions.ProtoReflect.Descriptor instead.
+func (*MsgReactions) Descriptor() ([]byte, []int) {
+	return file_msgreaction_msgreaction_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MsgReactions) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MsgReactions) GetReactions() []*MsgReactionCount {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+// PullMsgReactionsReq pulls the reactions of messages in [beginSeq, endSeq].
+type PullMsgReactionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	UserID         string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	BeginSeq       int64  `protobuf:"varint,3,opt,name=beginSeq,proto3" json:"beginSeq,omitempty"`
+	EndSeq         int64  `protobuf:"varint,4,opt,name=endSeq,proto3" json:"endSeq,omitempty"`
+}
+
+func (x *PullMsgReactionsReq) Reset() {
+	*x = PullMsgReactionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgreaction_msgreaction_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullMsgReactionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullMsgReactionsReq) ProtoMessage() {}
+
+func (x *PullMsgReactionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgreaction_msgreaction_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullMsgReactionsReq.ProtoReflect.Descriptor instead.
+func (*PullMsgReactionsReq) Descriptor() ([]byte, []int) {
+	return file_msgreaction_msgreaction_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PullMsgReactionsReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *PullMsgReactionsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *PullMsgReactionsReq) GetBeginSeq() int64 {
+	if x != nil {
+		return x.BeginSeq
+	}
+	return 0
+}
+
+func (x *PullMsgReactionsReq) GetEndSeq() int64 {
+	if x != nil {
+		return x.EndSeq
+	}
+	return 0
+}
+
+type PullMsgReactionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// messages without reactions are omitted
+	Msgs []*MsgReactions `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (x *PullMsgReactionsResp) Reset() {
+	*x = PullMsgReactionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgreaction_msgreaction_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullMsgReactionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullMsgReactionsResp) ProtoMessage() {}
+
+func (x *PullMsgReactionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgreaction_msgreaction_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullMsgReactionsResp.ProtoReflect.Descriptor instead.
+func (*PullMsgReactionsResp) Descriptor() ([]byte, []int) {
+	return file_msgreaction_msgreaction_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PullMsgReactionsResp) GetMsgs() []*MsgReactions {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+// MsgReactionTips is the detail of the MsgReactionNotification sent after a reaction is added or removed.
+type MsgReactionTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Emoji          string `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	IsRemove       bool   `protobuf:"varint,5,opt,name=isRemove,proto3" json:"isRemove,omitempty"`
+	// users reacting with the emoji after the change
+	Count int64 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *MsgReactionTips) Reset() {
+	*x = MsgReactionTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgreaction_msgreaction_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgReactionTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgReactionTips) ProtoMessage() {}
+
+func (x *MsgReactionTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgreaction_msgreaction_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgReactionTips.ProtoReflect.Descriptor instead.
+func (*MsgReactionTips) Descriptor() ([]byte, []int) {
+	return file_msgreaction_msgreaction_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgReactionTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgReactionTips) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MsgReactionTips) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MsgReactionTips) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *MsgReactionTips) GetIsRemove() bool {
+	if x != nil {
+		return x.IsRemove
+	}
+	return false
+}
+
+func (x *MsgReactionTips) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_msgreaction_msgreaction_proto protoreflect.FileDescriptor
+
+var file_msgreaction_msgreaction_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x6d, 0x73, 0x67, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6d, 0x73,
+	0x67, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x12, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x11, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7b, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x6f, 0x6a, 0x69, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7e, 0x0a, 0x14, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0xa9, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x3f, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x49,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x58, 0x0a, 0x10, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x6f, 0x6a, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x61, 0x63,
+	0x74, 0x65, 0x64, 0x22, 0x64, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x42, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09,
+	0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x50, 0x75,
+	0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65,
+	0x6e, 0x64, 0x53, 0x65, 0x71, 0x22, 0x4c, 0x0a, 0x14, 0x50, 0x75, 0x6c, 0x6c, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a,
+	0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x6d,
+	0x73, 0x67, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x70, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f,
+	0x6a, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x32, 0xaf, 0x03, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x5f, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
+	0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x41, 0x64, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x68, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x6d, 0x73, 0x67, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6e, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
+	0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x72, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x65, 0x0a, 0x10,
+	0x50, 0x75, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50,
+	0x75, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e,
+	0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x6d, 0x73, 0x67, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_msgreaction_msgreaction_proto_rawDescOnce sync.Once
+	file_msgreaction_msgreaction_proto_rawDescData = file_msgreaction_msgreaction_proto_rawDesc
+)
+
+func file_msgreaction_msgreaction_proto_rawDescGZIP() []byte {
+	file_msgreaction_msgreaction_proto_rawDescOnce.Do(func() {
+		file_msgreaction_msgreaction_proto_rawDescData = protoimpl.X.CompressGZIP(file_msgreaction_msgreaction_proto_rawDescData)
+	})
+	return file_msgreaction_msgreaction_proto_rawDescData
+}
+
+var file_msgreaction_msgreaction_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_msgreaction_msgreaction_proto_goTypes = []interface{}{
+	(*AddMsgReactionReq)(nil),       // 0: openim.msgreaction.AddMsgReactionReq
+	(*AddMsgReactionResp)(nil),      // 1: openim.msgreaction.AddMsgReactionResp
+	(*RemoveMsgReactionReq)(nil),    // 2: openim.msgreaction.RemoveMsgReactionReq
+	(*RemoveMsgReactionResp)(nil),   // 3: openim.msgreaction.RemoveMsgReactionResp
+	(*GetMsgReactionUsersReq)(nil),  // 4: openim.msgreaction.GetMsgReactionUsersReq
+	(*GetMsgReactionUsersResp)(nil), // 5: openim.msgreaction.GetMsgReactionUsersResp
+	(*MsgReactionCount)(nil),        // 6: openim.msgreaction.MsgReactionCount
+	(*MsgReactions)(nil),            // 7: openim.msgreaction.MsgReactions
+	(*PullMsgReactionsReq)(nil),     // 8: openim.msgreaction.PullMsgReactionsReq
+	(*PullMsgReactionsResp)(nil),    // 9: openim.msgreaction.PullMsgReactionsResp
+	(*MsgReactionTips)(nil),         // 10: openim.msgreaction.MsgReactionTips
+	(*sdkws.RequestPagination)(nil), // 11: openim.sdkws.RequestPagination
+}
+var file_msgreaction_msgreaction_proto_depIdxs = []int32{
+	11, // 0: openim.msgreaction.GetMsgReactionUsersReq.pagination:type_name -> openim.sdkws.RequestPagination
+	6,  // 1: openim.msgreaction.MsgReactions.reactions:type_name -> openim.msgreaction.MsgReactionCount
+	7,  // 2: openim.msgreaction.PullMsgReactionsResp.msgs:type_name -> openim.msgreaction.MsgReactions
+	0,  // 3: openim.msgreaction.MsgReaction.AddMsgReaction:input_type -> openim.msgreaction.AddMsgReactionReq
+	2,  // 4: openim.msgreaction.MsgReaction.RemoveMsgReaction:input_type -> openim.msgreaction.RemoveMsgReactionReq
+	4,  // 5: openim.msgreaction.MsgReaction.GetMsgReactionUsers:input_type -> openim.msgreaction.GetMsgReactionUsersReq
+	8,  // 6: openim.msgreaction.MsgReaction.PullMsgReactions:input_type -> openim.msgreaction.PullMsgReactionsReq
+	1,  // 7: openim.msgreaction.MsgReaction.AddMsgReaction:output_type -> openim.msgreaction.AddMsgReactionResp
+	3,  // 8: openim.msgreaction.MsgReaction.RemoveMsgReaction:output_type -> openim.msgreaction.RemoveMsgReactionResp
+	5,  // 9: openim.msgreaction.MsgReaction.GetMsgReactionUsers:output_type -> openim.msgreaction.GetMsgReactionUsersResp
+	9,  // 10: openim.msgreaction.MsgReaction.PullMsgReactions:output_type -> openim.msgreaction.PullMsgReactionsResp
+	7,  // [7:11] is the sub-list for method output_type
+	3,  // [3:7] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_msgreaction_msgreaction_proto_init() }
+func file_msgreaction_msgreaction_proto_init() {
+	if File_msgreaction_msgreaction_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_msgreaction_msgreaction_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMsgReactionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgreaction_msgreaction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMsgReactionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgreaction_msgreaction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMsgReactionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgreaction_msgreaction_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMsgReactionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgreaction_msgreaction_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgReactionUsersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgreaction_msgreaction_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgReactionUsersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgreaction_msgreaction_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReactionCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgreaction_msgreaction_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReactions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgreaction_msgreaction_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullMsgReactionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgreaction_msgreaction_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullMsgReactionsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgreaction_msgreaction_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReactionTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_msgreaction_msgreaction_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_msgreaction_msgreaction_proto_goTypes,
+		DependencyIndexes: file_msgreaction_msgreaction_proto_depIdxs,
+		MessageInfos:      file_msgreaction_msgreaction_proto_msgTypes,
+	}.Build()
+	File_msgreaction_msgreaction_proto = out.File
+	file_msgreaction_msgreaction_proto_rawDesc = nil
+	file_msgreaction_msgreaction_proto_goTypes = nil
+	file_msgreaction_msgreaction_proto_depIdxs = nil
+}
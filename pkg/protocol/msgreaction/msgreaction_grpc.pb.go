@@ -0,0 +1,234 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.0
+// source: msgreaction/msgreaction.proto
+
+package msgreaction
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MsgReaction_AddMsgReaction_FullMethodName      = "/openim.msgreaction.MsgReaction/AddMsgReaction"
+	MsgReaction_RemoveMsgReaction_FullMethodName   = "/openim.msgreaction.MsgReaction/RemoveMsgReaction"
+	MsgReaction_GetMsgReactionUsers_FullMethodName = "/openim.msgreaction.MsgReaction/GetMsgReactionUsers"
+	MsgReaction_PullMsgReactions_FullMethodName    = "/openim.msgreaction.MsgReaction/PullMsgReactions"
+)
+
+// MsgReactionClient is the client API for MsgReaction service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgReactionClient interface {
+	AddMsgReaction(ctx context.Context, in *AddMsgReactionReq, opts ...grpc.CallOption) (*AddMsgReactionResp, error)
+	RemoveMsgReaction(ctx context.Context, in *RemoveMsgReactionReq, opts ...grpc.CallOption) (*RemoveMsgReactionResp, error)
+	GetMsgReactionUsers(ctx context.Context, in *GetMsgReactionUsersReq, opts ...grpc.CallOption) (*GetMsgReactionUsersResp, error)
+	PullMsgReactions(ctx context.Context, in *PullMsgReactionsReq, opts ...grpc.CallOption) (*PullMsgReactionsResp, error)
+}
+
+type msgReactionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgReactionClient(cc grpc.ClientConnInterface) MsgReactionClient {
+	return &msgReactionClient{cc}
+}
+
+func (c *msgReactionClient) AddMsgReaction(ctx context.Context, in *AddMsgReactionReq, opts ...grpc.CallOption) (*AddMsgReactionResp, error) {
+	out := new(AddMsgReactionResp)
+	err := c.cc.Invoke(ctx, MsgReaction_AddMsgReaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgReactionClient) RemoveMsgReaction(ctx context.Context, in *RemoveMsgReactionReq, opts ...grpc.CallOption) (*RemoveMsgReactionResp, error) {
+	out := new(RemoveMsgReactionResp)
+	err := c.cc.Invoke(ctx, MsgReaction_RemoveMsgReaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgReactionClient) GetMsgReactionUsers(ctx context.Context, in *GetMsgReactionUsersReq, opts ...grpc.CallOption) (*GetMsgReactionUsersResp, error) {
+	out := new(GetMsgReactionUsersResp)
+	err := c.cc.Invoke(ctx, MsgReaction_GetMsgReactionUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgReactionClient) PullMsgReactions(ctx context.Context, in *PullMsgReactionsReq, opts ...grpc.CallOption) (*PullMsgReactionsResp, error) {
+	out := new(PullMsgReactionsResp)
+	err := c.cc.Invoke(ctx, MsgReaction_PullMsgReactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgReactionServer is the server API for MsgReaction service.
+// All implementations must embed UnimplementedMsgReactionServer
+// for forward compatibility
+type MsgReactionServer interface {
+	AddMsgReaction(context.Context, *AddMsgReactionReq) (*AddMsgReactionResp, error)
+	RemoveMsgReaction(context.Context, *RemoveMsgReactionReq) (*RemoveMsgReactionResp, error)
+	GetMsgReactionUsers(context.Context, *GetMsgReactionUsersReq) (*GetMsgReactionUsersResp, error)
+	PullMsgReactions(context.Context, *PullMsgReactionsReq) (*PullMsgReactionsResp, error)
+	mustEmbedUnimplementedMsgReactionServer()
+}
+
+// UnimplementedMsgReactionServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgReactionServer struct {
+}
+
+func (UnimplementedMsgReactionServer) AddMsgReaction(context.Context, *AddMsgReactionReq) (*AddMsgReactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMsgReaction not implemented")
+}
+func (UnimplementedMsgReactionServer) RemoveMsgReaction(context.Context, *RemoveMsgReactionReq) (*RemoveMsgReactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMsgReaction not implemented")
+}
+func (UnimplementedMsgReactionServer) GetMsgReactionUsers(context.Context, *GetMsgReactionUsersReq) (*GetMsgReactionUsersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMsgReactionUsers not implemented")
+}
+func (UnimplementedMsgReactionServer) PullMsgReactions(context.Context, *PullMsgReactionsReq) (*PullMsgReactionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullMsgReactions not implemented")
+}
+func (UnimplementedMsgReactionServer) mustEmbedUnimplementedMsgReactionServer() {}
+
+// UnsafeMsgReactionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgReactionServer will
+// result in compilation errors.
+type UnsafeMsgReactionServer interface {
+	mustEmbedUnimplementedMsgReactionServer()
+}
+
+func RegisterMsgReactionServer(s grpc.ServiceRegistrar, srv MsgReactionServer) {
+	s.RegisterService(&MsgReaction_ServiceDesc, srv)
+}
+
+func _MsgReaction_AddMsgReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMsgReactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgReactionServer).AddMsgReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgReaction_AddMsgReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgReactionServer).AddMsgReaction(ctx, req.(*AddMsgReactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgReaction_RemoveMsgReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMsgReactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgReactionServer).RemoveMsgReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgReaction_RemoveMsgReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgReactionServer).RemoveMsgReaction(ctx, req.(*RemoveMsgReactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgReaction_GetMsgReactionUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMsgReactionUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgReactionServer).GetMsgReactionUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgReaction_GetMsgReactionUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgReactionServer).GetMsgReactionUsers(ctx, req.(*GetMsgReactionUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgReaction_PullMsgReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullMsgReactionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgReactionServer).PullMsgReactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgReaction_PullMsgReactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgReactionServer).PullMsgReactions(ctx, req.(*PullMsgReactionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MsgReaction_ServiceDesc is the grpc.ServiceDesc for MsgReaction service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MsgReaction_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.msgreaction.MsgReaction",
+	HandlerType: (*MsgReactionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddMsgReaction",
+			Handler:    _MsgReaction_AddMsgReaction_Handler,
+		},
+		{
+			MethodName: "RemoveMsgReaction",
+			Handler:    _MsgReaction_RemoveMsgReaction_Handler,
+		},
+		{
+			MethodName: "GetMsgReactionUsers",
+			Handler:    _MsgReaction_GetMsgReactionUsers_Handler,
+		},
+		{
+			MethodName: "PullMsgReactions",
+			Handler:    _MsgReaction_PullMsgReactions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msgreaction/msgreaction.proto",
+}
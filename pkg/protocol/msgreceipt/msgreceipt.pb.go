@@ -0,0 +1,409 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.26.0
+// source: msgreceipt/msgreceipt.proto
+
+package msgreceipt
+
+import (
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetGroupMsgReadMembersReq asks which members of the group have read a message, only the sender can ask.
+type GetGroupMsgReadMembersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	// applied to both readUserIDs and unreadUserIDs
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetGroupMsgReadMembersReq) Reset() {
+	*x = GetGroupMsgReadMembersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgreceipt_msgreceipt_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupMsgReadMembersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMsgReadMembersReq) ProtoMessage() {}
+
+func (x *GetGroupMsgReadMembersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgreceipt_msgreceipt_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMsgReadMembersReq.ProtoReflect.Descriptor instead.
+func (*GetGroupMsgReadMembersReq) Descriptor() ([]byte, []int) {
+	return file_msgreceipt_msgreceipt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetGroupMsgReadMembersReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetGroupMsgReadMembersReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GetGroupMsgReadMembersReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetGroupMsgReadMembersReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetGroupMsgReadMembersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReadCount     int64    `protobuf:"varint,1,opt,name=readCount,proto3" json:"readCount,omitempty"`
+	UnreadCount   int64    `protobuf:"varint,2,opt,name=unreadCount,proto3" json:"unreadCount,omitempty"`
+	ReadUserIDs   []string `protobuf:"bytes,3,rep,name=readUserIDs,proto3" json:"readUserIDs,omitempty"`
+	UnreadUserIDs []string `protobuf:"bytes,4,rep,name=unreadUserIDs,proto3" json:"unreadUserIDs,omitempty"`
+}
+
+func (x *GetGroupMsgReadMembersResp) Reset() {
+	*x = GetGroupMsgReadMembersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgreceipt_msgreceipt_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupMsgReadMembersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMsgReadMembersResp) ProtoMessage() {}
+
+func (x *GetGroupMsgReadMembersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgreceipt_msgreceipt_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMsgReadMembersResp.ProtoReflect.Descriptor instead.
+func (*GetGroupMsgReadMembersResp) Descriptor() ([]byte, []int) {
+	return file_msgreceipt_msgreceipt_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetGroupMsgReadMembersResp) GetReadCount() int64 {
+	if x != nil {
+		return x.ReadCount
+	}
+	return 0
+}
+
+func (x *GetGroupMsgReadMembersResp) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *GetGroupMsgReadMembersResp) GetReadUserIDs() []string {
+	if x != nil {
+		return x.ReadUserIDs
+	}
+	return nil
+}
+
+func (x *GetGroupMsgReadMembersResp) GetUnreadUserIDs() []string {
+	if x != nil {
+		return x.UnreadUserIDs
+	}
+	return nil
+}
+
+// GroupMsgReadTips is the detail of the GroupMsgReadNotification sent to the senders of the messages a member has read.
+type GroupMsgReadTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	GroupID        string `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	ReaderUserID   string `protobuf:"bytes,3,opt,name=readerUserID,proto3" json:"readerUserID,omitempty"`
+	// seqs of the receiver's messages newly read by the reader
+	Seqs       []int64 `protobuf:"varint,4,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
+	HasReadSeq int64   `protobuf:"varint,5,opt,name=hasReadSeq,proto3" json:"hasReadSeq,omitempty"`
+}
+
+func (x *GroupMsgReadTips) Reset() {
+	*x = GroupMsgReadTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgreceipt_msgreceipt_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMsgReadTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMsgReadTips) ProtoMessage() {}
+
+func (x *GroupMsgReadTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgreceipt_msgreceipt_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMsgReadTips.ProtoReflect.Descriptor instead.
+func (*GroupMsgReadTips) Descriptor() ([]byte, []int) {
+	return file_msgreceipt_msgreceipt_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GroupMsgReadTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GroupMsgReadTips) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GroupMsgReadTips) GetReaderUserID() string {
+	if x != nil {
+		return x.ReaderUserID
+	}
+	return ""
+}
+
+func (x *GroupMsgReadTips) GetSeqs() []int64 {
+	if x != nil {
+		return x.Seqs
+	}
+	return nil
+}
+
+func (x *GroupMsgReadTips) GetHasReadSeq() int64 {
+	if x != nil {
+		return x.HasReadSeq
+	}
+	return 0
+}
+
+var File_msgreceipt_msgreceipt_proto protoreflect.FileDescriptor
+
+var file_msgreceipt_msgreceipt_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x6d, 0x73, 0x67, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x2f, 0x6d, 0x73, 0x67,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x1a, 0x11, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xae, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x6e,
+	0x72, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x10,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x64, 0x54, 0x69, 0x70, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x71, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x71, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x32, 0x83, 0x01, 0x0a, 0x0a, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x75, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d,
+	0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x6d, 0x73, 0x67, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_msgreceipt_msgreceipt_proto_rawDescOnce sync.Once
+	file_msgreceipt_msgreceipt_proto_rawDescData = file_msgreceipt_msgreceipt_proto_rawDesc
+)
+
+func file_msgreceipt_msgreceipt_proto_rawDescGZIP() []byte {
+	file_msgreceipt_msgreceipt_proto_rawDescOnce.Do(func() {
+		file_msgreceipt_msgreceipt_proto_rawDescData = protoimpl.X.CompressGZIP(file_msgreceipt_msgreceipt_proto_rawDescData)
+	})
+	return file_msgreceipt_msgreceipt_proto_rawDescData
+}
+
+var file_msgreceipt_msgreceipt_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_msgreceipt_msgreceipt_proto_goTypes = []interface{}{
+	(*GetGroupMsgReadMembersReq)(nil),  // 0: openim.msgreceipt.GetGroupMsgReadMembersReq
+	(*GetGroupMsgReadMembersResp)(nil), // 1: openim.msgreceipt.GetGroupMsgReadMembersResp
+	(*GroupMsgReadTips)(nil),           // 2: openim.msgreceipt.GroupMsgReadTips
+	(*sdkws.RequestPagination)(nil),    // 3: openim.sdkws.RequestPagination
+}
+var file_msgreceipt_msgreceipt_proto_depIdxs = []int32{
+	3, // 0: openim.msgreceipt.GetGroupMsgReadMembersReq.pagination:type_name -> openim.sdkws.RequestPagination
+	0, // 1: openim.msgreceipt.MsgReceipt.GetGroupMsgReadMembers:input_type -> openim.msgreceipt.GetGroupMsgReadMembersReq
+	1, // 2: openim.msgreceipt.MsgReceipt.GetGroupMsgReadMembers:output_type -> openim.msgreceipt.GetGroupMsgReadMembersResp
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_msgreceipt_msgreceipt_proto_init() }
+func file_msgreceipt_msgreceipt_proto_init() {
+	if File_msgreceipt_msgreceipt_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_msgreceipt_msgreceipt_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupMsgReadMembersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgreceipt_msgreceipt_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupMsgReadMembersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgreceipt_msgreceipt_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMsgReadTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_msgreceipt_msgreceipt_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_msgreceipt_msgreceipt_proto_goTypes,
+		DependencyIndexes: file_msgreceipt_msgreceipt_proto_depIdxs,
+		MessageInfos:      file_msgreceipt_msgreceipt_proto_msgTypes,
+	}.Build()
+	File_msgreceipt_msgreceipt_proto = out.File
+	file_msgreceipt_msgreceipt_proto_rawDesc = nil
+	file_msgreceipt_msgreceipt_proto_goTypes = nil
+	file_msgreceipt_msgreceipt_proto_depIdxs = nil
+}
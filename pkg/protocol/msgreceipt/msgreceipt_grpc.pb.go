@@ -0,0 +1,123 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.0
+// source: msgreceipt/msgreceipt.proto
+
+package msgreceipt
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MsgReceipt_GetGroupMsgReadMembers_FullMethodName = "/openim.msgreceipt.MsgReceipt/GetGroupMsgReadMembers"
+)
+
+// MsgReceiptClient is the client API for MsgReceipt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgReceiptClient interface {
+	GetGroupMsgReadMembers(ctx context.Context, in *GetGroupMsgReadMembersReq, opts ...grpc.CallOption) (*GetGroupMsgReadMembersResp, error)
+}
+
+type msgReceiptClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgReceiptClient(cc grpc.ClientConnInterface) MsgReceiptClient {
+	return &msgReceiptClient{cc}
+}
+
+func (c *msgReceiptClient) GetGroupMsgReadMembers(ctx context.Context, in *GetGroupMsgReadMembersReq, opts ...grpc.CallOption) (*GetGroupMsgReadMembersResp, error) {
+	out := new(GetGroupMsgReadMembersResp)
+	err := c.cc.Invoke(ctx, MsgReceipt_GetGroupMsgReadMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgReceiptServer is the server API for MsgReceipt service.
+// All implementations must embed UnimplementedMsgReceiptServer
+// for forward compatibility
+type MsgReceiptServer interface {
+	GetGroupMsgReadMembers(context.Context, *GetGroupMsgReadMembersReq) (*GetGroupMsgReadMembersResp, error)
+	mustEmbedUnimplementedMsgReceiptServer()
+}
+
+// UnimplementedMsgReceiptServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgReceiptServer struct {
+}
+
+func (UnimplementedMsgReceiptServer) GetGroupMsgReadMembers(context.Context, *GetGroupMsgReadMembersReq) (*GetGroupMsgReadMembersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMsgReadMembers not implemented")
+}
+func (UnimplementedMsgReceiptServer) mustEmbedUnimplementedMsgReceiptServer() {}
+
+// UnsafeMsgReceiptServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgReceiptServer will
+// result in compilation errors.
+type UnsafeMsgReceiptServer interface {
+	mustEmbedUnimplementedMsgReceiptServer()
+}
+
+func RegisterMsgReceiptServer(s grpc.ServiceRegistrar, srv MsgReceiptServer) {
+	s.RegisterService(&MsgReceipt_ServiceDesc, srv)
+}
+
+func _MsgReceipt_GetGroupMsgReadMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupMsgReadMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgReceiptServer).GetGroupMsgReadMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgReceipt_GetGroupMsgReadMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgReceiptServer).GetGroupMsgReadMembers(ctx, req.(*GetGroupMsgReadMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MsgReceipt_ServiceDesc is the grpc.ServiceDesc for MsgReceipt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MsgReceipt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.msgreceipt.MsgReceipt",
+	HandlerType: (*MsgReceiptServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGroupMsgReadMembers",
+			Handler:    _MsgReceipt_GetGroupMsgReadMembers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msgreceipt/msgreceipt.proto",
+}
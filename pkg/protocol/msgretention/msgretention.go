@@ -0,0 +1,64 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgretention
+
+import "errors"
+
+func checkConversation(conversationID, groupID string) error {
+	if conversationID == "" && groupID == "" {
+		return errors.New("conversationID and groupID are empty")
+	}
+	if conversationID != "" && groupID != "" {
+		return errors.New("only one of conversationID and groupID can be set")
+	}
+	return nil
+}
+
+func (x *SetRetentionPolicyReq) Check() error {
+	if err := checkConversation(x.ConversationID, x.GroupID); err != nil {
+		return err
+	}
+	if len(x.Rules) == 0 {
+		return errors.New("rules is empty")
+	}
+	contentTypes := make(map[int32]struct{}, len(x.Rules))
+	for _, rule := range x.Rules {
+		if rule == nil {
+			return errors.New("rule is empty")
+		}
+		if rule.ContentType < 0 {
+			return errors.New("contentType is invalid")
+		}
+		if rule.RetainDays <= 0 {
+			return errors.New("retainDays is invalid")
+		}
+		if _, ok := contentTypes[rule.ContentType]; ok {
+			return errors.New("contentType is repeated")
+		}
+		contentTypes[rule.ContentType] = struct{}{}
+	}
+	return nil
+}
+
+func (x *DeleteRetentionPolicyReq) Check() error {
+	return checkConversation(x.ConversationID, x.GroupID)
+}
+
+func (x *GetRetentionPoliciesReq) Check() error {
+	if len(x.ConversationIDs) == 0 && x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
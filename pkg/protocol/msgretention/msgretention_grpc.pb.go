@@ -0,0 +1,234 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.0
+// source: msgretention/msgretention.proto
+
+package msgretention
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MsgRetention_SetRetentionPolicy_FullMethodName     = "/openim.msgretention.MsgRetention/SetRetentionPolicy"
+	MsgRetention_DeleteRetentionPolicy_FullMethodName  = "/openim.msgretention.MsgRetention/DeleteRetentionPolicy"
+	MsgRetention_GetRetentionPolicies_FullMethodName   = "/openim.msgretention.MsgRetention/GetRetentionPolicies"
+	MsgRetention_ApplyRetentionPolicies_FullMethodName = "/openim.msgretention.MsgRetention/ApplyRetentionPolicies"
+)
+
+// MsgRetentionClient is the client API for MsgRetention service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgRetentionClient interface {
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyReq, opts ...grpc.CallOption) (*SetRetentionPolicyResp, error)
+	DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyReq, opts ...grpc.CallOption) (*DeleteRetentionPolicyResp, error)
+	GetRetentionPolicies(ctx context.Context, in *GetRetentionPoliciesReq, opts ...grpc.CallOption) (*GetRetentionPoliciesResp, error)
+	ApplyRetentionPolicies(ctx context.Context, in *ApplyRetentionPoliciesReq, opts ...grpc.CallOption) (*ApplyRetentionPoliciesResp, error)
+}
+
+type msgRetentionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgRetentionClient(cc grpc.ClientConnInterface) MsgRetentionClient {
+	return &msgRetentionClient{cc}
+}
+
+func (c *msgRetentionClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyReq, opts ...grpc.CallOption) (*SetRetentionPolicyResp, error) {
+	out := new(SetRetentionPolicyResp)
+	err := c.cc.Invoke(ctx, MsgRetention_SetRetentionPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgRetentionClient) DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyReq, opts ...grpc.CallOption) (*DeleteRetentionPolicyResp, error) {
+	out := new(DeleteRetentionPolicyResp)
+	err := c.cc.Invoke(ctx, MsgRetention_DeleteRetentionPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgRetentionClient) GetRetentionPolicies(ctx context.Context, in *GetRetentionPoliciesReq, opts ...grpc.CallOption) (*GetRetentionPoliciesResp, error) {
+	out := new(GetRetentionPoliciesResp)
+	err := c.cc.Invoke(ctx, MsgRetention_GetRetentionPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgRetentionClient) ApplyRetentionPolicies(ctx context.Context, in *ApplyRetentionPoliciesReq, opts ...grpc.CallOption) (*ApplyRetentionPoliciesResp, error) {
+	out := new(ApplyRetentionPoliciesResp)
+	err := c.cc.Invoke(ctx, MsgRetention_ApplyRetentionPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgRetentionServer is the server API for MsgRetention service.
+// All implementations must embed UnimplementedMsgRetentionServer
+// for forward compatibility
+type MsgRetentionServer interface {
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyReq) (*SetRetentionPolicyResp, error)
+	DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyReq) (*DeleteRetentionPolicyResp, error)
+	GetRetentionPolicies(context.Context, *GetRetentionPoliciesReq) (*GetRetentionPoliciesResp, error)
+	ApplyRetentionPolicies(context.Context, *ApplyRetentionPoliciesReq) (*ApplyRetentionPoliciesResp, error)
+	mustEmbedUnimplementedMsgRetentionServer()
+}
+
+// UnimplementedMsgRetentionServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgRetentionServer struct {
+}
+
+func (UnimplementedMsgRetentionServer) SetRetentionPolicy(context.Context, *SetRetentionPolicyReq) (*SetRetentionPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (UnimplementedMsgRetentionServer) DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyReq) (*DeleteRetentionPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRetentionPolicy not implemented")
+}
+func (UnimplementedMsgRetentionServer) GetRetentionPolicies(context.Context, *GetRetentionPoliciesReq) (*GetRetentionPoliciesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetentionPolicies not implemented")
+}
+func (UnimplementedMsgRetentionServer) ApplyRetentionPolicies(context.Context, *ApplyRetentionPoliciesReq) (*ApplyRetentionPoliciesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyRetentionPolicies not implemented")
+}
+func (UnimplementedMsgRetentionServer) mustEmbedUnimplementedMsgRetentionServer() {}
+
+// UnsafeMsgRetentionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgRetentionServer will
+// result in compilation errors.
+type UnsafeMsgRetentionServer interface {
+	mustEmbedUnimplementedMsgRetentionServer()
+}
+
+func RegisterMsgRetentionServer(s grpc.ServiceRegistrar, srv MsgRetentionServer) {
+	s.RegisterService(&MsgRetention_ServiceDesc, srv)
+}
+
+func _MsgRetention_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgRetentionServer).SetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgRetention_SetRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgRetentionServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgRetention_DeleteRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRetentionPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgRetentionServer).DeleteRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgRetention_DeleteRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgRetentionServer).DeleteRetentionPolicy(ctx, req.(*DeleteRetentionPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgRetention_GetRetentionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRetentionPoliciesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgRetentionServer).GetRetentionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgRetention_GetRetentionPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgRetentionServer).GetRetentionPolicies(ctx, req.(*GetRetentionPoliciesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgRetention_ApplyRetentionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRetentionPoliciesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgRetentionServer).ApplyRetentionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgRetention_ApplyRetentionPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgRetentionServer).ApplyRetentionPolicies(ctx, req.(*ApplyRetentionPoliciesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MsgRetention_ServiceDesc is the grpc.ServiceDesc for MsgRetention service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MsgRetention_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.msgretention.MsgRetention",
+	HandlerType: (*MsgRetentionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _MsgRetention_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteRetentionPolicy",
+			Handler:    _MsgRetention_DeleteRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "GetRetentionPolicies",
+			Handler:    _MsgRetention_GetRetentionPolicies_Handler,
+		},
+		{
+			MethodName: "ApplyRetentionPolicies",
+			Handler:    _MsgRetention_ApplyRetentionPolicies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msgretention/msgretention.proto",
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgschedule
+
+import "errors"
+
+func checkSchedule(userID, scheduleID string) error {
+	if userID == "" {
+		return errors.New("userID is empty")
+	}
+	if scheduleID == "" {
+		return errors.New("scheduleID is empty")
+	}
+	return nil
+}
+
+func (x *ScheduleMsgReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.MsgData == nil {
+		return errors.New("msgData is empty")
+	}
+	if x.SendTime <= 0 {
+		return errors.New("sendTime is invalid")
+	}
+	return nil
+}
+
+func (x *GetScheduledMsgsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *CancelScheduledMsgReq) Check() error {
+	return checkSchedule(x.UserID, x.ScheduleID)
+}
+
+func (x *RescheduleMsgReq) Check() error {
+	if err := checkSchedule(x.UserID, x.ScheduleID); err != nil {
+		return err
+	}
+	if x.SendTime <= 0 {
+		return errors.New("sendTime is invalid")
+	}
+	return nil
+}
+
+func (x *DispatchScheduledMsgsReq) Check() error {
+	if x.Limit <= 0 {
+		return errors.New("limit is invalid")
+	}
+	return nil
+}
@@ -0,0 +1,1052 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.26.0
+// source: msgschedule/msgschedule.proto
+
+package msgschedule
+
+import (
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ScheduledMsgStatus int32
+
+const (
+	ScheduledMsgStatus_Pending     ScheduledMsgStatus = 0
+	ScheduledMsgStatus_Dispatching ScheduledMsgStatus = 1
+	ScheduledMsgStatus_Sent        ScheduledMsgStatus = 2
+	ScheduledMsgStatus_Failed      ScheduledMsgStatus = 3
+	ScheduledMsgStatus_Canceled    ScheduledMsgStatus = 4
+)
+
+// Enum value maps for ScheduledMsgStatus.
+var (
+	ScheduledMsgStatus_name = map[int32]string{
+		0: "Pending",
+		1: "Dispatching",
+		2: "Sent",
+		3: "Failed",
+		4: "Canceled",
+	}
+	ScheduledMsgStatus_value = map[string]int32{
+		"Pending":     0,
+		"Dispatching": 1,
+		"Sent":        2,
+		"Failed":      3,
+		"Canceled":    4,
+	}
+)
+
+func (x ScheduledMsgStatus) Enum() *ScheduledMsgStatus {
+	p := new(ScheduledMsgStatus)
+	*p = x
+	return p
+}
+
+func (x ScheduledMsgStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduledMsgStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_msgschedule_msgschedule_proto_enumTypes[0].Descriptor()
+}
+
+func (ScheduledMsgStatus) Type() protoreflect.EnumType {
+	return &file_msgschedule_msgschedule_proto_enumTypes[0]
+}
+
+func (x ScheduledMsgStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduledMsgStatus.Descriptor instead.
+func (ScheduledMsgStatus) EnumDescriptor() ([]byte, []int) {
+	return file_msgschedule_msgschedule_proto_rawDescGZIP(), []int{0}
+}
+
+type ScheduledMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduleID string `protobuf:"bytes,1,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
+	// the user who scheduled the msg
+	UserID  string         `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	MsgData *sdkws.MsgData `protobuf:"bytes,3,opt,name=msgData,proto3" json:"msgData,omitempty"`
+	// unix milliseconds
+	SendTime int64              `protobuf:"varint,4,opt,name=sendTime,proto3" json:"sendTime,omitempty"`
+	Status   ScheduledMsgStatus `protobuf:"varint,5,opt,name=status,proto3,enum=openim.msgschedule.ScheduledMsgStatus" json:"status,omitempty"`
+	// reason of the failure when status is Failed
+	Error      string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	CreateTime int64  `protobuf:"varint,7,opt,name=createTime,proto3" json:"createTime,omitempty"`
+}
+
+func (x *ScheduledMsg) Reset() {
+	*x = ScheduledMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgschedule_msgschedule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledMsg) ProtoMessage() {}
+
+func (x *ScheduledMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_msgschedule_msgschedule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledMsg.ProtoReflect.Descriptor instead.
+func (*ScheduledMsg) Descriptor() ([]byte, []int) {
+	return file_msgschedule_msgschedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScheduledMsg) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
+func (x *ScheduledMsg) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ScheduledMsg) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+func (x *ScheduledMsg) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+func (x *ScheduledMsg) GetStatus() ScheduledMsgStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduledMsgStatus_Pending
+}
+
+func (x *ScheduledMsg) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ScheduledMsg) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+// ScheduleMsgReq stores msgData to be sent through SendMsg at sendTime.
+type ScheduleMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string         `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	MsgData  *sdkws.MsgData `protobuf:"bytes,2,opt,name=msgData,proto3" json:"msgData,omitempty"`
+	SendTime int64          `protobuf:"varint,3,opt,name=sendTime,proto3" json:"sendTime,omitempty"`
+}
+
+func (x *ScheduleMsgReq) Reset() {
+	*x = ScheduleMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgschedule_msgschedule_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMsgReq) ProtoMessage() {}
+
+func (x *ScheduleMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgschedule_msgschedule_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMsgReq.ProtoReflect.Descriptor instead.
+func (*ScheduleMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgschedule_msgschedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScheduleMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ScheduleMsgReq) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+func (x *ScheduleMsgReq) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+type ScheduleMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduleID string `protobuf:"bytes,1,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
+}
+
+func (x *ScheduleMsgResp) Reset() {
+	*x = ScheduleMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgschedule_msgschedule_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMsgResp) ProtoMessage() {}
+
+func (x *ScheduleMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgschedule_msgschedule_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMsgResp.ProtoReflect.Descriptor instead.
+func (*ScheduleMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgschedule_msgschedule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScheduleMsgResp) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
+type GetScheduledMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// all statuses when not set
+	Status     *ScheduledMsgStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=openim.msgschedule.ScheduledMsgStatus,oneof" json:"status,omitempty"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetScheduledMsgsReq) Reset() {
+	*x = GetScheduledMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgschedule_msgschedule_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledMsgsReq) ProtoMessage() {}
+
+func (x *GetScheduledMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgschedule_msgschedule_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledMsgsReq.ProtoReflect.Descriptor instead.
+func (*GetScheduledMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgschedule_msgschedule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetScheduledMsgsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetScheduledMsgsReq) GetStatus() ScheduledMsgStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ScheduledMsgStatus_Pending
+}
+
+func (x *GetScheduledMsgsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetScheduledMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64           `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Msgs  []*ScheduledMsg `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (x *GetScheduledMsgsResp) Reset() {
+	*x = GetScheduledMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgschedule_msgschedule_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledMsgsResp) ProtoMessage() {}
+
+func (x *GetScheduledMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgschedule_msgschedule_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledMsgsResp.ProtoReflect.Descriptor instead.
+func (*GetScheduledMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgschedule_msgschedule_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetScheduledMsgsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetScheduledMsgsResp) GetMsgs() []*ScheduledMsg {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+type CancelScheduledMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ScheduleID string `protobuf:"bytes,2,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
+}
+
+func (x *CancelScheduledMsgReq) Reset() {
+	*x = CancelScheduledMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgschedule_msgschedule_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMsgReq) ProtoMessage() {}
+
+func (x *CancelScheduledMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgschedule_msgschedule_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMsgReq.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgschedule_msgschedule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelScheduledMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CancelScheduledMsgReq) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
+type CancelScheduledMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelScheduledMsgResp) Reset() {
+	*x = CancelScheduledMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgschedule_msgschedule_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMsgResp) ProtoMessage() {}
+
+func (x *CancelScheduledMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgschedule_msgschedule_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMsgResp.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgschedule_msgschedule_proto_rawDescGZIP(), []int{6}
+}
+
+type RescheduleMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ScheduleID string `protobuf:"bytes,2,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
+	SendTime   int64  `protobuf:"varint,3,opt,name=sendTime,proto3" json:"sendTime,omitempty"`
+}
+
+func (x *RescheduleMsgReq) Reset() {
+	*x = RescheduleMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgschedule_msgschedule_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleMsgReq) ProtoMessage() {}
+
+func (x *RescheduleMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgschedule_msgschedule_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleMsgReq.ProtoReflect.Descriptor instead.
+func (*RescheduleMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgschedule_msgschedule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RescheduleMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RescheduleMsgReq) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
+func (x *RescheduleMsgReq) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+type RescheduleMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RescheduleMsgResp) Reset() {
+	*x = RescheduleMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgschedule_msgschedule_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleMsgResp) ProtoMessage() {}
+
+func (x *RescheduleMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgschedule_msgschedule_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleMsgResp.ProtoReflect.Descriptor instead.
+func (*RescheduleMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgschedule_msgschedule_proto_rawDescGZIP(), []int{8}
+}
+
+// DispatchScheduledMsgsReq sends the due msgs, it is called periodically by openim-crontask.
+type DispatchScheduledMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *DispatchScheduledMsgsReq) Reset() {
+	*x = DispatchScheduledMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgschedule_msgschedule_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DispatchScheduledMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchScheduledMsgsReq) ProtoMessage() {}
+
+func (x *DispatchScheduledMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgschedule_msgschedule_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispatchScheduledMsgsReq.ProtoReflect.Descriptor instead.
+func (*DispatchScheduledMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgschedule_msgschedule_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DispatchScheduledMsgsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DispatchScheduledMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sent   int32 `protobuf:"varint,1,opt,name=sent,proto3" json:"sent,omitempty"`
+	Failed int32 `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *DispatchScheduledMsgsResp) Reset() {
+	*x = DispatchScheduledMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgschedule_msgschedule_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DispatchScheduledMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchScheduledMsgsResp) ProtoMessage() {}
+
+func (x *DispatchScheduledMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgschedule_msgschedule_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispatchScheduledMsgsResp.ProtoReflect.Descriptor instead.
+func (*DispatchScheduledMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgschedule_msgschedule_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DispatchScheduledMsgsResp) GetSent() int32 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *DispatchScheduledMsgsResp) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+var File_msgschedule_msgschedule_proto protoreflect.FileDescriptor
+
+var file_msgschedule_msgschedule_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x6d, 0x73, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x6d, 0x73,
+	0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x12, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x1a, 0x11, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89, 0x02, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x2f, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e,
+	0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x75, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x07,
+	0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x0f, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x22, 0xbe, 0x01, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x62, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x04, 0x6d,
+	0x73, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x6d, 0x73, 0x67,
+	0x73, 0x22, 0x4f, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x49, 0x44, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x66, 0x0a, 0x10,
+	0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x30, 0x0a, 0x18, 0x44, 0x69, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x47, 0x0a, 0x19, 0x44,
+	0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x2a, 0x56, 0x0a, 0x12, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x65, 0x6e, 0x74,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x12, 0x0c,
+	0x0a, 0x08, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x10, 0x04, 0x32, 0x8d, 0x04, 0x0a,
+	0x0b, 0x4d, 0x73, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x56, 0x0a, 0x0b,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x22, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a,
+	0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x65, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a, 0x12, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x12, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a,
+	0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x74, 0x0a, 0x15, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x12,
+	0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x41, 0x5a, 0x3f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2f, 0x6d, 0x73, 0x67, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_msgschedule_msgschedule_proto_rawDescOnce sync.Once
+	file_msgschedule_msgschedule_proto_rawDescData = file_msgschedule_msgschedule_proto_rawDesc
+)
+
+func file_msgschedule_msgschedule_proto_rawDescGZIP() []byte {
+	file_msgschedule_msgschedule_proto_rawDescOnce.Do(func() {
+		file_msgschedule_msgschedule_proto_rawDescData = protoimpl.X.CompressGZIP(file_msgschedule_msgschedule_proto_rawDescData)
+	})
+	return file_msgschedule_msgschedule_proto_rawDescData
+}
+
+var file_msgschedule_msgschedule_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_msgschedule_msgschedule_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_msgschedule_msgschedule_proto_goTypes = []interface{}{
+	(ScheduledMsgStatus)(0),           // 0: openim.msgschedule.ScheduledMsgStatus
+	(*ScheduledMsg)(nil),              // 1: openim.msgschedule.ScheduledMsg
+	(*ScheduleMsgReq)(nil),            // 2: openim.msgschedule.ScheduleMsgReq
+	(*ScheduleMsgResp)(nil),           // 3: openim.msgschedule.ScheduleMsgResp
+	(*GetScheduledMsgsReq)(nil),       // 4: openim.msgschedule.GetScheduledMsgsReq
+	(*GetScheduledMsgsResp)(nil),      // 5: openim.msgschedule.GetScheduledMsgsResp
+	(*CancelScheduledMsgReq)(nil),     // 6: openim.msgschedule.CancelScheduledMsgReq
+	(*CancelScheduledMsgResp)(nil),    // 7: openim.msgschedule.CancelScheduledMsgResp
+	(*RescheduleMsgReq)(nil),          // 8: openim.msgschedule.RescheduleMsgReq
+	(*RescheduleMsgResp)(nil),         // 9: openim.msgschedule.RescheduleMsgResp
+	(*DispatchScheduledMsgsReq)(nil),  // 10: openim.msgschedule.DispatchScheduledMsgsReq
+	(*DispatchScheduledMsgsResp)(nil), // 11: openim.msgschedule.DispatchScheduledMsgsResp
+	(*sdkws.MsgData)(nil),             // 12: openim.sdkws.MsgData
+	(*sdkws.RequestPagination)(nil),   // 13: openim.sdkws.RequestPagination
+}
+var file_msgschedule_msgschedule_proto_depIdxs = []int32{
+	12, // 0: openim.msgschedule.ScheduledMsg.msgData:type_name -> openim.sdkws.MsgData
+	0,  // 1: openim.msgschedule.ScheduledMsg.status:type_name -> openim.msgschedule.ScheduledMsgStatus
+	12, // 2: openim.msgschedule.ScheduleMsgReq.msgData:type_name -> openim.sdkws.MsgData
+	0,  // 3: openim.msgschedule.GetScheduledMsgsReq.status:type_name -> openim.msgschedule.ScheduledMsgStatus
+	13, // 4: openim.msgschedule.GetScheduledMsgsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	1,  // 5: openim.msgschedule.GetScheduledMsgsResp.msgs:type_name -> openim.msgschedule.ScheduledMsg
+	2,  // 6: openim.msgschedule.MsgSchedule.ScheduleMsg:input_type -> openim.msgschedule.ScheduleMsgReq
+	4,  // 7: openim.msgschedule.MsgSchedule.GetScheduledMsgs:input_type -> openim.msgschedule.GetScheduledMsgsReq
+	6,  // 8: openim.msgschedule.MsgSchedule.CancelScheduledMsg:input_type -> openim.msgschedule.CancelScheduledMsgReq
+	8,  // 9: openim.msgschedule.MsgSchedule.RescheduleMsg:input_type -> openim.msgschedule.RescheduleMsgReq
+	10, // 10: openim.msgschedule.MsgSchedule.DispatchScheduledMsgs:input_type -> openim.msgschedule.DispatchScheduledMsgsReq
+	3,  // 11: openim.msgschedule.MsgSchedule.ScheduleMsg:output_type -> openim.msgschedule.ScheduleMsgResp
+	5,  // 12: openim.msgschedule.MsgSchedule.GetScheduledMsgs:output_type -> openim.msgschedule.GetScheduledMsgsResp
+	7,  // 13: openim.msgschedule.MsgSchedule.CancelScheduledMsg:output_type -> openim.msgschedule.CancelScheduledMsgResp
+	9,  // 14: openim.msgschedule.MsgSchedule.RescheduleMsg:output_type -> openim.msgschedule.RescheduleMsgResp
+	11, // 15: openim.msgschedule.MsgSchedule.DispatchScheduledMsgs:output_type -> openim.msgschedule.DispatchScheduledMsgsResp
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_msgschedule_msgschedule_proto_init() }
+func file_msgschedule_msgschedule_proto_init() {
+	if File_msgschedule_msgschedule_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_msgschedule_msgschedule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgschedule_msgschedule_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgschedule_msgschedule_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgschedule_msgschedule_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgschedule_msgschedule_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgschedule_msgschedule_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgschedule_msgschedule_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgschedule_msgschedule_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgschedule_msgschedule_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgschedule_msgschedule_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DispatchScheduledMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgschedule_msgschedule_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DispatchScheduledMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_msgschedule_msgschedule_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_msgschedule_msgschedule_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_msgschedule_msgschedule_proto_goTypes,
+		DependencyIndexes: file_msgschedule_msgschedule_proto_depIdxs,
+		EnumInfos:         file_msgschedule_msgschedule_proto_enumTypes,
+		MessageInfos:      file_msgschedule_msgschedule_proto_msgTypes,
+	}.Build()
+	File_msgschedule_msgschedule_proto = out.File
+	file_msgschedule_msgschedule_proto_rawDesc = nil
+	file_msgschedule_msgschedule_proto_goTypes = nil
+	file_msgschedule_msgschedule_proto_depIdxs = nil
+}
@@ -0,0 +1,271 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.0
+// source: msgschedule/msgschedule.proto
+
+package msgschedule
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MsgSchedule_ScheduleMsg_FullMethodName           = "/openim.msgschedule.MsgSchedule/ScheduleMsg"
+	MsgSchedule_GetScheduledMsgs_FullMethodName      = "/openim.msgschedule.MsgSchedule/GetScheduledMsgs"
+	MsgSchedule_CancelScheduledMsg_FullMethodName    = "/openim.msgschedule.MsgSchedule/CancelScheduledMsg"
+	MsgSchedule_RescheduleMsg_FullMethodName         = "/openim.msgschedule.MsgSchedule/RescheduleMsg"
+	MsgSchedule_DispatchScheduledMsgs_FullMethodName = "/openim.msgschedule.MsgSchedule/DispatchScheduledMsgs"
+)
+
+// MsgScheduleClient is the client API for MsgSchedule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgScheduleClient interface {
+	ScheduleMsg(ctx context.Context, in *ScheduleMsgReq, opts ...grpc.CallOption) (*ScheduleMsgResp, error)
+	GetScheduledMsgs(ctx context.Context, in *GetScheduledMsgsReq, opts ...grpc.CallOption) (*GetScheduledMsgsResp, error)
+	CancelScheduledMsg(ctx context.Context, in *CancelScheduledMsgReq, opts ...grpc.CallOption) (*CancelScheduledMsgResp, error)
+	RescheduleMsg(ctx context.Context, in *RescheduleMsgReq, opts ...grpc.CallOption) (*RescheduleMsgResp, error)
+	DispatchScheduledMsgs(ctx context.Context, in *DispatchScheduledMsgsReq, opts ...grpc.CallOption) (*DispatchScheduledMsgsResp, error)
+}
+
+type msgScheduleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgScheduleClient(cc grpc.ClientConnInterface) MsgScheduleClient {
+	return &msgScheduleClient{cc}
+}
+
+func (c *msgScheduleClient) ScheduleMsg(ctx context.Context, in *ScheduleMsgReq, opts ...grpc.CallOption) (*ScheduleMsgResp, error) {
+	out := new(ScheduleMsgResp)
+	err := c.cc.Invoke(ctx, MsgSchedule_ScheduleMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgScheduleClient) GetScheduledMsgs(ctx context.Context, in *GetScheduledMsgsReq, opts ...grpc.CallOption) (*GetScheduledMsgsResp, error) {
+	out := new(GetScheduledMsgsResp)
+	err := c.cc.Invoke(ctx, MsgSchedule_GetScheduledMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgScheduleClient) CancelScheduledMsg(ctx context.Context, in *CancelScheduledMsgReq, opts ...grpc.CallOption) (*CancelScheduledMsgResp, error) {
+	out := new(CancelScheduledMsgResp)
+	err := c.cc.Invoke(ctx, MsgSchedule_CancelScheduledMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgScheduleClient) RescheduleMsg(ctx context.Context, in *RescheduleMsgReq, opts ...grpc.CallOption) (*RescheduleMsgResp, error) {
+	out := new(RescheduleMsgResp)
+	err := c.cc.Invoke(ctx, MsgSchedule_RescheduleMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgScheduleClient) DispatchScheduledMsgs(ctx context.Context, in *DispatchScheduledMsgsReq, opts ...grpc.CallOption) (*DispatchScheduledMsgsResp, error) {
+	out := new(DispatchScheduledMsgsResp)
+	err := c.cc.Invoke(ctx, MsgSchedule_DispatchScheduledMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgScheduleServer is the server API for MsgSchedule service.
+// All implementations must embed UnimplementedMsgScheduleServer
+// for forward compatibility
+type MsgScheduleServer interface {
+	ScheduleMsg(context.Context, *ScheduleMsgReq) (*ScheduleMsgResp, error)
+	GetScheduledMsgs(context.Context, *GetScheduledMsgsReq) (*GetScheduledMsgsResp, error)
+	CancelScheduledMsg(context.Context, *CancelScheduledMsgReq) (*CancelScheduledMsgResp, error)
+	RescheduleMsg(context.Context, *RescheduleMsgReq) (*RescheduleMsgResp, error)
+	DispatchScheduledMsgs(context.Context, *DispatchScheduledMsgsReq) (*DispatchScheduledMsgsResp, error)
+	mustEmbedUnimplementedMsgScheduleServer()
+}
+
+// UnimplementedMsgScheduleServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgScheduleServer struct {
+}
+
+func (UnimplementedMsgScheduleServer) ScheduleMsg(context.Context, *ScheduleMsgReq) (*ScheduleMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMsg not implemented")
+}
+func (UnimplementedMsgScheduleServer) GetScheduledMsgs(context.Context, *GetScheduledMsgsReq) (*GetScheduledMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledMsgs not implemented")
+}
+func (UnimplementedMsgScheduleServer) CancelScheduledMsg(context.Context, *CancelScheduledMsgReq) (*CancelScheduledMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMsg not implemented")
+}
+func (UnimplementedMsgScheduleServer) RescheduleMsg(context.Context, *RescheduleMsgReq) (*RescheduleMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleMsg not implemented")
+}
+func (UnimplementedMsgScheduleServer) DispatchScheduledMsgs(context.Context, *DispatchScheduledMsgsReq) (*DispatchScheduledMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DispatchScheduledMsgs not implemented")
+}
+func (UnimplementedMsgScheduleServer) mustEmbedUnimplementedMsgScheduleServer() {}
+
+// UnsafeMsgScheduleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgScheduleServer will
+// result in compilation errors.
+type UnsafeMsgScheduleServer interface {
+	mustEmbedUnimplementedMsgScheduleServer()
+}
+
+func RegisterMsgScheduleServer(s grpc.ServiceRegistrar, srv MsgScheduleServer) {
+	s.RegisterService(&MsgSchedule_ServiceDesc, srv)
+}
+
+func _MsgSchedule_ScheduleMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgScheduleServer).ScheduleMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgSchedule_ScheduleMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgScheduleServer).ScheduleMsg(ctx, req.(*ScheduleMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgSchedule_GetScheduledMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgScheduleServer).GetScheduledMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgSchedule_GetScheduledMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgScheduleServer).GetScheduledMsgs(ctx, req.(*GetScheduledMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgSchedule_CancelScheduledMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgScheduleServer).CancelScheduledMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgSchedule_CancelScheduledMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgScheduleServer).CancelScheduledMsg(ctx, req.(*CancelScheduledMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgSchedule_RescheduleMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgScheduleServer).RescheduleMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgSchedule_RescheduleMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgScheduleServer).RescheduleMsg(ctx, req.(*RescheduleMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgSchedule_DispatchScheduledMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DispatchScheduledMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgScheduleServer).DispatchScheduledMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgSchedule_DispatchScheduledMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgScheduleServer).DispatchScheduledMsgs(ctx, req.(*DispatchScheduledMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MsgSchedule_ServiceDesc is the grpc.ServiceDesc for MsgSchedule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MsgSchedule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.msgschedule.MsgSchedule",
+	HandlerType: (*MsgScheduleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleMsg",
+			Handler:    _MsgSchedule_ScheduleMsg_Handler,
+		},
+		{
+			MethodName: "GetScheduledMsgs",
+			Handler:    _MsgSchedule_GetScheduledMsgs_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMsg",
+			Handler:    _MsgSchedule_CancelScheduledMsg_Handler,
+		},
+		{
+			MethodName: "RescheduleMsg",
+			Handler:    _MsgSchedule_RescheduleMsg_Handler,
+		},
+		{
+			MethodName: "DispatchScheduledMsgs",
+			Handler:    _MsgSchedule_DispatchScheduledMsgs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msgschedule/msgschedule.proto",
+}
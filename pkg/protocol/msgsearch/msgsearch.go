@@ -0,0 +1,34 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgsearch
+
+import "errors"
+
+func (x *SearchMsgReq) Check() error {
+	if x.Count < 0 || x.Count > 100 {
+		return errors.New("count must be between 0 and 100")
+	}
+	if x.StartTime < 0 || x.EndTime < 0 || (x.EndTime > 0 && x.EndTime <= x.StartTime) {
+		return errors.New("time range is invalid")
+	}
+	return nil
+}
+
+func (x *IndexMsgsReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	return nil
+}
@@ -0,0 +1,611 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.26.0
+// source: msgsearch/msgsearch.proto
+
+package msgsearch
+
+import (
+	msg "github.com/openimsdk/protocol/msg"
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SearchMsgReq keeps the fields of msg.SearchMessageReq so existing /msg/search_msg callers are unaffected.
+type SearchMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendID      string `protobuf:"bytes,1,opt,name=sendID,proto3" json:"sendID,omitempty"`
+	RecvID      string `protobuf:"bytes,2,opt,name=recvID,proto3" json:"recvID,omitempty"`
+	ContentType int32  `protobuf:"varint,3,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	// yyyy-mm-dd, messages sent on that day
+	SendTime    string `protobuf:"bytes,4,opt,name=sendTime,proto3" json:"sendTime,omitempty"`
+	SessionType int32  `protobuf:"varint,5,opt,name=sessionType,proto3" json:"sessionType,omitempty"`
+	// page based pagination, ignored when the search index is enabled
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// matched against the message text, requires the search index
+	Keyword         string   `protobuf:"bytes,7,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	ConversationIDs []string `protobuf:"bytes,8,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
+	ContentTypes    []int32  `protobuf:"varint,9,rep,packed,name=contentTypes,proto3" json:"contentTypes,omitempty"`
+	// milliseconds, endTime is exclusive
+	StartTime int64 `protobuf:"varint,10,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime   int64 `protobuf:"varint,11,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	// nextCursor of the previous page, empty for the first one
+	Cursor string `protobuf:"bytes,12,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count  int32  `protobuf:"varint,13,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *SearchMsgReq) Reset() {
+	*x = SearchMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgsearch_msgsearch_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMsgReq) ProtoMessage() {}
+
+func (x *SearchMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgsearch_msgsearch_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMsgReq.ProtoReflect.Descriptor instead.
+func (*SearchMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgsearch_msgsearch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchMsgReq) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *SearchMsgReq) GetRecvID() string {
+	if x != nil {
+		return x.RecvID
+	}
+	return ""
+}
+
+func (x *SearchMsgReq) GetContentType() int32 {
+	if x != nil {
+		return x.ContentType
+	}
+	return 0
+}
+
+func (x *SearchMsgReq) GetSendTime() string {
+	if x != nil {
+		return x.SendTime
+	}
+	return ""
+}
+
+func (x *SearchMsgReq) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *SearchMsgReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SearchMsgReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchMsgReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+func (x *SearchMsgReq) GetContentTypes() []int32 {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *SearchMsgReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SearchMsgReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *SearchMsgReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchMsgReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SearchMsgHit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	// parts of the message text with the keyword wrapped in <mark></mark>
+	Fragments []string `protobuf:"bytes,2,rep,name=fragments,proto3" json:"fragments,omitempty"`
+}
+
+func (x *SearchMsgHit) Reset() {
+	*x = SearchMsgHit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgsearch_msgsearch_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMsgHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMsgHit) ProtoMessage() {}
+
+func (x *SearchMsgHit) ProtoReflect() protoreflect.Message {
+	mi := &file_msgsearch_msgsearch_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMsgHit.ProtoReflect.Descriptor instead.
+func (*SearchMsgHit) Descriptor() ([]byte, []int) {
+	return file_msgsearch_msgsearch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchMsgHit) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *SearchMsgHit) GetFragments() []string {
+	if x != nil {
+		return x.Fragments
+	}
+	return nil
+}
+
+type SearchMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatLogs    []*msg.ChatLog `protobuf:"bytes,1,rep,name=chatLogs,proto3" json:"chatLogs,omitempty"`
+	ChatLogsNum int32          `protobuf:"varint,2,opt,name=chatLogsNum,proto3" json:"chatLogsNum,omitempty"`
+	// hits[i] describes chatLogs[i], only set when the search index is enabled
+	Hits []*SearchMsgHit `protobuf:"bytes,3,rep,name=hits,proto3" json:"hits,omitempty"`
+	// empty on the last page
+	NextCursor string `protobuf:"bytes,4,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+}
+
+func (x *SearchMsgResp) Reset() {
+	*x = SearchMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgsearch_msgsearch_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMsgResp) ProtoMessage() {}
+
+func (x *SearchMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgsearch_msgsearch_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMsgResp.ProtoReflect.Descriptor instead.
+func (*SearchMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgsearch_msgsearch_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchMsgResp) GetChatLogs() []*msg.ChatLog {
+	if x != nil {
+		return x.ChatLogs
+	}
+	return nil
+}
+
+func (x *SearchMsgResp) GetChatLogsNum() int32 {
+	if x != nil {
+		return x.ChatLogsNum
+	}
+	return 0
+}
+
+func (x *SearchMsgResp) GetHits() []*SearchMsgHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchMsgResp) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type IndexMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string           `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Msgs           []*sdkws.MsgData `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (x *IndexMsgsReq) Reset() {
+	*x = IndexMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgsearch_msgsearch_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexMsgsReq) ProtoMessage() {}
+
+func (x *IndexMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgsearch_msgsearch_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexMsgsReq.ProtoReflect.Descriptor instead.
+func (*IndexMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgsearch_msgsearch_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IndexMsgsReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *IndexMsgsReq) GetMsgs() []*sdkws.MsgData {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+type IndexMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *IndexMsgsResp) Reset() {
+	*x = IndexMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgsearch_msgsearch_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexMsgsResp) ProtoMessage() {}
+
+func (x *IndexMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgsearch_msgsearch_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexMsgsResp.ProtoReflect.Descriptor instead.
+func (*IndexMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgsearch_msgsearch_proto_rawDescGZIP(), []int{4}
+}
+
+var File_msgsearch_msgsearch_proto protoreflect.FileDescriptor
+
+var file_msgsearch_msgsearch_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x6d, 0x73, 0x67, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x6d, 0x73, 0x67, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x1a, 0x11, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x0d, 0x6d, 0x73, 0x67, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xad, 0x03, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x76,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64,
+	0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x54, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x48, 0x69, 0x74, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x61, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x08,
+	0x63, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x74,
+	0x4c, 0x6f, 0x67, 0x73, 0x4e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63,
+	0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x4e, 0x75, 0x6d, 0x12, 0x32, 0x0a, 0x04, 0x68, 0x69,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x4d, 0x73, 0x67, 0x48, 0x69, 0x74, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x61,
+	0x0a, 0x0c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64,
+	0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x73, 0x67,
+	0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x32, 0xa7, 0x01, 0x0a, 0x09, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x12, 0x4c, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x12, 0x1e, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c,
+	0x0a, 0x09, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x3f, 0x5a, 0x3d,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2f, 0x6d, 0x73, 0x67, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_msgsearch_msgsearch_proto_rawDescOnce sync.Once
+	file_msgsearch_msgsearch_proto_rawDescData = file_msgsearch_msgsearch_proto_rawDesc
+)
+
+func file_msgsearch_msgsearch_proto_rawDescGZIP() []byte {
+	file_msgsearch_msgsearch_proto_rawDescOnce.Do(func() {
+		file_msgsearch_msgsearch_proto_rawDescData = protoimpl.X.CompressGZIP(file_msgsearch_msgsearch_proto_rawDescData)
+	})
+	return file_msgsearch_msgsearch_proto_rawDescData
+}
+
+var file_msgsearch_msgsearch_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_msgsearch_msgsearch_proto_goTypes = []interface{}{
+	(*SearchMsgReq)(nil),            // 0: openim.msgsearch.SearchMsgReq
+	(*SearchMsgHit)(nil),            // 1: openim.msgsearch.SearchMsgHit
+	(*SearchMsgResp)(nil),           // 2: openim.msgsearch.SearchMsgResp
+	(*IndexMsgsReq)(nil),            // 3: openim.msgsearch.IndexMsgsReq
+	(*IndexMsgsResp)(nil),           // 4: openim.msgsearch.IndexMsgsResp
+	(*sdkws.RequestPagination)(nil), // 5: openim.sdkws.RequestPagination
+	(*msg.ChatLog)(nil),             // 6: openim.msg.ChatLog
+	(*sdkws.MsgData)(nil),           // 7: openim.sdkws.MsgData
+}
+var file_msgsearch_msgsearch_proto_depIdxs = []int32{
+	5, // 0: openim.msgsearch.SearchMsgReq.pagination:type_name -> openim.sdkws.RequestPagination
+	6, // 1: openim.msgsearch.SearchMsgResp.chatLogs:type_name -> openim.msg.ChatLog
+	1, // 2: openim.msgsearch.SearchMsgResp.hits:type_name -> openim.msgsearch.SearchMsgHit
+	7, // 3: openim.msgsearch.IndexMsgsReq.msgs:type_name -> openim.sdkws.MsgData
+	0, // 4: openim.msgsearch.MsgSearch.SearchMsg:input_type -> openim.msgsearch.SearchMsgReq
+	3, // 5: openim.msgsearch.MsgSearch.IndexMsgs:input_type -> openim.msgsearch.IndexMsgsReq
+	2, // 6: openim.msgsearch.MsgSearch.SearchMsg:output_type -> openim.msgsearch.SearchMsgResp
+	4, // 7: openim.msgsearch.MsgSearch.IndexMsgs:output_type -> openim.msgsearch.IndexMsgsResp
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_msgsearch_msgsearch_proto_init() }
+func file_msgsearch_msgsearch_proto_init() {
+	if File_msgsearch_msgsearch_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_msgsearch_msgsearch_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgsearch_msgsearch_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMsgHit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgsearch_msgsearch_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgsearch_msgsearch_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgsearch_msgsearch_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_msgsearch_msgsearch_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_msgsearch_msgsearch_proto_goTypes,
+		DependencyIndexes: file_msgsearch_msgsearch_proto_depIdxs,
+		MessageInfos:      file_msgsearch_msgsearch_proto_msgTypes,
+	}.Build()
+	File_msgsearch_msgsearch_proto = out.File
+	file_msgsearch_msgsearch_proto_rawDesc = nil
+	file_msgsearch_msgsearch_proto_goTypes = nil
+	file_msgsearch_msgsearch_proto_depIdxs = nil
+}
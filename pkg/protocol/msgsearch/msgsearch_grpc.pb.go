@@ -0,0 +1,162 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.0
+// source: msgsearch/msgsearch.proto
+
+package msgsearch
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MsgSearch_SearchMsg_FullMethodName = "/openim.msgsearch.MsgSearch/SearchMsg"
+	MsgSearch_IndexMsgs_FullMethodName = "/openim.msgsearch.MsgSearch/IndexMsgs"
+)
+
+// MsgSearchClient is the client API for MsgSearch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgSearchClient interface {
+	SearchMsg(ctx context.Context, in *SearchMsgReq, opts ...grpc.CallOption) (*SearchMsgResp, error)
+	// IndexMsgs is called by openim-msgtransfer once the messages are stored in MongoDB.
+	IndexMsgs(ctx context.Context, in *IndexMsgsReq, opts ...grpc.CallOption) (*IndexMsgsResp, error)
+}
+
+type msgSearchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgSearchClient(cc grpc.ClientConnInterface) MsgSearchClient {
+	return &msgSearchClient{cc}
+}
+
+func (c *msgSearchClient) SearchMsg(ctx context.Context, in *SearchMsgReq, opts ...grpc.CallOption) (*SearchMsgResp, error) {
+	out := new(SearchMsgResp)
+	err := c.cc.Invoke(ctx, MsgSearch_SearchMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgSearchClient) IndexMsgs(ctx context.Context, in *IndexMsgsReq, opts ...grpc.CallOption) (*IndexMsgsResp, error) {
+	out := new(IndexMsgsResp)
+	err := c.cc.Invoke(ctx, MsgSearch_IndexMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgSearchServer is the server API for MsgSearch service.
+// All implementations must embed UnimplementedMsgSearchServer
+// for forward compatibility
+type MsgSearchServer interface {
+	SearchMsg(context.Context, *SearchMsgReq) (*SearchMsgResp, error)
+	// IndexMsgs is called by openim-msgtransfer once the messages are stored in MongoDB.
+	IndexMsgs(context.Context, *IndexMsgsReq) (*IndexMsgsResp, error)
+	mustEmbedUnimplementedMsgSearchServer()
+}
+
+// UnimplementedMsgSearchServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgSearchServer struct {
+}
+
+func (UnimplementedMsgSearchServer) SearchMsg(context.Context, *SearchMsgReq) (*SearchMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMsg not implemented")
+}
+func (UnimplementedMsgSearchServer) IndexMsgs(context.Context, *IndexMsgsReq) (*IndexMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexMsgs not implemented")
+}
+func (UnimplementedMsgSearchServer) mustEmbedUnimplementedMsgSearchServer() {}
+
+// UnsafeMsgSearchServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgSearchServer will
+// result in compilation errors.
+type UnsafeMsgSearchServer interface {
+	mustEmbedUnimplementedMsgSearchServer()
+}
+
+func RegisterMsgSearchServer(s grpc.ServiceRegistrar, srv MsgSearchServer) {
+	s.RegisterService(&MsgSearch_ServiceDesc, srv)
+}
+
+func _MsgSearch_SearchMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgSearchServer).SearchMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgSearch_SearchMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgSearchServer).SearchMsg(ctx, req.(*SearchMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgSearch_IndexMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgSearchServer).IndexMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgSearch_IndexMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgSearchServer).IndexMsgs(ctx, req.(*IndexMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MsgSearch_ServiceDesc is the grpc.ServiceDesc for MsgSearch service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MsgSearch_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.msgsearch.MsgSearch",
+	HandlerType: (*MsgSearchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchMsg",
+			Handler:    _MsgSearch_SearchMsg_Handler,
+		},
+		{
+			MethodName: "IndexMsgs",
+			Handler:    _MsgSearch_IndexMsgs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msgsearch/msgsearch.proto",
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgthread
+
+import "errors"
+
+const maxRootSeqs = 200
+
+func (x *CreateThreadReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.RootSeq <= 0 {
+		return errors.New("rootSeq is invalid")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetThreadsByRootSeqsReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if len(x.RootSeqs) == 0 || len(x.RootSeqs) > maxRootSeqs {
+		return errors.New("rootSeqs length must be between 1 and 200")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetUserThreadsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *GetThreadParticipantIDsReq) Check() error {
+	if x.ThreadID == "" {
+		return errors.New("threadID is empty")
+	}
+	return nil
+}
+
+func (x *PullThreadMsgsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ThreadID == "" {
+		return errors.New("threadID is empty")
+	}
+	if x.Begin < 0 || x.End < x.Begin {
+		return errors.New("seq range is invalid")
+	}
+	if x.Num <= 0 {
+		return errors.New("num is invalid")
+	}
+	return nil
+}
+
+func (x *MarkThreadAsReadReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ThreadID == "" {
+		return errors.New("threadID is empty")
+	}
+	if x.HasReadSeq < 0 {
+		return errors.New("hasReadSeq is invalid")
+	}
+	return nil
+}
@@ -0,0 +1,1399 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.26.0
+// source: msgthread/msgthread.proto
+
+package msgthread
+
+import (
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ThreadReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq         int64  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	SendID      string `protobuf:"bytes,2,opt,name=sendID,proto3" json:"sendID,omitempty"`
+	ClientMsgID string `protobuf:"bytes,3,opt,name=clientMsgID,proto3" json:"clientMsgID,omitempty"`
+	ContentType int32  `protobuf:"varint,4,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Content     string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	SendTime    int64  `protobuf:"varint,6,opt,name=sendTime,proto3" json:"sendTime,omitempty"`
+}
+
+func (x *ThreadReply) Reset() {
+	*x = ThreadReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgthread_msgthread_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadReply) ProtoMessage() {}
+
+func (x *ThreadReply) ProtoReflect() protoreflect.Message {
+	mi := &file_msgthread_msgthread_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadReply.ProtoReflect.Descriptor instead.
+func (*ThreadReply) Descriptor() ([]byte, []int) {
+	return file_msgthread_msgthread_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ThreadReply) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ThreadReply) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *ThreadReply) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *ThreadReply) GetContentType() int32 {
+	if x != nil {
+		return x.ContentType
+	}
+	return 0
+}
+
+func (x *ThreadReply) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ThreadReply) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+type ThreadInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// conversationID of the replies, see msgprocessor.GetThreadConversationID
+	ThreadID string `protobuf:"bytes,1,opt,name=threadID,proto3" json:"threadID,omitempty"`
+	// conversationID of the group the root message belongs to
+	ConversationID  string       `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	GroupID         string       `protobuf:"bytes,3,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	RootSeq         int64        `protobuf:"varint,4,opt,name=rootSeq,proto3" json:"rootSeq,omitempty"`
+	RootClientMsgID string       `protobuf:"bytes,5,opt,name=rootClientMsgID,proto3" json:"rootClientMsgID,omitempty"`
+	CreatorUserID   string       `protobuf:"bytes,6,opt,name=creatorUserID,proto3" json:"creatorUserID,omitempty"`
+	ReplyCount      int64        `protobuf:"varint,7,opt,name=replyCount,proto3" json:"replyCount,omitempty"`
+	LastReply       *ThreadReply `protobuf:"bytes,8,opt,name=lastReply,proto3" json:"lastReply,omitempty"`
+	CreateTime      int64        `protobuf:"varint,9,opt,name=createTime,proto3" json:"createTime,omitempty"`
+}
+
+func (x *ThreadInfo) Reset() {
+	*x = ThreadInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgthread_msgthread_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadInfo) ProtoMessage() {}
+
+func (x *ThreadInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_msgthread_msgthread_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadInfo.ProtoReflect.Descriptor instead.
+func (*ThreadInfo) Descriptor() ([]byte, []int) {
+	return file_msgthread_msgthread_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ThreadInfo) GetThreadID() string {
+	if x != nil {
+		return x.ThreadID
+	}
+	return ""
+}
+
+func (x *ThreadInfo) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *ThreadInfo) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *ThreadInfo) GetRootSeq() int64 {
+	if x != nil {
+		return x.RootSeq
+	}
+	return 0
+}
+
+func (x *ThreadInfo) GetRootClientMsgID() string {
+	if x != nil {
+		return x.RootClientMsgID
+	}
+	return ""
+}
+
+func (x *ThreadInfo) GetCreatorUserID() string {
+	if x != nil {
+		return x.CreatorUserID
+	}
+	return ""
+}
+
+func (x *ThreadInfo) GetReplyCount() int64 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *ThreadInfo) GetLastReply() *ThreadReply {
+	if x != nil {
+		return x.LastReply
+	}
+	return nil
+}
+
+func (x *ThreadInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+// CreateThreadReq starts a thread from a group message, the existing thread is returned if already started.
+type CreateThreadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	RootSeq        int64  `protobuf:"varint,2,opt,name=rootSeq,proto3" json:"rootSeq,omitempty"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *CreateThreadReq) Reset() {
+	*x = CreateThreadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgthread_msgthread_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateThreadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateThreadReq) ProtoMessage() {}
+
+func (x *CreateThreadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgthread_msgthread_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateThreadReq.ProtoReflect.Descriptor instead.
+func (*CreateThreadReq) Descriptor() ([]byte, []int) {
+	return file_msgthread_msgthread_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateThreadReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *CreateThreadReq) GetRootSeq() int64 {
+	if x != nil {
+		return x.RootSeq
+	}
+	return 0
+}
+
+func (x *CreateThreadReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type CreateThreadResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Thread *ThreadInfo `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+}
+
+func (x *CreateThreadResp) Reset() {
+	*x = CreateThreadResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgthread_msgthread_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateThreadResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateThreadResp) ProtoMessage() {}
+
+func (x *CreateThreadResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgthread_msgthread_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateThreadResp.ProtoReflect.Descriptor instead.
+func (*CreateThreadResp) Descriptor() ([]byte, []int) {
+	return file_msgthread_msgthread_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateThreadResp) GetThread() *ThreadInfo {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+type GetThreadsByRootSeqsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string  `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	RootSeqs       []int64 `protobuf:"varint,2,rep,packed,name=rootSeqs,proto3" json:"rootSeqs,omitempty"`
+	UserID         string  `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *GetThreadsByRootSeqsReq) Reset() {
+	*x = GetThreadsByRootSeqsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgthread_msgthread_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetThreadsByRootSeqsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadsByRootSeqsReq) ProtoMessage() {}
+
+func (x *GetThreadsByRootSeqsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgthread_msgthread_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadsByRootSeqsReq.ProtoReflect.Descriptor instead.
+func (*GetThreadsByRootSeqsReq) Descriptor() ([]byte, []int) {
+	return file_msgthread_msgthread_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetThreadsByRootSeqsReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetThreadsByRootSeqsReq) GetRootSeqs() []int64 {
+	if x != nil {
+		return x.RootSeqs
+	}
+	return nil
+}
+
+func (x *GetThreadsByRootSeqsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetThreadsByRootSeqsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// root messages without a thread are omitted
+	Threads []*ThreadInfo `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+}
+
+func (x *GetThreadsByRootSeqsResp) Reset() {
+	*x = GetThreadsByRootSeqsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgthread_msgthread_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetThreadsByRootSeqsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadsByRootSeqsResp) ProtoMessage() {}
+
+func (x *GetThreadsByRootSeqsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgthread_msgthread_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadsByRootSeqsResp.ProtoReflect.Descriptor instead.
+func (*GetThreadsByRootSeqsResp) Descriptor() ([]byte, []int) {
+	return file_msgthread_msgthread_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetThreadsByRootSeqsResp) GetThreads() []*ThreadInfo {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+type UserThread struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Thread     *ThreadInfo `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	MaxSeq     int64       `protobuf:"varint,2,opt,name=maxSeq,proto3" json:"maxSeq,omitempty"`
+	HasReadSeq int64       `protobuf:"varint,3,opt,name=hasReadSeq,proto3" json:"hasReadSeq,omitempty"`
+}
+
+func (x *UserThread) Reset() {
+	*x = UserThread{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgthread_msgthread_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserThread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserThread) ProtoMessage() {}
+
+func (x *UserThread) ProtoReflect() protoreflect.Message {
+	mi := &file_msgthread_msgthread_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserThread.ProtoReflect.Descriptor instead.
+func (*UserThread) Descriptor() ([]byte, []int) {
+	return file_msgthread_msgthread_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserThread) GetThread() *ThreadInfo {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+func (x *UserThread) GetMaxSeq() int64 {
+	if x != nil {
+		return x.MaxSeq
+	}
+	return 0
+}
+
+func (x *UserThread) GetHasReadSeq() int64 {
+	if x != nil {
+		return x.HasReadSeq
+	}
+	return 0
+}
+
+type GetUserThreadsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetUserThreadsReq) Reset() {
+	*x = GetUserThreadsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgthread_msgthread_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserThreadsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserThreadsReq) ProtoMessage() {}
+
+func (x *GetUserThreadsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgthread_msgthread_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserThreadsReq.ProtoReflect.Descriptor instead.
+func (*GetUserThreadsReq) Descriptor() ([]byte, []int) {
+	return file_msgthread_msgthread_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetUserThreadsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetUserThreadsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetUserThreadsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// most recently active first
+	Threads []*UserThread `protobuf:"bytes,2,rep,name=threads,proto3" json:"threads,omitempty"`
+}
+
+func (x *GetUserThreadsResp) Reset() {
+	*x = GetUserThreadsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgthread_msgthread_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserThreadsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserThreadsResp) ProtoMessage() {}
+
+func (x *GetUserThreadsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgthread_msgthread_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserThreadsResp.ProtoReflect.Descriptor instead.
+func (*GetUserThreadsResp) Descriptor() ([]byte, []int) {
+	return file_msgthread_msgthread_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetUserThreadsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetUserThreadsResp) GetThreads() []*UserThread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+type GetThreadParticipantIDsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadID string `protobuf:"bytes,1,opt,name=threadID,proto3" json:"threadID,omitempty"`
+}
+
+func (x *GetThreadParticipantIDsReq) Reset() {
+	*x = GetThreadParticipantIDsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgthread_msgthread_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetThreadParticipantIDsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadParticipantIDsReq) ProtoMessage() {}
+
+func (x *GetThreadParticipantIDsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgthread_msgthread_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadParticipantIDsReq.ProtoReflect.Descriptor instead.
+func (*GetThreadParticipantIDsReq) Descriptor() ([]byte, []int) {
+	return file_msgthread_msgthread_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetThreadParticipantIDsReq) GetThreadID() string {
+	if x != nil {
+		return x.ThreadID
+	}
+	return ""
+}
+
+type GetThreadParticipantIDsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs,omitempty"`
+}
+
+func (x *GetThreadParticipantIDsResp) Reset() {
+	*x = GetThreadParticipantIDsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgthread_msgthread_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetThreadParticipantIDsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadParticipantIDsResp) ProtoMessage() {}
+
+func (x *GetThreadParticipantIDsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgthread_msgthread_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadParticipantIDsResp.ProtoReflect.Descriptor instead.
+func (*GetThreadParticipantIDsResp) Descriptor() ([]byte, []int) {
+	return file_msgthread_msgthread_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetThreadParticipantIDsResp) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type PullThreadMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string          `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ThreadID string          `protobuf:"bytes,2,opt,name=threadID,proto3" json:"threadID,omitempty"`
+	Begin    int64           `protobuf:"varint,3,opt,name=begin,proto3" json:"begin,omitempty"`
+	End      int64           `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	Num      int64           `protobuf:"varint,5,opt,name=num,proto3" json:"num,omitempty"`
+	Order    sdkws.PullOrder `protobuf:"varint,6,opt,name=order,proto3,enum=openim.sdkws.PullOrder" json:"order,omitempty"`
+}
+
+func (x *PullThreadMsgsReq) Reset() {
+	*x = PullThreadMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgthread_msgthread_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullThreadMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullThreadMsgsReq) ProtoMessage() {}
+
+func (x *PullThreadMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgthread_msgthread_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullThreadMsgsReq.ProtoReflect.Descriptor instead.
+func (*PullThreadMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgthread_msgthread_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PullThreadMsgsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *PullThreadMsgsReq) GetThreadID() string {
+	if x != nil {
+		return x.ThreadID
+	}
+	return ""
+}
+
+func (x *PullThreadMsgsReq) GetBegin() int64 {
+	if x != nil {
+		return x.Begin
+	}
+	return 0
+}
+
+func (x *PullThreadMsgsReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *PullThreadMsgsReq) GetNum() int64 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+func (x *PullThreadMsgsReq) GetOrder() sdkws.PullOrder {
+	if x != nil {
+		return x.Order
+	}
+	return sdkws.PullOrder(0)
+}
+
+type PullThreadMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msgs  []*sdkws.MsgData `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	IsEnd bool             `protobuf:"varint,2,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
+}
+
+func (x *PullThreadMsgsResp) Reset() {
+	*x = PullThreadMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgthread_msgthread_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullThreadMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullThreadMsgsResp) ProtoMessage() {}
+
+func (x *PullThreadMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgthread_msgthread_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullThreadMsgsResp.ProtoReflect.Descriptor instead.
+func (*PullThreadMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgthread_msgthread_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PullThreadMsgsResp) GetMsgs() []*sdkws.MsgData {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+func (x *PullThreadMsgsResp) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+type MarkThreadAsReadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ThreadID   string `protobuf:"bytes,2,opt,name=threadID,proto3" json:"threadID,omitempty"`
+	HasReadSeq int64  `protobuf:"varint,3,opt,name=hasReadSeq,proto3" json:"hasReadSeq,omitempty"`
+}
+
+func (x *MarkThreadAsReadReq) Reset() {
+	*x = MarkThreadAsReadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgthread_msgthread_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkThreadAsReadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkThreadAsReadReq) ProtoMessage() {}
+
+func (x *MarkThreadAsReadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgthread_msgthread_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkThreadAsReadReq.ProtoReflect.Descriptor instead.
+func (*MarkThreadAsReadReq) Descriptor() ([]byte, []int) {
+	return file_msgthread_msgthread_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MarkThreadAsReadReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MarkThreadAsReadReq) GetThreadID() string {
+	if x != nil {
+		return x.ThreadID
+	}
+	return ""
+}
+
+func (x *MarkThreadAsReadReq) GetHasReadSeq() int64 {
+	if x != nil {
+		return x.HasReadSeq
+	}
+	return 0
+}
+
+type MarkThreadAsReadResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MarkThreadAsReadResp) Reset() {
+	*x = MarkThreadAsReadResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgthread_msgthread_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkThreadAsReadResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkThreadAsReadResp) ProtoMessage() {}
+
+func (x *MarkThreadAsReadResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgthread_msgthread_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkThreadAsReadResp.ProtoReflect.Descriptor instead.
+func (*MarkThreadAsReadResp) Descriptor() ([]byte, []int) {
+	return file_msgthread_msgthread_proto_rawDescGZIP(), []int{14}
+}
+
+var File_msgthread_msgthread_proto protoreflect.FileDescriptor
+
+var file_msgthread_msgthread_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x6d, 0x73, 0x67, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2f, 0x6d, 0x73, 0x67, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x1a, 0x11, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xb1, 0x01, 0x0a, 0x0b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0xd1, 0x02, 0x0a, 0x0a, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x44, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x0f, 0x72,
+	0x6f, 0x6f, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x6b, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x48, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x06, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x22,
+	0x75, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x42, 0x79, 0x52,
+	0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x52, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x42, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x36, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x22, 0x7a, 0x0a, 0x0a, 0x55, 0x73,
+	0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x53, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x22, 0x6c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x36, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x74, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52,
+	0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x22, 0x38, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x49, 0x44, 0x22, 0x37, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x11,
+	0x50, 0x75, 0x6c, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12,
+	0x2d, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x50, 0x75,
+	0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x55,
+	0x0a, 0x12, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x73, 0x45, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x69, 0x73, 0x45, 0x6e, 0x64, 0x22, 0x69, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71,
+	0x22, 0x16, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x41, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x32, 0xe6, 0x04, 0x0a, 0x09, 0x4d, 0x73, 0x67,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x55, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x6d, 0x73, 0x67, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6d, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x42, 0x79, 0x52, 0x6f, 0x6f,
+	0x74, 0x53, 0x65, 0x71, 0x73, 0x12, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x42, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x42, 0x79,
+	0x52, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x23,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x76, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x49, 0x44, 0x73, 0x12, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73,
+	0x67, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x5b, 0x0a, 0x0e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d,
+	0x73, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x50, 0x75, 0x6c, 0x6c,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61,
+	0x0a, 0x10, 0x4d, 0x61, 0x72, 0x6b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x41, 0x73, 0x52, 0x65,
+	0x61, 0x64, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69,
+	0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x6d, 0x73, 0x67, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_msgthread_msgthread_proto_rawDescOnce sync.Once
+	file_msgthread_msgthread_proto_rawDescData = file_msgthread_msgthread_proto_rawDesc
+)
+
+func file_msgthread_msgthread_proto_rawDescGZIP() []byte {
+	file_msgthread_msgthread_proto_rawDescOnce.Do(func() {
+		file_msgthread_msgthread_proto_rawDescData = protoimpl.X.CompressGZIP(file_msgthread_msgthread_proto_rawDescData)
+	})
+	return file_msgthread_msgthread_proto_rawDescData
+}
+
+var file_msgthread_msgthread_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_msgthread_msgthread_proto_goTypes = []interface{}{
+	(*ThreadReply)(nil),                 // 0: openim.msgthread.ThreadReply
+	(*ThreadInfo)(nil),                  // 1: openim.msgthread.ThreadInfo
+	(*CreateThreadReq)(nil),             // 2: openim.msgthread.CreateThreadReq
+	(*CreateThreadResp)(nil),            // 3: openim.msgthread.CreateThreadResp
+	(*GetThreadsByRootSeqsReq)(nil),     // 4: openim.msgthread.GetThreadsByRootSeqsReq
+	(*GetThreadsByRootSeqsResp)(nil),    // 5: openim.msgthread.GetThreadsByRootSeqsResp
+	(*UserThread)(nil),                  // 6: openim.msgthread.UserThread
+	(*GetUserThreadsReq)(nil),           // 7: openim.msgthread.GetUserThreadsReq
+	(*GetUserThreadsResp)(nil),          // 8: openim.msgthread.GetUserThreadsResp
+	(*GetThreadParticipantIDsReq)(nil),  // 9: openim.msgthread.GetThreadParticipantIDsReq
+	(*GetThreadParticipantIDsResp)(nil), // 10: openim.msgthread.GetThreadParticipantIDsResp
+	(*PullThreadMsgsReq)(nil),           // 11: openim.msgthread.PullThreadMsgsReq
+	(*PullThreadMsgsResp)(nil),          // 12: openim.msgthread.PullThreadMsgsResp
+	(*MarkThreadAsReadReq)(nil),         // 13: openim.msgthread.MarkThreadAsReadReq
+	(*MarkThreadAsReadResp)(nil),        // 14: openim.msgthread.MarkThreadAsReadResp
+	(*sdkws.RequestPagination)(nil),     // 15: openim.sdkws.RequestPagination
+	(sdkws.PullOrder)(0),                // 16: openim.sdkws.PullOrder
+	(*sdkws.MsgData)(nil),               // 17: openim.sdkws.MsgData
+}
+var file_msgthread_msgthread_proto_depIdxs = []int32{
+	0,  // 0: openim.msgthread.ThreadInfo.lastReply:type_name -> openim.msgthread.ThreadReply
+	1,  // 1: openim.msgthread.CreateThreadResp.thread:type_name -> openim.msgthread.ThreadInfo
+	1,  // 2: openim.msgthread.GetThreadsByRootSeqsResp.threads:type_name -> openim.msgthread.ThreadInfo
+	1,  // 3: openim.msgthread.UserThread.thread:type_name -> openim.msgthread.ThreadInfo
+	15, // 4: openim.msgthread.GetUserThreadsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	6,  // 5: openim.msgthread.GetUserThreadsResp.threads:type_name -> openim.msgthread.UserThread
+	16, // 6: openim.msgthread.PullThreadMsgsReq.order:type_name -> openim.sdkws.PullOrder
+	17, // 7: openim.msgthread.PullThreadMsgsResp.msgs:type_name -> openim.sdkws.MsgData
+	2,  // 8: openim.msgthread.MsgThread.CreateThread:input_type -> openim.msgthread.CreateThreadReq
+	4,  // 9: openim.msgthread.MsgThread.GetThreadsByRootSeqs:input_type -> openim.msgthread.GetThreadsByRootSeqsReq
+	7,  // 10: openim.msgthread.MsgThread.GetUserThreads:input_type -> openim.msgthread.GetUserThreadsReq
+	9,  // 11: openim.msgthread.MsgThread.GetThreadParticipantIDs:input_type -> openim.msgthread.GetThreadParticipantIDsReq
+	11, // 12: openim.msgthread.MsgThread.PullThreadMsgs:input_type -> openim.msgthread.PullThreadMsgsReq
+	13, // 13: openim.msgthread.MsgThread.MarkThreadAsRead:input_type -> openim.msgthread.MarkThreadAsReadReq
+	3,  // 14: openim.msgthread.MsgThread.CreateThread:output_type -> openim.msgthread.CreateThreadResp
+	5,  // 15: openim.msgthread.MsgThread.GetThreadsByRootSeqs:output_type -> openim.msgthread.GetThreadsByRootSeqsResp
+	8,  // 16: openim.msgthread.MsgThread.GetUserThreads:output_type -> openim.msgthread.GetUserThreadsResp
+	10, // 17: openim.msgthread.MsgThread.GetThreadParticipantIDs:output_type -> openim.msgthread.GetThreadParticipantIDsResp
+	12, // 18: openim.msgthread.MsgThread.PullThreadMsgs:output_type -> openim.msgthread.PullThreadMsgsResp
+	14, // 19: openim.msgthread.MsgThread.MarkThreadAsRead:output_type -> openim.msgthread.MarkThreadAsReadResp
+	14, // [14:20] is the sub-list for method output_type
+	8,  // [8:14] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_msgthread_msgthread_proto_init() }
+func file_msgthread_msgthread_proto_init() {
+	if File_msgthread_msgthread_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_msgthread_msgthread_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgthread_msgthread_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgthread_msgthread_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateThreadReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgthread_msgthread_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateThreadResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgthread_msgthread_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetThreadsByRootSeqsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgthread_msgthread_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetThreadsByRootSeqsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgthread_msgthread_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserThread); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgthread_msgthread_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserThreadsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgthread_msgthread_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserThreadsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgthread_msgthread_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetThreadParticipantIDsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgthread_msgthread_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetThreadParticipantIDsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgthread_msgthread_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullThreadMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgthread_msgthread_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullThreadMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgthread_msgthread_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkThreadAsReadReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgthread_msgthread_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkThreadAsReadResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_msgthread_msgthread_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_msgthread_msgthread_proto_goTypes,
+		DependencyIndexes: file_msgthread_msgthread_proto_depIdxs,
+		MessageInfos:      file_msgthread_msgthread_proto_msgTypes,
+	}.Build()
+	File_msgthread_msgthread_proto = out.File
+	file_msgthread_msgthread_proto_rawDesc = nil
+	file_msgthread_msgthread_proto_goTypes = nil
+	file_msgthread_msgthread_proto_depIdxs = nil
+}
@@ -0,0 +1,308 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.0
+// source: msgthread/msgthread.proto
+
+package msgthread
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MsgThread_CreateThread_FullMethodName            = "/openim.msgthread.MsgThread/CreateThread"
+	MsgThread_GetThreadsByRootSeqs_FullMethodName    = "/openim.msgthread.MsgThread/GetThreadsByRootSeqs"
+	MsgThread_GetUserThreads_FullMethodName          = "/openim.msgthread.MsgThread/GetUserThreads"
+	MsgThread_GetThreadParticipantIDs_FullMethodName = "/openim.msgthread.MsgThread/GetThreadParticipantIDs"
+	MsgThread_PullThreadMsgs_FullMethodName          = "/openim.msgthread.MsgThread/PullThreadMsgs"
+	MsgThread_MarkThreadAsRead_FullMethodName        = "/openim.msgthread.MsgThread/MarkThreadAsRead"
+)
+
+// MsgThreadClient is the client API for MsgThread service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgThreadClient interface {
+	CreateThread(ctx context.Context, in *CreateThreadReq, opts ...grpc.CallOption) (*CreateThreadResp, error)
+	GetThreadsByRootSeqs(ctx context.Context, in *GetThreadsByRootSeqsReq, opts ...grpc.CallOption) (*GetThreadsByRootSeqsResp, error)
+	GetUserThreads(ctx context.Context, in *GetUserThreadsReq, opts ...grpc.CallOption) (*GetUserThreadsResp, error)
+	GetThreadParticipantIDs(ctx context.Context, in *GetThreadParticipantIDsReq, opts ...grpc.CallOption) (*GetThreadParticipantIDsResp, error)
+	PullThreadMsgs(ctx context.Context, in *PullThreadMsgsReq, opts ...grpc.CallOption) (*PullThreadMsgsResp, error)
+	MarkThreadAsRead(ctx context.Context, in *MarkThreadAsReadReq, opts ...grpc.CallOption) (*MarkThreadAsReadResp, error)
+}
+
+type msgThreadClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgThreadClient(cc grpc.ClientConnInterface) MsgThreadClient {
+	return &msgThreadClient{cc}
+}
+
+func (c *msgThreadClient) CreateThread(ctx context.Context, in *CreateThreadReq, opts ...grpc.CallOption) (*CreateThreadResp, error) {
+	out := new(CreateThreadResp)
+	err := c.cc.Invoke(ctx, MsgThread_CreateThread_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgThreadClient) GetThreadsByRootSeqs(ctx context.Context, in *GetThreadsByRootSeqsReq, opts ...grpc.CallOption) (*GetThreadsByRootSeqsResp, error) {
+	out := new(GetThreadsByRootSeqsResp)
+	err := c.cc.Invoke(ctx, MsgThread_GetThreadsByRootSeqs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgThreadClient) GetUserThreads(ctx context.Context, in *GetUserThreadsReq, opts ...grpc.CallOption) (*GetUserThreadsResp, error) {
+	out := new(GetUserThreadsResp)
+	err := c.cc.Invoke(ctx, MsgThread_GetUserThreads_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgThreadClient) GetThreadParticipantIDs(ctx context.Context, in *GetThreadParticipantIDsReq, opts ...grpc.CallOption) (*GetThreadParticipantIDsResp, error) {
+	out := new(GetThreadParticipantIDsResp)
+	err := c.cc.Invoke(ctx, MsgThread_GetThreadParticipantIDs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgThreadClient) PullThreadMsgs(ctx context.Context, in *PullThreadMsgsReq, opts ...grpc.CallOption) (*PullThreadMsgsResp, error) {
+	out := new(PullThreadMsgsResp)
+	err := c.cc.Invoke(ctx, MsgThread_PullThreadMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgThreadClient) MarkThreadAsRead(ctx context.Context, in *MarkThreadAsReadReq, opts ...grpc.CallOption) (*MarkThreadAsReadResp, error) {
+	out := new(MarkThreadAsReadResp)
+	err := c.cc.Invoke(ctx, MsgThread_MarkThreadAsRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgThreadServer is the server API for MsgThread service.
+// All implementations must embed UnimplementedMsgThreadServer
+// for forward compatibility
+type MsgThreadServer interface {
+	CreateThread(context.Context, *CreateThreadReq) (*CreateThreadResp, error)
+	GetThreadsByRootSeqs(context.Context, *GetThreadsByRootSeqsReq) (*GetThreadsByRootSeqsResp, error)
+	GetUserThreads(context.Context, *GetUserThreadsReq) (*GetUserThreadsResp, error)
+	GetThreadParticipantIDs(context.Context, *GetThreadParticipantIDsReq) (*GetThreadParticipantIDsResp, error)
+	PullThreadMsgs(context.Context, *PullThreadMsgsReq) (*PullThreadMsgsResp, error)
+	MarkThreadAsRead(context.Context, *MarkThreadAsReadReq) (*MarkThreadAsReadResp, error)
+	mustEmbedUnimplementedMsgThreadServer()
+}
+
+// UnimplementedMsgThreadServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgThreadServer struct {
+}
+
+func (UnimplementedMsgThreadServer) CreateThread(context.Context, *CreateThreadReq) (*CreateThreadResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateThread not implemented")
+}
+func (UnimplementedMsgThreadServer) GetThreadsByRootSeqs(context.Context, *GetThreadsByRootSeqsReq) (*GetThreadsByRootSeqsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThreadsByRootSeqs not implemented")
+}
+func (UnimplementedMsgThreadServer) GetUserThreads(context.Context, *GetUserThreadsReq) (*GetUserThreadsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserThreads not implemented")
+}
+func (UnimplementedMsgThreadServer) GetThreadParticipantIDs(context.Context, *GetThreadParticipantIDsReq) (*GetThreadParticipantIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThreadParticipantIDs not implemented")
+}
+func (UnimplementedMsgThreadServer) PullThreadMsgs(context.Context, *PullThreadMsgsReq) (*PullThreadMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullThreadMsgs not implemented")
+}
+func (UnimplementedMsgThreadServer) MarkThreadAsRead(context.Context, *MarkThreadAsReadReq) (*MarkThreadAsReadResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkThreadAsRead not implemented")
+}
+func (UnimplementedMsgThreadServer) mustEmbedUnimplementedMsgThreadServer() {}
+
+// UnsafeMsgThreadServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgThreadServer will
+// result in compilation errors.
+type UnsafeMsgThreadServer interface {
+	mustEmbedUnimplementedMsgThreadServer()
+}
+
+func RegisterMsgThreadServer(s grpc.ServiceRegistrar, srv MsgThreadServer) {
+	s.RegisterService(&MsgThread_ServiceDesc, srv)
+}
+
+func _MsgThread_CreateThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateThreadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgThreadServer).CreateThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgThread_CreateThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgThreadServer).CreateThread(ctx, req.(*CreateThreadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgThread_GetThreadsByRootSeqs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadsByRootSeqsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgThreadServer).GetThreadsByRootSeqs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgThread_GetThreadsByRootSeqs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgThreadServer).GetThreadsByRootSeqs(ctx, req.(*GetThreadsByRootSeqsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgThread_GetUserThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserThreadsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgThreadServer).GetUserThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgThread_GetUserThreads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgThreadServer).GetUserThreads(ctx, req.(*GetUserThreadsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgThread_GetThreadParticipantIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadParticipantIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgThreadServer).GetThreadParticipantIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgThread_GetThreadParticipantIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgThreadServer).GetThreadParticipantIDs(ctx, req.(*GetThreadParticipantIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgThread_PullThreadMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullThreadMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgThreadServer).PullThreadMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgThread_PullThreadMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgThreadServer).PullThreadMsgs(ctx, req.(*PullThreadMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgThread_MarkThreadAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkThreadAsReadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgThreadServer).MarkThreadAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgThread_MarkThreadAsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgThreadServer).MarkThreadAsRead(ctx, req.(*MarkThreadAsReadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MsgThread_ServiceDesc is the grpc.ServiceDesc for MsgThread service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MsgThread_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.msgthread.MsgThread",
+	HandlerType: (*MsgThreadServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateThread",
+			Handler:    _MsgThread_CreateThread_Handler,
+		},
+		{
+			MethodName: "GetThreadsByRootSeqs",
+			Handler:    _MsgThread_GetThreadsByRootSeqs_Handler,
+		},
+		{
+			MethodName: "GetUserThreads",
+			Handler:    _MsgThread_GetUserThreads_Handler,
+		},
+		{
+			MethodName: "GetThreadParticipantIDs",
+			Handler:    _MsgThread_GetThreadParticipantIDs_Handler,
+		},
+		{
+			MethodName: "PullThreadMsgs",
+			Handler:    _MsgThread_PullThreadMsgs_Handler,
+		},
+		{
+			MethodName: "MarkThreadAsRead",
+			Handler:    _MsgThread_MarkThreadAsRead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msgthread/msgthread.proto",
+}
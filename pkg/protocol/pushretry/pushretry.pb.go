@@ -0,0 +1,659 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.26.0
+// source: pushretry/pushretry.proto
+
+package pushretry
+
+import (
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeadLetter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider   string   `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	UserIDs    []string `protobuf:"bytes,3,rep,name=userIDs,proto3" json:"userIDs,omitempty"`
+	Title      string   `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Content    string   `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Ex         string   `protobuf:"bytes,6,opt,name=ex,proto3" json:"ex,omitempty"`
+	Attempts   int32    `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError  string   `protobuf:"bytes,8,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	CreateTime int64    `protobuf:"varint,9,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	UpdateTime int64    `protobuf:"varint,10,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushretry_pushretry_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_pushretry_pushretry_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_pushretry_pushretry_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeadLetter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type GetDeadLettersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetDeadLettersReq) Reset() {
+	*x = GetDeadLettersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushretry_pushretry_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadLettersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLettersReq) ProtoMessage() {}
+
+func (x *GetDeadLettersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pushretry_pushretry_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLettersReq.ProtoReflect.Descriptor instead.
+func (*GetDeadLettersReq) Descriptor() ([]byte, []int) {
+	return file_pushretry_pushretry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetDeadLettersReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetDeadLettersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       int64         `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	DeadLetters []*DeadLetter `protobuf:"bytes,2,rep,name=deadLetters,proto3" json:"deadLetters,omitempty"`
+}
+
+func (x *GetDeadLettersResp) Reset() {
+	*x = GetDeadLettersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushretry_pushretry_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadLettersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLettersResp) ProtoMessage() {}
+
+func (x *GetDeadLettersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_pushretry_pushretry_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLettersResp.ProtoReflect.Descriptor instead.
+func (*GetDeadLettersResp) Descriptor() ([]byte, []int) {
+	return file_pushretry_pushretry_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDeadLettersResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetDeadLettersResp) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type ReplayDeadLettersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *ReplayDeadLettersReq) Reset() {
+	*x = ReplayDeadLettersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushretry_pushretry_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLettersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersReq) ProtoMessage() {}
+
+func (x *ReplayDeadLettersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pushretry_pushretry_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersReq.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersReq) Descriptor() ([]byte, []int) {
+	return file_pushretry_pushretry_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReplayDeadLettersReq) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ReplayDeadLettersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NotFoundIDs []string `protobuf:"bytes,1,rep,name=notFoundIDs,proto3" json:"notFoundIDs,omitempty"`
+}
+
+func (x *ReplayDeadLettersResp) Reset() {
+	*x = ReplayDeadLettersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushretry_pushretry_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLettersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersResp) ProtoMessage() {}
+
+func (x *ReplayDeadLettersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_pushretry_pushretry_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersResp.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersResp) Descriptor() ([]byte, []int) {
+	return file_pushretry_pushretry_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReplayDeadLettersResp) GetNotFoundIDs() []string {
+	if x != nil {
+		return x.NotFoundIDs
+	}
+	return nil
+}
+
+type DeleteDeadLettersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *DeleteDeadLettersReq) Reset() {
+	*x = DeleteDeadLettersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushretry_pushretry_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDeadLettersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeadLettersReq) ProtoMessage() {}
+
+func (x *DeleteDeadLettersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pushretry_pushretry_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeadLettersReq.ProtoReflect.Descriptor instead.
+func (*DeleteDeadLettersReq) Descriptor() ([]byte, []int) {
+	return file_pushretry_pushretry_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteDeadLettersReq) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type DeleteDeadLettersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteDeadLettersResp) Reset() {
+	*x = DeleteDeadLettersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushretry_pushretry_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDeadLettersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeadLettersResp) ProtoMessage() {}
+
+func (x *DeleteDeadLettersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_pushretry_pushretry_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeadLettersResp.ProtoReflect.Descriptor instead.
+func (*DeleteDeadLettersResp) Descriptor() ([]byte, []int) {
+	return file_pushretry_pushretry_proto_rawDescGZIP(), []int{6}
+}
+
+var File_pushretry_pushretry_proto protoreflect.FileDescriptor
+
+var file_pushretry_pushretry_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x70, 0x75, 0x73, 0x68, 0x72, 0x65, 0x74, 0x72, 0x79, 0x2f, 0x70, 0x75, 0x73, 0x68,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x72, 0x65, 0x74, 0x72, 0x79, 0x1a, 0x11, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x8c, 0x02, 0x0a, 0x0a, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x54, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x3e, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x72, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0x28, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x39, 0x0a, 0x15, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64,
+	0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x46, 0x6f,
+	0x75, 0x6e, 0x64, 0x49, 0x44, 0x73, 0x22, 0x28, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10,
+	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73,
+	0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x32, 0xb4, 0x02, 0x0a, 0x09, 0x50, 0x75,
+	0x73, 0x68, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x72, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x72, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x11, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x70, 0x75, 0x73, 0x68, 0x72, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d,
+	0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x70, 0x75, 0x73, 0x68, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pushretry_pushretry_proto_rawDescOnce sync.Once
+	file_pushretry_pushretry_proto_rawDescData = file_pushretry_pushretry_proto_rawDesc
+)
+
+func file_pushretry_pushretry_proto_rawDescGZIP() []byte {
+	file_pushretry_pushretry_proto_rawDescOnce.Do(func() {
+		file_pushretry_pushretry_proto_rawDescData = protoimpl.X.CompressGZIP(file_pushretry_pushretry_proto_rawDescData)
+	})
+	return file_pushretry_pushretry_proto_rawDescData
+}
+
+var file_pushretry_pushretry_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_pushretry_pushretry_proto_goTypes = []interface{}{
+	(*DeadLetter)(nil),              // 0: openim.pushretry.DeadLetter
+	(*GetDeadLettersReq)(nil),       // 1: openim.pushretry.GetDeadLettersReq
+	(*GetDeadLettersResp)(nil),      // 2: openim.pushretry.GetDeadLettersResp
+	(*ReplayDeadLettersReq)(nil),    // 3: openim.pushretry.ReplayDeadLettersReq
+	(*ReplayDeadLettersResp)(nil),   // 4: openim.pushretry.ReplayDeadLettersResp
+	(*DeleteDeadLettersReq)(nil),    // 5: openim.pushretry.DeleteDeadLettersReq
+	(*DeleteDeadLettersResp)(nil),   // 6: openim.pushretry.DeleteDeadLettersResp
+	(*sdkws.RequestPagination)(nil), // 7: openim.sdkws.RequestPagination
+}
+var file_pushretry_pushretry_proto_depIdxs = []int32{
+	7, // 0: openim.pushretry.GetDeadLettersReq.pagination:type_name -> openim.sdkws.RequestPagination
+	0, // 1: openim.pushretry.GetDeadLettersResp.deadLetters:type_name -> openim.pushretry.DeadLetter
+	1, // 2: openim.pushretry.PushRetry.GetDeadLetters:input_type -> openim.pushretry.GetDeadLettersReq
+	3, // 3: openim.pushretry.PushRetry.ReplayDeadLetters:input_type -> openim.pushretry.ReplayDeadLettersReq
+	5, // 4: openim.pushretry.PushRetry.DeleteDeadLetters:input_type -> openim.pushretry.DeleteDeadLettersReq
+	2, // 5: openim.pushretry.PushRetry.GetDeadLetters:output_type -> openim.pushretry.GetDeadLettersResp
+	4, // 6: openim.pushretry.PushRetry.ReplayDeadLetters:output_type -> openim.pushretry.ReplayDeadLettersResp
+	6, // 7: openim.pushretry.PushRetry.DeleteDeadLetters:output_type -> openim.pushretry.DeleteDeadLettersResp
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_pushretry_pushretry_proto_init() }
+func file_pushretry_pushretry_proto_init() {
+	if File_pushretry_pushretry_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pushretry_pushretry_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadLetter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pushretry_pushretry_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadLettersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pushretry_pushretry_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadLettersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pushretry_pushretry_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayDeadLettersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pushretry_pushretry_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayDeadLettersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pushretry_pushretry_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDeadLettersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pushretry_pushretry_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDeadLettersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pushretry_pushretry_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pushretry_pushretry_proto_goTypes,
+		DependencyIndexes: file_pushretry_pushretry_proto_depIdxs,
+		MessageInfos:      file_pushretry_pushretry_proto_msgTypes,
+	}.Build()
+	File_pushretry_pushretry_proto = out.File
+	file_pushretry_pushretry_proto_rawDesc = nil
+	file_pushretry_pushretry_proto_goTypes = nil
+	file_pushretry_pushretry_proto_depIdxs = nil
+}
@@ -0,0 +1,201 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.0
+// source: pushretry/pushretry.proto
+
+package pushretry
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	PushRetry_GetDeadLetters_FullMethodName    = "/openim.pushretry.PushRetry/GetDeadLetters"
+	PushRetry_ReplayDeadLetters_FullMethodName = "/openim.pushretry.PushRetry/ReplayDeadLetters"
+	PushRetry_DeleteDeadLetters_FullMethodName = "/openim.pushretry.PushRetry/DeleteDeadLetters"
+)
+
+// PushRetryClient is the client API for PushRetry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PushRetryClient interface {
+	// Dead letters are offline pushes that still failed after the configured retry attempts.
+	GetDeadLetters(ctx context.Context, in *GetDeadLettersReq, opts ...grpc.CallOption) (*GetDeadLettersResp, error)
+	// Move dead letters back to the retry queue with the attempt count reset.
+	ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersReq, opts ...grpc.CallOption) (*ReplayDeadLettersResp, error)
+	DeleteDeadLetters(ctx context.Context, in *DeleteDeadLettersReq, opts ...grpc.CallOption) (*DeleteDeadLettersResp, error)
+}
+
+type pushRetryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPushRetryClient(cc grpc.ClientConnInterface) PushRetryClient {
+	return &pushRetryClient{cc}
+}
+
+func (c *pushRetryClient) GetDeadLetters(ctx context.Context, in *GetDeadLettersReq, opts ...grpc.CallOption) (*GetDeadLettersResp, error) {
+	out := new(GetDeadLettersResp)
+	err := c.cc.Invoke(ctx, PushRetry_GetDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushRetryClient) ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersReq, opts ...grpc.CallOption) (*ReplayDeadLettersResp, error) {
+	out := new(ReplayDeadLettersResp)
+	err := c.cc.Invoke(ctx, PushRetry_ReplayDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushRetryClient) DeleteDeadLetters(ctx context.Context, in *DeleteDeadLettersReq, opts ...grpc.CallOption) (*DeleteDeadLettersResp, error) {
+	out := new(DeleteDeadLettersResp)
+	err := c.cc.Invoke(ctx, PushRetry_DeleteDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PushRetryServer is the server API for PushRetry service.
+// All implementations must embed UnimplementedPushRetryServer
+// for forward compatibility
+type PushRetryServer interface {
+	// Dead letters are offline pushes that still failed after the configured retry attempts.
+	GetDeadLetters(context.Context, *GetDeadLettersReq) (*GetDeadLettersResp, error)
+	// Move dead letters back to the retry queue with the attempt count reset.
+	ReplayDeadLetters(context.Context, *ReplayDeadLettersReq) (*ReplayDeadLettersResp, error)
+	DeleteDeadLetters(context.Context, *DeleteDeadLettersReq) (*DeleteDeadLettersResp, error)
+	mustEmbedUnimplementedPushRetryServer()
+}
+
+// UnimplementedPushRetryServer must be embedded to have forward compatible implementations.
+type UnimplementedPushRetryServer struct {
+}
+
+func (UnimplementedPushRetryServer) GetDeadLetters(context.Context, *GetDeadLettersReq) (*GetDeadLettersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetters not implemented")
+}
+func (UnimplementedPushRetryServer) ReplayDeadLetters(context.Context, *ReplayDeadLettersReq) (*ReplayDeadLettersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetters not implemented")
+}
+func (UnimplementedPushRetryServer) DeleteDeadLetters(context.Context, *DeleteDeadLettersReq) (*DeleteDeadLettersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeadLetters not implemented")
+}
+func (UnimplementedPushRetryServer) mustEmbedUnimplementedPushRetryServer() {}
+
+// UnsafePushRetryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PushRetryServer will
+// result in compilation errors.
+type UnsafePushRetryServer interface {
+	mustEmbedUnimplementedPushRetryServer()
+}
+
+func RegisterPushRetryServer(s grpc.ServiceRegistrar, srv PushRetryServer) {
+	s.RegisterService(&PushRetry_ServiceDesc, srv)
+}
+
+func _PushRetry_GetDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLettersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushRetryServer).GetDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushRetry_GetDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushRetryServer).GetDeadLetters(ctx, req.(*GetDeadLettersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushRetry_ReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLettersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushRetryServer).ReplayDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushRetry_ReplayDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushRetryServer).ReplayDeadLetters(ctx, req.(*ReplayDeadLettersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushRetry_DeleteDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeadLettersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushRetryServer).DeleteDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushRetry_DeleteDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushRetryServer).DeleteDeadLetters(ctx, req.(*DeleteDeadLettersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PushRetry_ServiceDesc is the grpc.ServiceDesc for PushRetry service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PushRetry_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.pushretry.PushRetry",
+	HandlerType: (*PushRetryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDeadLetters",
+			Handler:    _PushRetry_GetDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetters",
+			Handler:    _PushRetry_ReplayDeadLetters_Handler,
+		},
+		{
+			MethodName: "DeleteDeadLetters",
+			Handler:    _PushRetry_DeleteDeadLetters_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pushretry/pushretry.proto",
+}
@@ -14,6 +14,14 @@
 
 package rpccache
 
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
+)
+
 func newListMap[V comparable](values []V, err error) (*listMap[V], error) {
 	if err != nil {
 		return nil, err
@@ -32,3 +40,65 @@ type listMap[V comparable] struct {
 	List []V
 	Map  map[V]struct{}
 }
+
+// newLocalCache builds the local cache configured by cacheConfig, the cache is built again with the new slots
+// and TTLs when local-cache.yml is reloaded. The topic is kept, the caches subscribed to it at startup.
+func newLocalCache(localCache *config.LocalCache, cacheConfig func(*config.LocalCache) config.CacheConfig) localcache.Cache[any] {
+	c := &reloadLocalCache{}
+	localCache.OnReload(func(localCache *config.LocalCache) {
+		c.reload(cacheConfig(localCache))
+	})
+	c.reload(cacheConfig(localCache.Current()))
+	return c
+}
+
+type localCacheValue struct {
+	conf  config.CacheConfig
+	cache localcache.Cache[any]
+}
+
+// reloadLocalCache is a localcache.Cache replaced as a whole on reload, the values cached before are dropped.
+type reloadLocalCache struct {
+	value atomic.Pointer[localCacheValue]
+}
+
+func (c *reloadLocalCache) reload(conf config.CacheConfig) {
+	old := c.value.Load()
+	if old != nil && old.conf == conf {
+		return
+	}
+	cache := localcache.New[any](
+		localcache.WithLocalSlotNum(conf.SlotNum),
+		localcache.WithLocalSlotSize(conf.SlotSize),
+		localcache.WithLinkSlotNum(conf.SlotNum),
+		localcache.WithLocalSuccessTTL(conf.Success()),
+		localcache.WithLocalFailedTTL(conf.Failed()),
+	)
+	if !c.value.CompareAndSwap(old, &localCacheValue{conf: conf, cache: cache}) {
+		cache.Stop()
+		return
+	}
+	if old != nil {
+		old.cache.Stop()
+	}
+}
+
+func (c *reloadLocalCache) Get(ctx context.Context, key string, fetch func(ctx context.Context) (any, error)) (any, error) {
+	return c.value.Load().cache.Get(ctx, key, fetch)
+}
+
+func (c *reloadLocalCache) GetLink(ctx context.Context, key string, fetch func(ctx context.Context) (any, error), link ...string) (any, error) {
+	return c.value.Load().cache.GetLink(ctx, key, fetch, link...)
+}
+
+func (c *reloadLocalCache) Del(ctx context.Context, key ...string) {
+	c.value.Load().cache.Del(ctx, key...)
+}
+
+func (c *reloadLocalCache) DelLocal(ctx context.Context, key ...string) {
+	c.value.Load().cache.DelLocal(ctx, key...)
+}
+
+func (c *reloadLocalCache) Stop() {
+	c.value.Load().cache.Stop()
+}
@@ -32,13 +32,9 @@ func NewConversationLocalCache(client rpcclient.ConversationRpcClient, localCach
 	log.ZDebug(context.Background(), "ConversationLocalCache", "topic", lc.Topic, "slotNum", lc.SlotNum, "slotSize", lc.SlotSize, "enable", lc.Enable())
 	x := &ConversationLocalCache{
 		client: client,
-		local: localcache.New[any](
-			localcache.WithLocalSlotNum(lc.SlotNum),
-			localcache.WithLocalSlotSize(lc.SlotSize),
-			localcache.WithLinkSlotNum(lc.SlotNum),
-			localcache.WithLocalSuccessTTL(lc.Success()),
-			localcache.WithLocalFailedTTL(lc.Failed()),
-		),
+		local: newLocalCache(localCache, func(localCache *config.LocalCache) config.CacheConfig {
+			return localCache.Conversation
+		}),
 	}
 	if lc.Enable() {
 		go subscriberRedisDeleteCache(context.Background(), cli, lc.Topic, x.local.DelLocal)
@@ -30,13 +30,9 @@ func NewFriendLocalCache(client rpcclient.FriendRpcClient, localCache *config.Lo
 	log.ZDebug(context.Background(), "FriendLocalCache", "topic", lc.Topic, "slotNum", lc.SlotNum, "slotSize", lc.SlotSize, "enable", lc.Enable())
 	x := &FriendLocalCache{
 		client: client,
-		local: localcache.New[any](
-			localcache.WithLocalSlotNum(lc.SlotNum),
-			localcache.WithLocalSlotSize(lc.SlotSize),
-			localcache.WithLinkSlotNum(lc.SlotNum),
-			localcache.WithLocalSuccessTTL(lc.Success()),
-			localcache.WithLocalFailedTTL(lc.Failed()),
-		),
+		local: newLocalCache(localCache, func(localCache *config.LocalCache) config.CacheConfig {
+			return localCache.Friend
+		}),
 	}
 	if lc.Enable() {
 		go subscriberRedisDeleteCache(context.Background(), cli, lc.Topic, x.local.DelLocal)
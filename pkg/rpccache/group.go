@@ -32,13 +32,9 @@ func NewGroupLocalCache(client rpcclient.GroupRpcClient, localCache *config.Loca
 	log.ZDebug(context.Background(), "GroupLocalCache", "topic", lc.Topic, "slotNum", lc.SlotNum, "slotSize", lc.SlotSize, "enable", lc.Enable())
 	x := &GroupLocalCache{
 		client: client,
-		local: localcache.New[any](
-			localcache.WithLocalSlotNum(lc.SlotNum),
-			localcache.WithLocalSlotSize(lc.SlotSize),
-			localcache.WithLinkSlotNum(lc.SlotNum),
-			localcache.WithLocalSuccessTTL(lc.Success()),
-			localcache.WithLocalFailedTTL(lc.Failed()),
-		),
+		local: newLocalCache(localCache, func(localCache *config.LocalCache) config.CacheConfig {
+			return localCache.Group
+		}),
 	}
 	if lc.Enable() {
 		go subscriberRedisDeleteCache(context.Background(), cli, lc.Topic, x.local.DelLocal)
@@ -20,6 +20,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/cachekey"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/donotdisturb"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/errs"
@@ -32,13 +33,9 @@ func NewUserLocalCache(client rpcclient.UserRpcClient, localCache *config.LocalC
 	log.ZDebug(context.Background(), "UserLocalCache", "topic", lc.Topic, "slotNum", lc.SlotNum, "slotSize", lc.SlotSize, "enable", lc.Enable())
 	x := &UserLocalCache{
 		client: client,
-		local: localcache.New[any](
-			localcache.WithLocalSlotNum(lc.SlotNum),
-			localcache.WithLocalSlotSize(lc.SlotSize),
-			localcache.WithLinkSlotNum(lc.SlotNum),
-			localcache.WithLocalSuccessTTL(lc.Success()),
-			localcache.WithLocalFailedTTL(lc.Failed()),
-		),
+		local: newLocalCache(localCache, func(localCache *config.LocalCache) config.CacheConfig {
+			return localCache.User
+		}),
 	}
 	if lc.Enable() {
 		go subscriberRedisDeleteCache(context.Background(), cli, lc.Topic, x.local.DelLocal)
@@ -81,6 +78,21 @@ func (u *UserLocalCache) GetUserGlobalMsgRecvOpt(ctx context.Context, userID str
 	}))
 }
 
+func (u *UserLocalCache) GetUserDoNotDisturb(ctx context.Context, userID string) (val *donotdisturb.DoNotDisturb, err error) {
+	log.ZDebug(ctx, "UserLocalCache GetUserDoNotDisturb req", "userID", userID)
+	defer func() {
+		if err == nil {
+			log.ZDebug(ctx, "UserLocalCache GetUserDoNotDisturb return", "value", val)
+		} else {
+			log.ZError(ctx, "UserLocalCache GetUserDoNotDisturb return", err)
+		}
+	}()
+	return localcache.AnyValue[*donotdisturb.DoNotDisturb](u.local.Get(ctx, cachekey.GetUserDoNotDisturbKey(userID), func(ctx context.Context) (any, error) {
+		log.ZDebug(ctx, "UserLocalCache GetUserDoNotDisturb rpc", "userID", userID)
+		return u.client.GetUserDoNotDisturb(ctx, userID)
+	}))
+}
+
 func (u *UserLocalCache) GetUsersInfo(ctx context.Context, userIDs []string) ([]*sdkws.UserInfo, error) {
 	users := make([]*sdkws.UserInfo, 0, len(userIDs))
 	for _, userID := range userIDs {
@@ -17,7 +17,9 @@ package rpcclient
 import (
 	"context"
 	"fmt"
+	"time"
 
+	pbmsgdestruct "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgdestruct"
 	pbconversation "github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
@@ -26,9 +28,10 @@ import (
 )
 
 type Conversation struct {
-	Client pbconversation.ConversationClient
-	conn   grpc.ClientConnInterface
-	discov discovery.SvcDiscoveryRegistry
+	Client         pbconversation.ConversationClient
+	DestructClient pbmsgdestruct.ConversationDestructClient
+	conn           grpc.ClientConnInterface
+	discov         discovery.SvcDiscoveryRegistry
 }
 
 func NewConversation(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *Conversation {
@@ -37,7 +40,7 @@ func NewConversation(discov discovery.SvcDiscoveryRegistry, rpcRegisterName stri
 		program.ExitWithError(err)
 	}
 	client := pbconversation.NewConversationClient(conn)
-	return &Conversation{discov: discov, conn: conn, Client: client}
+	return &Conversation{discov: discov, conn: conn, Client: client, DestructClient: pbmsgdestruct.NewConversationDestructClient(conn)}
 }
 
 type ConversationRpcClient Conversation
@@ -146,3 +149,20 @@ func (c *ConversationRpcClient) GetConversationNotReceiveMessageUserIDs(ctx cont
 	}
 	return resp.UserIDs, nil
 }
+
+func (c *ConversationRpcClient) GetConversationsNeedDestruct(ctx context.Context) ([]*pbmsgdestruct.DestructConversation, error) {
+	resp, err := c.DestructClient.GetConversationsNeedDestruct(ctx, &pbmsgdestruct.GetConversationsNeedDestructReq{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Conversations, nil
+}
+
+func (c *ConversationRpcClient) SetLatestMsgDestructTime(ctx context.Context, ownerUserID, conversationID string, latestMsgDestructTime time.Time) error {
+	_, err := c.DestructClient.SetLatestMsgDestructTime(ctx, &pbmsgdestruct.SetLatestMsgDestructTimeReq{
+		OwnerUserID:           ownerUserID,
+		ConversationID:        conversationID,
+		LatestMsgDestructTime: latestMsgDestructTime.UnixMilli(),
+	})
+	return err
+}
@@ -18,6 +18,15 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/legalhold"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgedit"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgreaction"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgreceipt"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgretention"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgschedule"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgsearch"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgthread"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notification"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/sdkws"
@@ -31,6 +40,7 @@ import (
 	"github.com/openimsdk/tools/utils/timeutil"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
+	"sync/atomic"
 	"time"
 )
 
@@ -76,9 +86,13 @@ func newContentTypeConf(conf *config.Notification) map[int32]config.Notification
 		constant.ConversationUnreadNotification:      conf.ConversationChanged,
 		constant.ConversationPrivateChatNotification: conf.ConversationSetPrivate,
 		// msg
-		constant.MsgRevokeNotification:  {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
-		constant.HasReadReceipt:         {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
-		constant.DeleteMsgsNotification: {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		constant.MsgRevokeNotification:       {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		constant.HasReadReceipt:              {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		constant.DeleteMsgsNotification:      {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		notification.MsgEditNotification:     {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		notification.MsgReactionNotification: {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		// only pushed to online senders to update the read counts
+		notification.GroupMsgReadNotification: {IsSendMsg: false, ReliabilityLevel: constant.UnreliableNotification},
 	}
 }
 
@@ -129,9 +143,17 @@ func newSessionTypeConf() map[int32]int32 {
 }
 
 type Message struct {
-	conn   grpc.ClientConnInterface
-	Client msg.MsgClient
-	discov discovery.SvcDiscoveryRegistry
+	conn            grpc.ClientConnInterface
+	Client          msg.MsgClient
+	SearchClient    msgsearch.MsgSearchClient
+	EditClient      msgedit.MsgEditClient
+	ReactionClient  msgreaction.MsgReactionClient
+	ThreadClient    msgthread.MsgThreadClient
+	ScheduleClient  msgschedule.MsgScheduleClient
+	ReceiptClient   msgreceipt.MsgReceiptClient
+	RetentionClient msgretention.MsgRetentionClient
+	LegalHoldClient legalhold.LegalHoldServiceClient
+	discov          discovery.SvcDiscoveryRegistry
 }
 
 func NewMessage(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *Message {
@@ -140,7 +162,19 @@ func NewMessage(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *
 		program.ExitWithError(err)
 	}
 	client := msg.NewMsgClient(conn)
-	return &Message{discov: discov, conn: conn, Client: client}
+	return &Message{
+		discov:          discov,
+		conn:            conn,
+		Client:          client,
+		SearchClient:    msgsearch.NewMsgSearchClient(conn),
+		EditClient:      msgedit.NewMsgEditClient(conn),
+		ReactionClient:  msgreaction.NewMsgReactionClient(conn),
+		ThreadClient:    msgthread.NewMsgThreadClient(conn),
+		ScheduleClient:  msgschedule.NewMsgScheduleClient(conn),
+		ReceiptClient:   msgreceipt.NewMsgReceiptClient(conn),
+		RetentionClient: msgretention.NewMsgRetentionClient(conn),
+		LegalHoldClient: legalhold.NewLegalHoldServiceClient(conn),
+	}
 }
 
 type MessageRpcClient Message
@@ -159,6 +193,12 @@ func (m *MessageRpcClient) SendMsg(ctx context.Context, req *msg.SendMsgReq) (*m
 	return resp, nil
 }
 
+// IndexMsgs adds messages stored in MongoDB to the search index.
+func (m *MessageRpcClient) IndexMsgs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error {
+	_, err := m.SearchClient.IndexMsgs(ctx, &msgsearch.IndexMsgsReq{ConversationID: conversationID, Msgs: msgs})
+	return err
+}
+
 // GetMaxSeq retrieves the maximum sequence number from the gRPC client.
 // Errors during the gRPC call are wrapped to provide additional context.
 func (m *MessageRpcClient) GetMaxSeq(ctx context.Context, req *sdkws.GetMaxSeqReq) (*sdkws.GetMaxSeqResp, error) {
@@ -182,7 +222,10 @@ func (m *MessageRpcClient) GetHasReadSeqs(ctx context.Context, userID string, co
 		UserID:          userID,
 		ConversationIDs: conversationIDs,
 	})
-	return resp.MaxSeqs, err
+	if err != nil {
+		return nil, err
+	}
+	return resp.MaxSeqs, nil
 }
 
 func (m *MessageRpcClient) GetMsgByConversationIDs(ctx context.Context, docIDs []string, seqs map[string]int64) (map[string]*sdkws.MsgData, error) {
@@ -204,6 +247,15 @@ func (m *MessageRpcClient) PullMessageBySeqList(ctx context.Context, req *sdkws.
 	return resp, nil
 }
 
+// GetThreadParticipantIDs returns the users thread messages are pushed to.
+func (m *MessageRpcClient) GetThreadParticipantIDs(ctx context.Context, threadID string) ([]string, error) {
+	resp, err := m.ThreadClient.GetThreadParticipantIDs(ctx, &msgthread.GetThreadParticipantIDsReq{ThreadID: threadID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.UserIDs, nil
+}
+
 func (m *MessageRpcClient) GetConversationMaxSeq(ctx context.Context, conversationID string) (int64, error) {
 	resp, err := m.Client.GetConversationMaxSeq(ctx, &msg.GetConversationMaxSeqReq{ConversationID: conversationID})
 	if err != nil {
@@ -218,7 +270,7 @@ func (m *MessageRpcClient) ClearMsg(ctx context.Context, ts int64) error {
 }
 
 type NotificationSender struct {
-	contentTypeConf map[int32]config.NotificationConfig
+	contentTypeConf atomic.Pointer[map[int32]config.NotificationConfig] // replaced when notification.yml is reloaded
 	sessionTypeConf map[int32]int32
 	sendMsg         func(ctx context.Context, req *msg.SendMsgReq) (*msg.SendMsgResp, error)
 	getUserInfo     func(ctx context.Context, userID string) (*sdkws.UserInfo, error)
@@ -257,7 +309,13 @@ const (
 )
 
 func NewNotificationSender(conf *config.Notification, opts ...NotificationSenderOptions) *NotificationSender {
-	notificationSender := &NotificationSender{contentTypeConf: newContentTypeConf(conf), sessionTypeConf: newSessionTypeConf()}
+	notificationSender := &NotificationSender{sessionTypeConf: newSessionTypeConf()}
+	conf.OnReload(func(conf *config.Notification) {
+		contentTypeConf := newContentTypeConf(conf)
+		notificationSender.contentTypeConf.Store(&contentTypeConf)
+	})
+	contentTypeConf := newContentTypeConf(conf.Current())
+	notificationSender.contentTypeConf.CompareAndSwap(nil, &contentTypeConf)
 	for _, opt := range opts {
 		opt(notificationSender)
 	}
@@ -317,7 +375,7 @@ func (s *NotificationSender) send(ctx context.Context, sendID, recvID string, co
 	}
 	msg.CreateTime = timeutil.GetCurrentTimestampByMill()
 	msg.ClientMsgID = idutil.GetMsgIDByMD5(sendID)
-	optionsConfig := s.contentTypeConf[contentType]
+	optionsConfig := (*s.contentTypeConf.Load())[contentType]
 	if sendID == recvID && contentType == constant.HasReadReceipt {
 		optionsConfig.ReliabilityLevel = constant.UnreliableNotification
 	}
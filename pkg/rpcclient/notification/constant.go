@@ -0,0 +1,25 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+// Content types of notifications defined by the server in addition to those of the protocol.
+const (
+	// MsgEditNotification tells clients to replace the content of an edited message.
+	MsgEditNotification = 2103
+	// MsgReactionNotification tells clients a reaction to a message was added or removed.
+	MsgReactionNotification = 2104
+	// GroupMsgReadNotification tells the sender of group messages that a member has read them.
+	GroupMsgReadNotification = 2105
+)
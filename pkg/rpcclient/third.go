@@ -17,6 +17,7 @@ package rpcclient
 import (
 	"context"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/exportjob"
 	"github.com/openimsdk/protocol/third"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/system/program"
@@ -24,10 +25,12 @@ import (
 )
 
 type Third struct {
-	conn       grpc.ClientConnInterface
-	Client     third.ThirdClient
-	discov     discovery.SvcDiscoveryRegistry
-	GrafanaUrl string
+	conn   grpc.ClientConnInterface
+	Client third.ThirdClient
+	// ExportClient manages the chat history export jobs run by the third service.
+	ExportClient exportjob.ExportJobServiceClient
+	discov       discovery.SvcDiscoveryRegistry
+	GrafanaUrl   string
 }
 
 func NewThird(discov discovery.SvcDiscoveryRegistry, rpcRegisterName, grafanaUrl string) *Third {
@@ -39,5 +42,5 @@ func NewThird(discov discovery.SvcDiscoveryRegistry, rpcRegisterName, grafanaUrl
 	if err != nil {
 		program.ExitWithError(err)
 	}
-	return &Third{discov: discov, Client: client, conn: conn, GrafanaUrl: grafanaUrl}
+	return &Third{discov: discov, Client: client, ExportClient: exportjob.NewExportJobServiceClient(conn), conn: conn, GrafanaUrl: grafanaUrl}
 }
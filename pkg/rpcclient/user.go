@@ -20,9 +20,12 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/donotdisturb"
+	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/protocol/user"
 	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/system/program"
 	"github.com/openimsdk/tools/utils/datautil"
 	"google.golang.org/grpc"
@@ -32,20 +35,23 @@ import (
 type User struct {
 	conn                  grpc.ClientConnInterface
 	Client                user.UserClient
+	DoNotDisturbClient    donotdisturb.DoNotDisturbServiceClient
 	Discov                discovery.SvcDiscoveryRegistry
 	MessageGateWayRpcName string
-	imAdminUserID         []string
+	imAdminUserID         func() []string
 }
 
-// NewUser initializes and returns a User instance based on the provided service discovery registry.
+// NewUser initializes and returns a User instance based on the provided service discovery registry,
+// imAdminUserID returns the current admin user IDs.
 func NewUser(discov discovery.SvcDiscoveryRegistry, rpcRegisterName, messageGateWayRpcName string,
-	imAdminUserID []string) *User {
+	imAdminUserID func() []string) *User {
 	conn, err := discov.GetConn(context.Background(), rpcRegisterName)
 	if err != nil {
 		program.ExitWithError(err)
 	}
 	client := user.NewUserClient(conn)
 	return &User{Discov: discov, Client: client,
+		DoNotDisturbClient:    donotdisturb.NewDoNotDisturbServiceClient(conn),
 		conn:                  conn,
 		MessageGateWayRpcName: messageGateWayRpcName,
 		imAdminUserID:         imAdminUserID}
@@ -62,7 +68,7 @@ func NewUserRpcClientByUser(user *User) *UserRpcClient {
 
 // NewUserRpcClient initializes a UserRpcClient based on the provided service discovery registry.
 func NewUserRpcClient(client discovery.SvcDiscoveryRegistry, rpcRegisterName string,
-	imAdminUserID []string) UserRpcClient {
+	imAdminUserID func() []string) UserRpcClient {
 	return UserRpcClient(*NewUser(client, rpcRegisterName, "", imAdminUserID))
 }
 
@@ -160,13 +166,28 @@ func (u *UserRpcClient) GetUserGlobalMsgRecvOpt(ctx context.Context, userID stri
 	return resp.GlobalRecvMsgOpt, nil
 }
 
+// GetUserDoNotDisturb retrieves the do not disturb schedule of a user for the services, it is called as the
+// admin since the user service only lets the user and the admins read the schedule.
+func (u *UserRpcClient) GetUserDoNotDisturb(ctx context.Context, userID string) (*donotdisturb.DoNotDisturb, error) {
+	if adminUserIDs := u.imAdminUserID(); len(adminUserIDs) > 0 {
+		ctx = mcontext.WithOpUserIDContext(ctx, adminUserIDs[0])
+	}
+	resp, err := u.DoNotDisturbClient.GetDoNotDisturb(ctx, &donotdisturb.GetDoNotDisturbReq{
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.DoNotDisturb, nil
+}
+
 // Access verifies the access rights for the provided user ID.
 func (u *UserRpcClient) Access(ctx context.Context, ownerUserID string) error {
 	_, err := u.GetUserInfo(ctx, ownerUserID)
 	if err != nil {
 		return err
 	}
-	return authverify.CheckAccessV3(ctx, ownerUserID, u.imAdminUserID)
+	return authverify.CheckAccessV3(ctx, ownerUserID, u.imAdminUserID())
 }
 
 // GetAllUserIDs retrieves all user IDs with pagination options.
@@ -187,6 +208,20 @@ func (u *UserRpcClient) SetUserStatus(ctx context.Context, userID string, status
 	return err
 }
 
+// GetOnlineUserIDs returns the users connected on any platform.
+func (u *UserRpcClient) GetOnlineUserIDs(ctx context.Context, userIDs []string) ([]string, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	resp, err := u.Client.GetUserStatus(ctx, &user.GetUserStatusReq{UserIDs: userIDs})
+	if err != nil {
+		return nil, err
+	}
+	return datautil.Filter(resp.StatusList, func(status *user.OnlineStatus) (string, bool) {
+		return status.UserID, status.Status == constant.Online
+	}), nil
+}
+
 func (u *UserRpcClient) GetNotificationByID(ctx context.Context, userID string) error {
 	_, err := u.Client.GetNotificationAccount(ctx, &user.GetNotificationAccountReq{
 		UserID: userID,